@@ -19,6 +19,7 @@ import (
 	mock "storj.io/storj/pkg/overlay/mocks"
 	psserver "storj.io/storj/pkg/piecestore/rpc/server"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/provider"
 )
@@ -30,6 +31,7 @@ const (
 // Satellite is for configuring client
 type Satellite struct {
 	Identity    provider.IdentityConfig
+	APIKeys     auth.Config
 	Kademlia    kademlia.Config
 	PointerDB   pointerdb.Config
 	Overlay     overlay.Config
@@ -109,6 +111,7 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 			o = mock.Config{Nodes: strings.Join(storagenodes, ",")}
 		}
 		errch <- runCfg.Satellite.Identity.Run(ctx,
+			runCfg.Satellite.APIKeys,
 			runCfg.Satellite.PointerDB,
 			runCfg.Satellite.Kademlia,
 			// runCfg.Satellite.Checker,
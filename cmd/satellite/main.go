@@ -4,22 +4,34 @@
 package main
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"storj.io/storj/pkg/cfgstruct"
 
+	"storj.io/storj/pkg/accounting"
 	// "storj.io/storj/pkg/datarepair/repairer"
-	// "storj.io/storj/pkg/datarepair/checker"
+	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/limits"
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/overlay"
 	mockOverlay "storj.io/storj/pkg/overlay/mocks"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/provider"
 	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/archive"
 )
 
 var (
@@ -38,15 +50,87 @@ var (
 		RunE:  cmdSetup,
 	}
 
+	apiKeyCmd = &cobra.Command{
+		Use:   "api-key",
+		Short: "Manage the macaroon API keys",
+	}
+	createAPIKeyCmd = &cobra.Command{
+		Use:   "create [project id]",
+		Short: "Create a new API key of the project with a new root secret",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCreateAPIKey,
+	}
+	revokeAPIKeyCmd = &cobra.Command{
+		Use:   "revoke [api key or id]",
+		Short: "Revoke an API key and all keys restricted from it",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdRevokeAPIKey,
+	}
+
+	projectCmd = &cobra.Command{
+		Use:   "project",
+		Short: "Manage the projects",
+	}
+	createProjectCmd = &cobra.Command{
+		Use:   "create [name]",
+		Short: "Create a new project",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdCreateProject,
+	}
+	listProjectsCmd = &cobra.Command{
+		Use:   "list",
+		Short: "List the projects",
+		Args:  cobra.NoArgs,
+		RunE:  cmdListProjects,
+	}
+	deleteProjectCmd = &cobra.Command{
+		Use:   "delete [project id]",
+		Short: "Delete a project and revoke its API keys",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdDeleteProject,
+	}
+
+	pointerDBCmd = &cobra.Command{
+		Use:   "pointerdb",
+		Short: "Maintain the pointer database",
+	}
+	rebuildNodeIndexCmd = &cobra.Command{
+		Use:   "rebuild-node-index",
+		Short: "Rebuild the index of the segments by storage node while the satellite is not running",
+		Args:  cobra.NoArgs,
+		RunE:  cmdRebuildNodeIndex,
+	}
+
+	dbCmd = &cobra.Command{
+		Use:   "db",
+		Short: "Back up and migrate the pointer database",
+	}
+	exportDBCmd = &cobra.Command{
+		Use:   "export [archive]",
+		Short: "Export the pointer database to an archive while the satellite is not running",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdExportDB,
+	}
+	importDBCmd = &cobra.Command{
+		Use:   "import [archive]",
+		Short: "Import an archive into an empty pointer database, resuming an interrupted import",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdImportDB,
+	}
+
 	runCfg struct {
 		Identity    provider.IdentityConfig
+		APIKeys     auth.Config
 		Kademlia    kademlia.Config
 		PointerDB   pointerdb.Config
+		Accounting  accounting.Config
+		Limits      limits.Config
 		// Checker     checker.Config
 		// Repairer    repairer.Config
 		Overlay     overlay.Config
 		MockOverlay mockOverlay.Config
 		StatDB      statdb.Config
+		NodeChecker checker.NodeConfig
 		// RepairQueue   queue.Config
 		// RepairChecker checker.Config
 		// Repairer      repairer.Config
@@ -57,6 +141,12 @@ var (
 		Identity  provider.IdentitySetupConfig
 		Overwrite bool `default:"false" help:"whether to overwrite pre-existing configuration files"`
 	}
+	apiKeyCfg struct {
+		APIKeys auth.Config
+	}
+	pointerDBCfg struct {
+		PointerDB pointerdb.Config
+	}
 
 	defaultConfDir = "$HOME/.storj/satellite"
 )
@@ -64,8 +154,28 @@ var (
 func init() {
 	rootCmd.AddCommand(runCmd)
 	rootCmd.AddCommand(setupCmd)
+	rootCmd.AddCommand(apiKeyCmd)
+	apiKeyCmd.AddCommand(createAPIKeyCmd)
+	apiKeyCmd.AddCommand(revokeAPIKeyCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.Bind(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(createAPIKeyCmd.Flags(), &apiKeyCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(revokeAPIKeyCmd.Flags(), &apiKeyCfg, cfgstruct.ConfDir(defaultConfDir))
+	rootCmd.AddCommand(projectCmd)
+	projectCmd.AddCommand(createProjectCmd)
+	projectCmd.AddCommand(listProjectsCmd)
+	projectCmd.AddCommand(deleteProjectCmd)
+	cfgstruct.Bind(createProjectCmd.Flags(), &apiKeyCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(listProjectsCmd.Flags(), &apiKeyCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(deleteProjectCmd.Flags(), &apiKeyCfg, cfgstruct.ConfDir(defaultConfDir))
+	rootCmd.AddCommand(pointerDBCmd)
+	pointerDBCmd.AddCommand(rebuildNodeIndexCmd)
+	cfgstruct.Bind(rebuildNodeIndexCmd.Flags(), &pointerDBCfg, cfgstruct.ConfDir(defaultConfDir))
+	rootCmd.AddCommand(dbCmd)
+	dbCmd.AddCommand(exportDBCmd)
+	dbCmd.AddCommand(importDBCmd)
+	cfgstruct.Bind(exportDBCmd.Flags(), &pointerDBCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(importDBCmd.Flags(), &pointerDBCfg, cfgstruct.ConfDir(defaultConfDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -74,7 +184,221 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 		o = runCfg.MockOverlay
 	}
 	return runCfg.Identity.Run(process.Ctx(cmd),
-		runCfg.Kademlia, runCfg.PointerDB, o, runCfg.StatDB)
+		runCfg.APIKeys, runCfg.Kademlia, runCfg.PointerDB, runCfg.Accounting, runCfg.Limits, o,
+		runCfg.StatDB, runCfg.NodeChecker)
+}
+
+func cmdCreateAPIKey(cmd *cobra.Command, args []string) (err error) {
+	if apiKeyCfg.APIKeys.DatabaseURL == "" {
+		return fmt.Errorf("no API key database configured")
+	}
+	keys, err := apiKeyCfg.APIKeys.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, keys.Close()) }()
+
+	key, err := keys.Create(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+	serialized, err := key.Serialize()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("API key: %s\nID: %s\n", serialized, hex.EncodeToString(key.ID()))
+	return nil
+}
+
+func cmdRevokeAPIKey(cmd *cobra.Command, args []string) (err error) {
+	if apiKeyCfg.APIKeys.DatabaseURL == "" {
+		return fmt.Errorf("no API key database configured")
+	}
+
+	var id []byte
+	if key, err := macaroon.ParseAPIKey(args[0]); err == nil {
+		id = key.ID()
+	} else if id, err = hex.DecodeString(args[0]); err != nil {
+		return fmt.Errorf("invalid API key or ID: %s", args[0])
+	}
+
+	keys, err := apiKeyCfg.APIKeys.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, keys.Close()) }()
+
+	return keys.Revoke(process.Ctx(cmd), id)
+}
+
+func cmdCreateProject(cmd *cobra.Command, args []string) (err error) {
+	if apiKeyCfg.APIKeys.DatabaseURL == "" {
+		return fmt.Errorf("no API key database configured")
+	}
+	keys, err := apiKeyCfg.APIKeys.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, keys.Close()) }()
+
+	project, err := keys.CreateProject(process.Ctx(cmd), args[0])
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Project: %s\nID: %s\n", project.Name, project.Id)
+	return nil
+}
+
+func cmdListProjects(cmd *cobra.Command, args []string) (err error) {
+	if apiKeyCfg.APIKeys.DatabaseURL == "" {
+		return fmt.Errorf("no API key database configured")
+	}
+	keys, err := apiKeyCfg.APIKeys.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, keys.Close()) }()
+
+	projects, err := keys.ListProjects(process.Ctx(cmd))
+	if err != nil {
+		return err
+	}
+
+	for _, project := range projects {
+		fmt.Printf("%s %s\n", project.Id, project.Name)
+	}
+	return nil
+}
+
+func cmdDeleteProject(cmd *cobra.Command, args []string) (err error) {
+	if apiKeyCfg.APIKeys.DatabaseURL == "" {
+		return fmt.Errorf("no API key database configured")
+	}
+	keys, err := apiKeyCfg.APIKeys.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, keys.Close()) }()
+
+	return keys.DeleteProject(process.Ctx(cmd), args[0])
+}
+
+func cmdRebuildNodeIndex(cmd *cobra.Command, args []string) (err error) {
+	db, err := pointerDBCfg.PointerDB.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, db.Close()) }()
+
+	segments, err := pointerdb.RebuildNodeIndex(process.Ctx(cmd), db)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Indexed %d remote segments\n", segments)
+	return nil
+}
+
+func cmdExportDB(cmd *cobra.Command, args []string) (err error) {
+	db, err := pointerDBCfg.PointerDB.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, db.Close()) }()
+
+	// write to a temporary file, so an interrupted export leaves no archive
+	tmp := args[0] + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	count, err := archive.Export(process.Ctx(cmd), db, f)
+	if err == nil {
+		err = f.Sync()
+	}
+	err = utils.CombineErrors(err, f.Close())
+	if err != nil {
+		return utils.CombineErrors(err, os.Remove(tmp))
+	}
+	if err = os.Rename(tmp, args[0]); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported %d items to %s\n", count, args[0])
+	return nil
+}
+
+func cmdImportDB(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	// check the whole archive before touching the database
+	f, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, f.Close()) }()
+	count, err := archive.Verify(ctx, f)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	db, err := pointerDBCfg.PointerDB.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { err = utils.CombineErrors(err, db.Close()) }()
+
+	// the progress file holds the last key imported by an interrupted import
+	progress := args[0] + ".progress"
+	after, err := ioutil.ReadFile(progress)
+	switch {
+	case err == nil:
+		if after, err = hex.DecodeString(strings.TrimSpace(string(after))); err != nil {
+			return fmt.Errorf("invalid progress file %s: %v", progress, err)
+		}
+		if len(after) > 0 {
+			fmt.Printf("Resuming the import after %q\n", after)
+		}
+	case os.IsNotExist(err):
+		existing, err := archive.Count(ctx, db)
+		if err != nil {
+			return err
+		}
+		if existing > 0 {
+			return fmt.Errorf("the pointer database already has %d items", existing)
+		}
+		if err = ioutil.WriteFile(progress, nil, 0600); err != nil {
+			return err
+		}
+	default:
+		return err
+	}
+
+	_, err = archive.Import(ctx, f, db, after, func(last storage.Key, count int64) error {
+		return ioutil.WriteFile(progress, []byte(hex.EncodeToString(last)+"\n"), 0600)
+	})
+	if err != nil {
+		return err
+	}
+
+	imported, err := archive.Count(ctx, db)
+	if err != nil {
+		return err
+	}
+	if imported != count {
+		return fmt.Errorf("the pointer database has %d items instead of the %d of the archive", imported, count)
+	}
+	if err = os.Remove(progress); err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported %d items from %s\n", count, args[0])
+	return nil
 }
 
 func cmdSetup(cmd *cobra.Command, args []string) (err error) {
@@ -107,9 +431,16 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	// the statdb serves the RPCs changing the node stats only with an admin key
+	adminKey := make([]byte, 32)
+	if _, err = rand.Read(adminKey); err != nil {
+		return err
+	}
+
 	o := map[string]interface{}{
 		"identity.cert-path": setupCfg.Identity.CertPath,
 		"identity.key-path":  setupCfg.Identity.KeyPath,
+		"stat-db.admin-key":  hex.EncodeToString(adminKey),
 	}
 
 	return process.SaveConfig(runCmd.Flags(),
@@ -119,5 +450,15 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 func main() {
 	runCmd.Flags().String("config",
 		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
+	createAPIKeyCmd.Flags().String("config",
+		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
+	revokeAPIKeyCmd.Flags().String("config",
+		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
+	createProjectCmd.Flags().String("config",
+		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
+	listProjectsCmd.Flags().String("config",
+		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
+	deleteProjectCmd.Flags().String("config",
+		filepath.Join(defaultConfDir, "config.yaml"), "path to configuration")
 	process.Exec(rootCmd)
 }
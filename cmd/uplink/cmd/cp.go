@@ -26,6 +26,7 @@ import (
 
 var (
 	progress *bool
+	resume   *bool
 )
 
 func init() {
@@ -35,6 +36,7 @@ func init() {
 		RunE:  copyMain,
 	})
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, continue the failed upload of a local file")
 }
 
 func cleanAbsPath(p string) string {
@@ -80,7 +82,7 @@ func upload(ctx context.Context, bs buckets.Store, srcFile string, destObj *url.
 	if *progress {
 		bar = pb.New(int(fi.Size())).SetUnits(pb.U_BYTES)
 		bar.Start()
-		r = bar.NewProxyReader(r)
+		r = &progressFile{Reader: bar.NewProxyReader(r), file: f, bar: bar}
 	}
 
 	o, err := bs.GetObjectStore(ctx, destObj.Host)
@@ -91,7 +93,12 @@ func upload(ctx context.Context, bs buckets.Store, srcFile string, destObj *url.
 	meta := objects.SerializableMeta{}
 	expTime := time.Time{}
 
-	_, err = o.Put(ctx, paths.New(destObj.Path), r, meta, expTime)
+	if *resume {
+		// the uploaded part of the file is skipped
+		_, err = o.Resume(ctx, paths.New(destObj.Path), r, meta, expTime)
+	} else {
+		_, err = o.Put(ctx, paths.New(destObj.Path), r, meta, expTime)
+	}
 	if err != nil {
 		return err
 	}
@@ -105,6 +112,23 @@ func upload(ctx context.Context, bs buckets.Store, srcFile string, destObj *url.
 	return nil
 }
 
+// progressFile reads a file through a progress bar, and seeks the file
+// directly, moving the progress bar along
+type progressFile struct {
+	io.Reader
+	file *os.File
+	bar  *pb.ProgressBar
+}
+
+// Seek implements io.Seeker
+func (p *progressFile) Seek(offset int64, whence int) (int64, error) {
+	pos, err := p.file.Seek(offset, whence)
+	if err == nil {
+		p.bar.Set64(pos)
+	}
+	return pos, err
+}
+
 // download downloads s3 compatible object args[0] to args[1] on local machine
 func download(ctx context.Context, bs buckets.Store, srcObj *url.URL, destFile string) error {
 	var err error
@@ -15,11 +15,13 @@ import (
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/storage/buckets"
 	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/objects"
 	"storj.io/storj/pkg/utils"
 )
 
 var (
 	recursiveFlag *bool
+	pendingFlag   *bool
 )
 
 func init() {
@@ -29,6 +31,7 @@ func init() {
 		RunE:  list,
 	})
 	recursiveFlag = lsCmd.Flags().Bool("recursive", false, "if true, list recursively")
+	pendingFlag = lsCmd.Flags().Bool("pending", false, "if true, list the failed uploads that can be resumed or removed")
 }
 
 func list(cmd *cobra.Command, args []string) error {
@@ -90,10 +93,58 @@ func listFiles(ctx context.Context, bs buckets.Store, u *url.URL, prependBucket
 		return err
 	}
 
+	if *pendingFlag {
+		return listPending(ctx, o, u, prependBucket)
+	}
+
+	printItem := func(object objects.ListItem) error {
+		path := object.Path.String()
+		if prependBucket {
+			path = fmt.Sprintf("%s/%s", u.Host, path)
+		}
+		if object.IsPrefix {
+			fmt.Println("PRE", path+"/")
+		} else {
+			fmt.Printf("%v %v %12v %v\n", "OBJ", formatTime(object.Meta.Modified), object.Meta.Size, path)
+		}
+		return nil
+	}
+
+	// recursive listings are streamed, so they do not need paging
+	if *recursiveFlag {
+		return o.ListStream(ctx, paths.New(u.Path), nil, true, meta.Modified|meta.Size, printItem)
+	}
+
+	startAfter := paths.New("")
+
+	for {
+		items, more, err := o.List(ctx, paths.New(u.Path), startAfter, nil, false, 0, meta.Modified|meta.Size)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range items {
+			if err := printItem(object); err != nil {
+				return err
+			}
+		}
+
+		if !more {
+			break
+		}
+
+		startAfter = items[len(items)-1].Path
+	}
+
+	return nil
+}
+
+// listPending lists the failed uploads below the path of u
+func listPending(ctx context.Context, o objects.Store, u *url.URL, prependBucket bool) error {
 	startAfter := paths.New("")
 
 	for {
-		items, more, err := o.List(ctx, paths.New(u.Path), startAfter, nil, *recursiveFlag, 0, meta.Modified|meta.Size)
+		items, more, err := o.ListPending(ctx, paths.New(u.Path), startAfter, nil, *recursiveFlag, 0)
 		if err != nil {
 			return err
 		}
@@ -106,7 +157,7 @@ func listFiles(ctx context.Context, bs buckets.Store, u *url.URL, prependBucket
 			if object.IsPrefix {
 				fmt.Println("PRE", path+"/")
 			} else {
-				fmt.Printf("%v %v %12v %v\n", "OBJ", formatTime(object.Meta.Modified), object.Meta.Size, path)
+				fmt.Printf("%v %v %v\n", "UPL", formatTime(object.Meta.Modified), path)
 			}
 		}
 
@@ -8,17 +8,28 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/storage/buckets"
 	"storj.io/storj/pkg/utils"
 	"storj.io/storj/storage"
 )
 
+var (
+	versioning       *bool
+	versionRetention *int
+	pathEncryption   *string
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mbCmd := addCmd(&cobra.Command{
 		Use:   "mb",
 		Short: "Create a new bucket",
 		RunE:  makeBucket,
 	})
+	versioning = mbCmd.Flags().Bool("versioning", false, "if true, keep the previous versions of overwritten or deleted objects, which the S3 gateway does not serve")
+	versionRetention = mbCmd.Flags().Int("version-retention", 0, "number of previous versions to keep per object, or 0 to keep all")
+	pathEncryption = mbCmd.Flags().String("path-encryption", "", "encryption of the object paths: unencrypted, aesgcm or siv (default from the configuration)")
 }
 
 func makeBucket(cmd *cobra.Command, args []string) error {
@@ -36,6 +47,14 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("No bucket specified. Please use format sj://bucket/")
 	}
 
+	pathCipher := paths.Cipher(cfg.PathEncType)
+	if *pathEncryption != "" {
+		pathCipher, err = paths.ParseCipher(*pathEncryption)
+		if err != nil {
+			return err
+		}
+	}
+
 	bs, err := cfg.BucketStore(ctx)
 	if err != nil {
 		return err
@@ -48,11 +67,21 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	if !storage.ErrKeyNotFound.Has(err) {
 		return err
 	}
-	_, err = bs.Put(ctx, u.Host)
+	_, err = bs.Put(ctx, u.Host, pathCipher)
 	if err != nil {
 		return err
 	}
 
+	if *versioning {
+		_, err = bs.SetVersioning(ctx, u.Host, buckets.Versioning{
+			Enabled:   true,
+			Retention: *versionRetention,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	fmt.Printf("Bucket %s created\n", u.Host)
 
 	return nil
@@ -0,0 +1,70 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/utils"
+)
+
+func init() {
+	addCmd(&cobra.Command{
+		Use:   "mv",
+		Short: "Moves a Storj object to another location in Storj",
+		RunE:  move,
+	})
+}
+
+// move moves s3 compatible object args[0] to s3 compatible object args[1]
+// without downloading and uploading its data
+func move(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No object specified for move")
+	}
+	if len(args) == 1 {
+		return fmt.Errorf("No destination specified")
+	}
+
+	srcObj, err := utils.ParseURL(args[0])
+	if err != nil {
+		return err
+	}
+	destObj, err := utils.ParseURL(args[1])
+	if err != nil {
+		return err
+	}
+	if srcObj.Host == "" || destObj.Host == "" {
+		return fmt.Errorf("No bucket specified. Please use format sj://bucket/")
+	}
+
+	srcObj.Path = cleanAbsPath(srcObj.Path)
+	destObj.Path = cleanAbsPath(destObj.Path)
+	// if object name not specified, default to the source object name
+	if strings.HasSuffix(destObj.Path, "/") {
+		destObj.Path = path.Join(destObj.Path, path.Base(srcObj.Path))
+	}
+
+	bs, err := cfg.BucketStore(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = bs.MoveObject(ctx, srcObj.Host, paths.New(srcObj.Path), destObj.Host, paths.New(destObj.Path))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s moved to %s\n", srcObj, destObj)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/utils"
+)
+
+var (
+	disallowReads   *bool
+	disallowWrites  *bool
+	disallowLists   *bool
+	disallowDeletes *bool
+	notBefore       *string
+	notAfter        *string
+)
+
+func init() {
+	restrictKeyCmd := addCmd(&cobra.Command{
+		Use:   "restrict-api-key [sj://bucket/prefix]",
+		Short: "Create a restricted copy of the API key",
+		Long: "Create a restricted copy of the configured macaroon API key without contacting " +
+			"the satellite. If a bucket is given, the copy only gives access to the objects " +
+			"below the prefix in the bucket.",
+		RunE: restrictKey,
+	})
+	disallowReads = restrictKeyCmd.Flags().Bool("disallow-reads", false, "disallow reading objects")
+	disallowWrites = restrictKeyCmd.Flags().Bool("disallow-writes", false, "disallow creating and replacing objects")
+	disallowLists = restrictKeyCmd.Flags().Bool("disallow-lists", false, "disallow listing objects")
+	disallowDeletes = restrictKeyCmd.Flags().Bool("disallow-deletes", false, "disallow deleting objects")
+	notBefore = restrictKeyCmd.Flags().String("not-before", "", "time (RFC 3339) before which the key is not valid")
+	notAfter = restrictKeyCmd.Flags().String("not-after", "", "time (RFC 3339) after which the key is not valid")
+}
+
+func restrictKey(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	if cfg.APIKey == "" {
+		return fmt.Errorf("No API key configured")
+	}
+
+	caveat := pb.Caveat{
+		DisallowReads:   *disallowReads,
+		DisallowWrites:  *disallowWrites,
+		DisallowLists:   *disallowLists,
+		DisallowDeletes: *disallowDeletes,
+	}
+	if caveat.NotBefore, err = parseCaveatTime(*notBefore); err != nil {
+		return err
+	}
+	if caveat.NotAfter, err = parseCaveatTime(*notAfter); err != nil {
+		return err
+	}
+
+	var bucket string
+	var prefix paths.Path
+	var identity *provider.FullIdentity
+	if len(args) > 0 {
+		u, err := utils.ParseURL(args[0])
+		if err != nil {
+			return err
+		}
+		if u.Host == "" {
+			return fmt.Errorf("No bucket specified. Please use format sj://bucket/prefix")
+		}
+		bucket, prefix = u.Host, paths.New(u.Path)
+
+		// the identity is only needed to look up the bucket
+		identity, err = cfg.Load()
+		if err != nil {
+			return err
+		}
+	}
+
+	key, err := cfg.RestrictAPIKey(ctx, identity, cfg.APIKey, caveat, bucket, prefix)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(key)
+
+	return nil
+}
+
+// parseCaveatTime parses an optional RFC 3339 time of a caveat
+func parseCaveatTime(value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid time %q: %v", value, err)
+	}
+	return ptypes.TimestampProto(t)
+}
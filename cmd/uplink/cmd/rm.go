@@ -13,12 +13,17 @@ import (
 	"storj.io/storj/pkg/utils"
 )
 
+var (
+	rmPendingFlag *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	rmCmd := addCmd(&cobra.Command{
 		Use:   "rm",
 		Short: "Delete an object",
 		RunE:  delete,
 	})
+	rmPendingFlag = rmCmd.Flags().Bool("pending", false, "if true, remove the failed upload of the object instead")
 }
 
 func delete(cmd *cobra.Command, args []string) error {
@@ -46,6 +51,17 @@ func delete(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if *rmPendingFlag {
+		err = o.Abort(ctx, paths.New(u.Path))
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Removed the upload of %s\n", u)
+
+		return nil
+	}
+
 	err = o.Delete(ctx, paths.New(u.Path))
 	if err != nil {
 		return err
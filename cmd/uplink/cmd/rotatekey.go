@@ -0,0 +1,84 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/miniogw"
+	"storj.io/storj/pkg/process"
+)
+
+var (
+	newKeyFile    *string
+	newPassphrase *bool
+	newSalt       *string
+)
+
+func init() {
+	rotateKeyCmd := addCmd(&cobra.Command{
+		Use:   "rotate-key",
+		Short: "Re-encrypt all buckets and objects with a new root key",
+		Long: "Re-encrypt all buckets and objects with a new root key. Only the keys of the " +
+			"segments are re-encrypted, the data is not moved. Pending uploads are not " +
+			"re-encrypted, so complete or abort them first. An interrupted rotation can be " +
+			"run again.",
+		RunE: rotateKey,
+	})
+	newKeyFile = rotateKeyCmd.Flags().String("new-key-file", "", "path to a file holding the new root key or passphrase, which must not be accessible by other users")
+	newPassphrase = rotateKeyCmd.Flags().Bool("new-passphrase", false, "derive the new root key from the contents of new-key-file as a passphrase")
+	newSalt = rotateKeyCmd.Flags().String("new-salt", "", "salt for deriving the new root key from the passphrase (default a new random salt)")
+}
+
+func rotateKey(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if *newKeyFile == "" {
+		return fmt.Errorf("No new key specified. Please use --new-key-file")
+	}
+
+	secret, err := miniogw.ReadKeyFile(*newKeyFile)
+	if err != nil {
+		return err
+	}
+
+	if *newSalt != "" && !*newPassphrase {
+		return fmt.Errorf("A salt can only be used with --new-passphrase")
+	}
+
+	newKey := []byte(secret)
+	salt := *newSalt
+	if *newPassphrase {
+		if salt == "" {
+			salt, err = miniogw.NewSalt()
+			if err != nil {
+				return err
+			}
+		}
+		// the same key must be derived to resume an interrupted rotation
+		fmt.Printf("Deriving the new root key with salt %s\n", salt)
+		newKey, err = miniogw.DeriveRootKey(secret, salt)
+		if err != nil {
+			return err
+		}
+	}
+
+	identity, err := cfg.Load()
+	if err != nil {
+		return err
+	}
+
+	err = cfg.RotateKey(ctx, identity, newKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Root key rotated. Please update your configuration:")
+	fmt.Printf("  enc-key-file: %s\n", *newKeyFile)
+	fmt.Printf("  enc-salt: %q\n", salt)
+
+	return nil
+}
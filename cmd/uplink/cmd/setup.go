@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"storj.io/storj/pkg/cfgstruct"
+	"storj.io/storj/pkg/miniogw"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/provider"
 )
@@ -31,6 +32,8 @@ var (
 		SatelliteAddr string `default:"localhost:7778" help:"the address to use for the satellite"`
 		APIKey        string `default:"" help:"the api key to use for the satellite"`
 		EncKey        string `default:"" help:"your root encryption key"`
+		EncKeyFile    string `default:"" help:"path to a file holding your root encryption key, which must not be accessible by other users"`
+		Passphrase    bool   `default:"false" help:"derive the root encryption key from the given key as a passphrase"`
 	}
 )
 
@@ -82,6 +85,14 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		return err
 	}
 
+	var salt string
+	if setupCfg.Passphrase {
+		salt, err = miniogw.NewSalt()
+		if err != nil {
+			return err
+		}
+	}
+
 	o := map[string]interface{}{
 		"cert-path":       setupCfg.Identity.CertPath,
 		"key-path":        setupCfg.Identity.KeyPath,
@@ -91,6 +102,8 @@ func cmdSetup(cmd *cobra.Command, args []string) (err error) {
 		"access-key":      accessKey,
 		"secret-key":      secretKey,
 		"enc-key":         setupCfg.EncKey,
+		"enc-key-file":    setupCfg.EncKeyFile,
+		"enc-salt":        salt,
 	}
 
 	return process.SaveConfig(runCmd.Flags(),
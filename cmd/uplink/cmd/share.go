@@ -0,0 +1,60 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/utils"
+)
+
+var shareAPIKey *string
+
+func init() {
+	shareCmd := addCmd(&cobra.Command{
+		Use:   "share",
+		Short: "Create an access token to the objects below a path prefix",
+		RunE:  share,
+	})
+	shareAPIKey = shareCmd.Flags().String("share-api-key", "", "API key to put in the access token, which is restricted to the shared objects if it is a macaroon (default the configured API key)")
+}
+
+func share(cmd *cobra.Command, args []string) error {
+	ctx := process.Ctx(cmd)
+
+	if len(args) == 0 {
+		return fmt.Errorf("No path specified to share")
+	}
+
+	u, err := utils.ParseURL(args[0])
+	if err != nil {
+		return err
+	}
+	if u.Host == "" {
+		return fmt.Errorf("No bucket specified. Please use format sj://bucket/prefix")
+	}
+
+	apiKey := *shareAPIKey
+	if apiKey == "" && cfg.AccessToken == "" {
+		apiKey = cfg.APIKey
+	}
+
+	identity, err := cfg.Load()
+	if err != nil {
+		return err
+	}
+
+	token, err := cfg.Share(ctx, identity, u.Host, paths.New(u.Path), apiKey)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(token)
+
+	return nil
+}
@@ -109,7 +109,7 @@ func main() {
 	}
 
 	// Example Delete
-	err = client.Delete(ctx, path)
+	_, err = client.Delete(ctx, path)
 
 	if err != nil || status.Code(err) == codes.Internal {
 		logger.Error("Error in deleteing file from db", zap.Error(err))
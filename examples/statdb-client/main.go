@@ -19,13 +19,14 @@ import (
 )
 
 var (
-	port   string
-	APIKey = []byte("")
-	ctx    = context.Background()
+	port     string
+	adminKey string
+	ctx      = context.Background()
 )
 
 func initializeFlags() {
 	flag.StringVar(&port, "port", ":7777", "port")
+	flag.StringVar(&adminKey, "admin-key", "", "the statdb admin key of the satellite, required to change the node stats")
 	flag.Parse()
 }
 
@@ -44,6 +45,11 @@ func main() {
 	logger, _ := zap.NewDevelopment()
 	defer printError(logger.Sync)
 
+	if adminKey == "" {
+		logger.Error("the statdb admin key is required: -admin-key=<key>")
+		os.Exit(1)
+	}
+
 	ca, err := provider.NewCA(ctx, 12, 4)
 	if err != nil {
 		logger.Error("Failed to create certificate authority: ", zap.Error(err))
@@ -54,7 +60,7 @@ func main() {
 		logger.Error("Failed to create full identity: ", zap.Error(err))
 		os.Exit(1)
 	}
-	client, err := sdbclient.NewClient(identity, port, APIKey)
+	client, err := sdbclient.NewClient(identity, port, []byte(adminKey))
 	if err != nil {
 		logger.Error("Failed to create sdbclient: ", zap.Error(err))
 	}
@@ -0,0 +1,141 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+var ctx = context.Background()
+
+func TestTallyPointers(t *testing.T) {
+	pointers := teststore.New()
+	expired, err := ptypes.TimestampProto(time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for key, pointer := range map[string]*pb.Pointer{
+		"l/bucket1/a":             {Type: pb.Pointer_REMOTE, Size: 100},
+		"s0/bucket1/a":            {Type: pb.Pointer_REMOTE, Size: 200},
+		"l/bucket2/b":             {Type: pb.Pointer_INLINE, InlineSegment: []byte("12345")},
+		"l/bucket2/expired":       {Type: pb.Pointer_REMOTE, Size: 400, ExpirationDate: expired},
+		"projects/p1/l/bucket1/c": {Type: pb.Pointer_INLINE, InlineSegment: []byte("123")},
+		"refs/piece":              {Type: pb.Pointer_REMOTE, Size: 800},
+		// a copied segment is tallied once, in the first bucket
+		"l/bucket1/copy":  {Type: pb.Pointer_REMOTE, Size: 1000, Remote: &pb.RemoteSegment{PieceId: "piece"}},
+		"l/bucket2/copy":  {Type: pb.Pointer_REMOTE, Size: 1000, Remote: &pb.RemoteSegment{PieceId: "piece"}},
+		"s0/bucket2/copy": {Type: pb.Pointer_REMOTE, Size: 1000, Remote: &pb.RemoteSegment{PieceId: "piece"}},
+	} {
+		value, err := proto.Marshal(pointer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = pointers.Put(storage.Key(key), value); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	tallies, err := TallyPointers(ctx, pointers, now)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []Tally{
+		{Bucket: "bucket1", Time: now, RemoteBytes: 1300, RemoteSegments: 3},
+		{Bucket: "bucket2", Time: now, InlineBytes: 5, InlineSegments: 1},
+		{ProjectID: "p1", Bucket: "bucket1", Time: now, InlineBytes: 3, InlineSegments: 1},
+	}, tallies)
+}
+
+func TestUsage(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "storj-accounting")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tempdir) }()
+
+	db, err := Open("sqlite://" + filepath.Join(tempdir, "accounting.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	start := time.Date(2018, 10, 1, 0, 0, 0, 0, time.UTC)
+	for i, tallies := range [][]Tally{
+		{{Bucket: "bucket1", RemoteBytes: 100}, {ProjectID: "p1", Bucket: "bucket1", RemoteBytes: 1000}},
+		{{Bucket: "bucket1", RemoteBytes: 200}, {Bucket: "bucket2", InlineBytes: 50}},
+		{{Bucket: "bucket2", InlineBytes: 50}},
+	} {
+		assert.NoError(t, db.SaveTallies(ctx, start.Add(time.Duration(i)*time.Hour), tallies))
+	}
+
+	// only the last run counts for the stored bytes
+	stored, err := db.StoredBytes(ctx, "")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(50), stored)
+	stored, err = db.StoredBytes(ctx, "p1")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), stored)
+
+	s := NewServer(db, nil, zap.NewNop())
+	toTimestamp := func(t time.Time) *timestamp.Timestamp {
+		ts, _ := ptypes.TimestampProto(t)
+		return ts
+	}
+
+	// the first tally counts for the half hour after the period starts
+	resp, err := s.Usage(ctx, &pb.UsageRequest{
+		Start: toTimestamp(start.Add(30 * time.Minute)),
+		End:   toTimestamp(start.Add(3 * time.Hour)),
+	})
+	if assert.NoError(t, err) && assert.Len(t, resp.Buckets, 2) {
+		assert.Equal(t, "bucket1", resp.Buckets[0].Bucket)
+		assert.Equal(t, 0.5*100+200, resp.Buckets[0].RemoteByteHours)
+		assert.Equal(t, int64(0), resp.Buckets[0].RemoteBytes)
+		assert.Equal(t, "bucket2", resp.Buckets[1].Bucket)
+		assert.Equal(t, 2*50.0, resp.Buckets[1].InlineByteHours)
+		assert.Equal(t, int64(50), resp.Buckets[1].InlineBytes)
+		assert.Equal(t, 250.0, resp.RemoteByteHours)
+		assert.Equal(t, 100.0, resp.InlineByteHours)
+	}
+
+	resp, err = s.Usage(ctx, &pb.UsageRequest{
+		Bucket: "bucket1",
+		Start:  toTimestamp(start),
+		End:    toTimestamp(start.Add(90 * time.Minute)),
+	})
+	if assert.NoError(t, err) && assert.Len(t, resp.Buckets, 1) {
+		assert.Equal(t, 100+0.5*200, resp.Buckets[0].RemoteByteHours)
+		assert.Equal(t, int64(200), resp.Buckets[0].RemoteBytes)
+	}
+
+	// nothing was tallied before the first run
+	resp, err = s.Usage(ctx, &pb.UsageRequest{End: toTimestamp(start)})
+	if assert.NoError(t, err) {
+		assert.Len(t, resp.Buckets, 0)
+	}
+
+	_, err = s.Usage(ctx, &pb.UsageRequest{Start: toTimestamp(start), End: toTimestamp(start)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.Usage(ctx, &pb.UsageRequest{APIKey: []byte("wrong key")})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
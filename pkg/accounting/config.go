@@ -0,0 +1,66 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/pointerdb/auth"
+	"storj.io/storj/pkg/provider"
+)
+
+// CtxKey used for assigning the tally database
+type CtxKey int
+
+const (
+	ctxKeyAccounting CtxKey = iota
+)
+
+// Config is a configuration struct that is everything you need to start an
+// accounting responsibility, which tallies the pointers of the pointerdb
+// responsibility started before it
+type Config struct {
+	DatabaseURL string        `help:"the database connection string of the tallies, postgres:// or sqlite://" default:"sqlite://$CONFDIR/accounting.db"`
+	Interval    time.Duration `help:"how frequently the pointers are tallied, or 0 to never tally them" default:"1h"`
+}
+
+// Run implements the provider.Responsibility interface
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointers := pointerdb.LoadFromContext(ctx)
+	if pointers == nil {
+		return Error.New("accounting requires the pointerdb responsibility")
+	}
+
+	db, err := Open(c.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	pb.RegisterAccountingServer(server.GRPC(), NewServer(db, auth.LoadFromContext(ctx), zap.L()))
+
+	if c.Interval > 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go runTallies(ctx, pointers, db, c.Interval)
+	}
+
+	return server.Run(context.WithValue(ctx, ctxKeyAccounting, db))
+}
+
+// LoadFromContext gives access to the tally database from the context, or
+// returns nil
+func LoadFromContext(ctx context.Context) *DB {
+	if v, ok := ctx.Value(ctxKeyAccounting).(*DB); ok {
+		return v
+	}
+	return nil
+}
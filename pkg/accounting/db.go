@@ -0,0 +1,189 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq"           // register the postgres driver
+	_ "github.com/mattn/go-sqlite3" // register the sqlite3 driver
+
+	"storj.io/storj/pkg/utils"
+)
+
+// DB keeps the tallies in a SQL database. Every walk of the pointers is
+// recorded as a run, so a bucket missing from a run is known to have stored
+// nothing at the time. It also keeps the bandwidth the projects used per
+// quota period. Times are kept as Unix nanoseconds.
+type DB struct {
+	db *sql.DB
+}
+
+// Open opens the tally database at a postgres:// or sqlite:// URL and creates
+// its tables if they do not exist yet
+func Open(databaseURL string) (*DB, error) {
+	var driver, source string
+	switch {
+	case strings.HasPrefix(databaseURL, "postgres://") || strings.HasPrefix(databaseURL, "postgresql://"):
+		driver, source = "postgres", databaseURL
+	case strings.HasPrefix(databaseURL, "sqlite://"):
+		driver, source = "sqlite3", strings.TrimPrefix(databaseURL, "sqlite://")
+	default:
+		return nil, Error.New("unsupported database url: %s", databaseURL)
+	}
+
+	db, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if driver == "sqlite3" {
+		// sqlite allows a single writer
+		db.SetMaxOpenConns(1)
+	}
+
+	for _, query := range []string{
+		`CREATE TABLE IF NOT EXISTS tally_runs (
+			"time" BIGINT NOT NULL PRIMARY KEY
+		)`,
+		`CREATE TABLE IF NOT EXISTS tallies (
+			project_id TEXT NOT NULL,
+			bucket TEXT NOT NULL,
+			"time" BIGINT NOT NULL,
+			remote_bytes BIGINT NOT NULL,
+			inline_bytes BIGINT NOT NULL,
+			remote_segments BIGINT NOT NULL,
+			inline_segments BIGINT NOT NULL,
+			PRIMARY KEY (project_id, bucket, "time")
+		)`,
+		`CREATE TABLE IF NOT EXISTS bandwidth (
+			project_id TEXT NOT NULL,
+			period BIGINT NOT NULL,
+			"bytes" BIGINT NOT NULL,
+			PRIMARY KEY (project_id, period)
+		)`,
+	} {
+		if _, err = db.Exec(query); err != nil {
+			return nil, utils.CombineErrors(Error.Wrap(err), db.Close())
+		}
+	}
+	return &DB{db: db}, nil
+}
+
+// Close closes the database
+func (db *DB) Close() error {
+	return Error.Wrap(db.db.Close())
+}
+
+// SaveTallies records a run at the time of the tallies along with them
+func (db *DB) SaveTallies(ctx context.Context, at time.Time, tallies []Tally) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := db.db.Begin()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = utils.CombineErrors(err, Error.Wrap(tx.Rollback()))
+		}
+	}()
+
+	if _, err = tx.Exec(`INSERT INTO tally_runs ("time") VALUES ($1)`, at.UnixNano()); err != nil {
+		return Error.Wrap(err)
+	}
+	for _, tally := range tallies {
+		_, err = tx.Exec(`INSERT INTO tallies (project_id, bucket, "time", remote_bytes, inline_bytes, remote_segments, inline_segments)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			tally.ProjectID, tally.Bucket, at.UnixNano(),
+			tally.RemoteBytes, tally.InlineBytes, tally.RemoteSegments, tally.InlineSegments)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return Error.Wrap(tx.Commit())
+}
+
+// Runs returns the times of the runs before end, starting with the last run
+// at or before start
+func (db *DB) Runs(ctx context.Context, start, end time.Time) (runs []time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`SELECT "time" FROM tally_runs
+		WHERE "time" >= COALESCE((SELECT MAX("time") FROM tally_runs WHERE "time" <= $1), $1) AND "time" < $2
+		ORDER BY "time"`, start.UnixNano(), end.UnixNano())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, Error.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		var nanos int64
+		if err = rows.Scan(&nanos); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		runs = append(runs, time.Unix(0, nanos))
+	}
+	return runs, Error.Wrap(rows.Err())
+}
+
+// Tallies returns the tallies of the project from the runs between from and
+// end, of a single bucket, or of all buckets if bucket is empty
+func (db *DB) Tallies(ctx context.Context, projectID, bucket string, from, end time.Time) (tallies []Tally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := db.db.Query(`SELECT bucket, "time", remote_bytes, inline_bytes, remote_segments, inline_segments
+		FROM tallies
+		WHERE project_id = $1 AND ($2 = '' OR bucket = $2) AND "time" >= $3 AND "time" < $4
+		ORDER BY bucket, "time"`, projectID, bucket, from.UnixNano(), end.UnixNano())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, Error.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		tally := Tally{ProjectID: projectID}
+		var nanos int64
+		err = rows.Scan(&tally.Bucket, &nanos, &tally.RemoteBytes, &tally.InlineBytes, &tally.RemoteSegments, &tally.InlineSegments)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		tally.Time = time.Unix(0, nanos)
+		tallies = append(tallies, tally)
+	}
+	return tallies, Error.Wrap(rows.Err())
+}
+
+// StoredBytes returns the bytes the project stored as of the last run, or 0
+// if nothing was tallied yet
+func (db *DB) StoredBytes(ctx context.Context, projectID string) (bytes int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRow(`SELECT COALESCE(SUM(remote_bytes + inline_bytes), 0) FROM tallies
+		WHERE project_id = $1 AND "time" = (SELECT MAX("time") FROM tally_runs)`, projectID).Scan(&bytes)
+	return bytes, Error.Wrap(err)
+}
+
+// AddBandwidth adds bytes to the bandwidth the project used in the quota
+// period starting at period
+func (db *DB) AddBandwidth(ctx context.Context, projectID string, period time.Time, bytes int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = db.db.Exec(`INSERT INTO bandwidth (project_id, period, "bytes") VALUES ($1, $2, $3)
+		ON CONFLICT (project_id, period) DO UPDATE SET "bytes" = bandwidth."bytes" + excluded."bytes"`,
+		projectID, period.UnixNano(), bytes)
+	return Error.Wrap(err)
+}
+
+// UsedBandwidth returns the bandwidth the project used in the quota period
+// starting at period
+func (db *DB) UsedBandwidth(ctx context.Context, projectID string, period time.Time) (bytes int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = db.db.QueryRow(`SELECT COALESCE(SUM("bytes"), 0) FROM bandwidth
+		WHERE project_id = $1 AND period = $2`, projectID, period.UnixNano()).Scan(&bytes)
+	return bytes, Error.Wrap(err)
+}
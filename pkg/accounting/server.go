@@ -0,0 +1,91 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
+)
+
+// Server implements the accounting RPC service
+type Server struct {
+	db     *DB
+	keys   *auth.KeyStore
+	logger *zap.Logger
+}
+
+// NewServer creates a server reporting the usage from the tallies of db. The
+// API keys are checked with keys, or against the shared API key if it is nil.
+func NewServer(db *DB, keys *auth.KeyStore, logger *zap.Logger) *Server {
+	return &Server{db: db, keys: keys, logger: logger}
+}
+
+// Usage returns the byte-hours the buckets of the project of the API key
+// stored during the requested period, which ends now unless requested
+// otherwise
+func (s *Server) Usage(ctx context.Context, req *pb.UsageRequest) (resp *pb.UsageResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	authorization, err := auth.Check(ctx, s.keys, req.GetAPIKey(), macaroon.Action{
+		Op:     macaroon.OpRead,
+		Bucket: req.GetBucket(),
+		Time:   time.Now(),
+	})
+	if err != nil {
+		if !macaroon.ErrUnauthorized.Has(err) {
+			s.logger.Error("err checking api key", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		s.logger.Error("unauthorized request: ", zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "Invalid API credential")
+	}
+
+	var start, end time.Time
+	if req.GetStart() != nil {
+		if start, err = ptypes.Timestamp(req.GetStart()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	end = time.Now()
+	if req.GetEnd() != nil {
+		if end, err = ptypes.Timestamp(req.GetEnd()); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "usage period ends before it starts")
+	}
+
+	runs, err := s.db.Runs(ctx, start, end)
+	if err != nil {
+		s.logger.Error("err reading tally runs", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp = &pb.UsageResponse{}
+	if len(runs) == 0 {
+		return resp, nil
+	}
+
+	tallies, err := s.db.Tallies(ctx, authorization.ProjectID, req.GetBucket(), runs[0], end)
+	if err != nil {
+		s.logger.Error("err reading tallies", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp.Buckets = usage(runs, tallies, start, end)
+	for _, bucket := range resp.Buckets {
+		resp.RemoteByteHours += bucket.RemoteByteHours
+		resp.InlineByteHours += bucket.InlineByteHours
+	}
+	return resp, nil
+}
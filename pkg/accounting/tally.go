@@ -0,0 +1,162 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package accounting
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+)
+
+// Tally is the data a bucket of a project stored when the pointers were
+// tallied. Projects are identified by their ID, which is empty for the
+// pointers of the shared API key.
+type Tally struct {
+	ProjectID      string
+	Bucket         string
+	Time           time.Time
+	RemoteBytes    int64
+	InlineBytes    int64
+	RemoteSegments int64
+	InlineSegments int64
+}
+
+// TallyPointers walks the pointers of the store and sums their bytes per
+// project and bucket. The tallies are sorted by project and bucket.
+//
+// The pieces shared by several pointers, as after a copy, are stored once,
+// so they are tallied once, in the bucket of the first pointer referencing
+// them in the order of the store.
+func TallyPointers(ctx context.Context, pointers storage.KeyValueStore, now time.Time) (tallies []Tally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shared, err := pointerdb.SharedPieces(ctx, pointers)
+	if err != nil {
+		return nil, err
+	}
+	tallied := map[string]bool{}
+
+	type bucketKey struct{ projectID, bucket string }
+	sums := map[bucketKey]*Tally{}
+
+	err = pointerdb.WalkPointers(ctx, pointers, func(projectID, path string, pointer *pb.Pointer) error {
+		// the paths start with the kind of the segment and the bucket
+		parts := strings.SplitN(path, "/", 3)
+		if len(parts) < 2 {
+			return nil
+		}
+		key := bucketKey{projectID: projectID, bucket: parts[1]}
+		tally := sums[key]
+		if tally == nil {
+			tally = &Tally{ProjectID: projectID, Bucket: parts[1], Time: now}
+			sums[key] = tally
+		}
+
+		switch pointer.GetType() {
+		case pb.Pointer_REMOTE:
+			if pieceID := pointer.GetRemote().GetPieceId(); shared[pieceID] {
+				if tallied[pieceID] {
+					return nil
+				}
+				tallied[pieceID] = true
+			}
+			tally.RemoteBytes += pointer.GetSize()
+			tally.RemoteSegments++
+		case pb.Pointer_INLINE:
+			tally.InlineBytes += int64(len(pointer.GetInlineSegment()))
+			tally.InlineSegments++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tallies = make([]Tally, 0, len(sums))
+	for _, tally := range sums {
+		tallies = append(tallies, *tally)
+	}
+	sort.Slice(tallies, func(i, k int) bool {
+		if tallies[i].ProjectID != tallies[k].ProjectID {
+			return tallies[i].ProjectID < tallies[k].ProjectID
+		}
+		return tallies[i].Bucket < tallies[k].Bucket
+	})
+	return tallies, nil
+}
+
+// runTallies tallies the pointers at every interval and saves the tallies to
+// the database
+func runTallies(ctx context.Context, pointers storage.KeyValueStore, db *DB, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			tallies, err := TallyPointers(ctx, pointers, now)
+			if err != nil {
+				zap.L().Error("err tallying pointers", zap.Error(err))
+				continue
+			}
+			if err = db.SaveTallies(ctx, now, tallies); err != nil {
+				zap.L().Error("err saving tallies", zap.Error(err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// usage sums the tallies of the buckets to the byte-hours they stored
+// between start and end. runs are the times of the walks that produced the
+// tallies, in order, starting with the last walk before start. Every tally
+// counts until the next walk or end, and a bucket missing from a walk stored
+// nothing until the next.
+func usage(runs []time.Time, tallies []Tally, start, end time.Time) []*pb.BucketUsage {
+	byBucket := map[string]map[int64]Tally{}
+	var buckets []string
+	for _, tally := range tallies {
+		if byBucket[tally.Bucket] == nil {
+			byBucket[tally.Bucket] = map[int64]Tally{}
+			buckets = append(buckets, tally.Bucket)
+		}
+		byBucket[tally.Bucket][tally.Time.UnixNano()] = tally
+	}
+	sort.Strings(buckets)
+
+	usages := make([]*pb.BucketUsage, 0, len(buckets))
+	for _, bucket := range buckets {
+		bucketUsage := &pb.BucketUsage{Bucket: bucket}
+		for i, run := range runs {
+			from, until := run, end
+			if i+1 < len(runs) && runs[i+1].Before(end) {
+				until = runs[i+1]
+			}
+			if from.Before(start) {
+				from = start
+			}
+			tally := byBucket[bucket][run.UnixNano()]
+			if until.After(from) {
+				hours := until.Sub(from).Hours()
+				bucketUsage.RemoteByteHours += float64(tally.RemoteBytes) * hours
+				bucketUsage.InlineByteHours += float64(tally.InlineBytes) * hours
+			}
+			bucketUsage.RemoteBytes = tally.RemoteBytes
+			bucketUsage.InlineBytes = tally.InlineBytes
+			bucketUsage.RemoteSegments = tally.RemoteSegments
+			bucketUsage.InlineSegments = tally.InlineSegments
+		}
+		usages = append(usages, bucketUsage)
+	}
+	return usages
+}
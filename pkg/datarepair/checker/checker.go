@@ -11,7 +11,13 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/provider"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/redis"
 )
 
 var (
@@ -20,10 +26,12 @@ var (
 	Error = errs.Class("checker error")
 )
 
-// Config contains configurable values for checker
+// Config contains configurable values for checker, which checks the segments
+// of all projects. It needs the pointerdb and overlay responsibilities
+// started before it.
 type Config struct {
-	// QueueAddress string `help:"data repair queue address" default:"redis://localhost:6379?db=5&password=123"`
-	Interval time.Duration `help:"how frequently checker should audit segments" default:"30s"`
+	QueueAddress string        `help:"data repair queue address" default:"redis://localhost:6379?db=5&password=123"`
+	Interval     time.Duration `help:"how frequently checker should audit segments" default:"30s"`
 }
 
 // Run runs the checker with configured values
@@ -32,6 +40,20 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 
 	zap.S().Info("Checker is starting up")
 
+	pointers := pointerdb.LoadFromContext(ctx)
+	if pointers == nil {
+		return Error.New("checker requires the pointerdb responsibility")
+	}
+	cache := overlay.LoadFromContext(ctx)
+	if cache == nil {
+		return Error.New("overlay cache is not configured")
+	}
+	client, err := redis.NewClientFrom(c.QueueAddress)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	checker := NewChecker(pointers, cache, queue.NewQueue(client))
+
 	ticker := time.NewTicker(c.Interval)
 	defer ticker.Stop()
 
@@ -43,6 +65,9 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 			select {
 			case <-ticker.C:
 				zap.S().Info("Starting segment checker service")
+				if err := checker.IdentifyInjuredSegments(ctx); err != nil {
+					zap.S().Errorf("Segment check failed: %v", err)
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -51,3 +76,70 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 
 	return server.Run(ctx)
 }
+
+// Checker finds the segments that lost too many pieces and queues them for
+// repair
+type Checker struct {
+	pointers storage.KeyValueStore
+	cache    *overlay.Cache
+	queue    queue.RepairQueue
+}
+
+// NewChecker creates a checker of the segments of the pointer store
+func NewChecker(pointers storage.KeyValueStore, cache *overlay.Cache, queue queue.RepairQueue) *Checker {
+	return &Checker{pointers: pointers, cache: cache, queue: queue}
+}
+
+// IdentifyInjuredSegments walks the segments of all projects in the pointer
+// store and enqueues the remote segments whose pieces on known nodes dropped
+// below the repair threshold. The segments are queued with the keys of their
+// pointers, like by the NodeChecker.
+func (c *Checker) IdentifyInjuredSegments(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return pointerdb.WalkPointers(ctx, c.pointers, func(projectID, path string, pointer *pb.Pointer) error {
+		seg, err := injuredSegment(ctx, c.cache, pointerdb.PointerKey(projectID, path), pointer, nil)
+		if err != nil || seg == nil {
+			return err
+		}
+		return Error.Wrap(c.queue.Enqueue(seg))
+	})
+}
+
+// injuredSegment returns the segment to repair if the pieces of the pointer
+// that are on nodes known to the cache and not down dropped below the repair
+// threshold, or nil otherwise. isDown may be nil.
+func injuredSegment(ctx context.Context, cache *overlay.Cache, path string, pointer *pb.Pointer, isDown func(nodeID string) bool) (*pb.InjuredSegment, error) {
+	if pointer == nil || pointer.GetType() != pb.Pointer_REMOTE {
+		return nil, nil
+	}
+	remote := pointer.GetRemote()
+	pieces := remote.GetRemotePieces()
+	if len(pieces) == 0 {
+		return nil, nil
+	}
+
+	nodeIDs := make([]string, len(pieces))
+	for i, piece := range pieces {
+		nodeIDs[i] = piece.NodeId
+	}
+	nodes, err := cache.GetAll(ctx, nodeIDs)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	var lostPieces []int32
+	for i, node := range nodes {
+		if node == nil || (isDown != nil && isDown(pieces[i].NodeId)) {
+			lostPieces = append(lostPieces, pieces[i].PieceNum)
+		}
+	}
+	if int32(len(pieces)-len(lostPieces)) >= remote.GetRedundancy().GetRepairThreshold() {
+		return nil, nil
+	}
+
+	return &pb.InjuredSegment{
+		Path:       path,
+		LostPieces: lostPieces,
+	}, nil
+}
@@ -2,3 +2,75 @@
 // See LICENSE for copying information.
 
 package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+var ctx = context.Background()
+
+func remotePointer(threshold int32, nodeIDs ...string) *pb.Pointer {
+	pieces := make([]*pb.RemotePiece, len(nodeIDs))
+	for i, nodeID := range nodeIDs {
+		pieces[i] = &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID}
+	}
+	return &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			Redundancy:   &pb.RedundancyScheme{RepairThreshold: threshold},
+			RemotePieces: pieces,
+		},
+	}
+}
+
+func TestIdentifyInjuredSegments(t *testing.T) {
+	cache := &overlay.Cache{DB: teststore.New()}
+	for _, nodeID := range []string{"node1", "node2", "node3"} {
+		assert.NoError(t, cache.Put(nodeID, pb.Node{Id: nodeID}))
+	}
+	repairQueue := queue.NewQueue(teststore.New())
+
+	// the segments of every project are checked
+	pointers := teststore.New()
+	for path, pointer := range map[string]*pb.Pointer{
+		"healthy":                   remotePointer(2, "node1", "node2", "lost1"),
+		"injured":                   remotePointer(3, "node1", "lost1", "node3", "lost2"),
+		"inline":                    {Type: pb.Pointer_INLINE},
+		"projects/project1/injured": remotePointer(2, "lost1", "node2"),
+		"projects/project2/healthy": remotePointer(1, "lost1", "node2"),
+	} {
+		value, err := proto.Marshal(pointer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NoError(t, pointers.Put(storage.Key(path), value))
+	}
+
+	checker := NewChecker(pointers, cache, repairQueue)
+	if !assert.NoError(t, checker.IdentifyInjuredSegments(ctx)) {
+		return
+	}
+
+	for _, expected := range []*pb.InjuredSegment{
+		{Path: "injured", LostPieces: []int32{1, 3}},
+		{Path: "projects/project1/injured", LostPieces: []int32{0}},
+	} {
+		seg, err := repairQueue.Dequeue()
+		if assert.NoError(t, err) {
+			assert.Equal(t, expected.Path, seg.Path)
+			assert.Equal(t, expected.LostPieces, seg.LostPieces)
+		}
+	}
+	_, err := repairQueue.Dequeue()
+	assert.Error(t, err)
+}
@@ -0,0 +1,191 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/redis"
+)
+
+// NodeConfig contains configurable values for the node checker, which queues
+// the segments of the nodes that go offline or get disqualified as soon as
+// statdb notices it. It needs the pointerdb, overlay and statdb
+// responsibilities started before it.
+type NodeConfig struct {
+	QueueAddress  string  `help:"data repair queue address, or empty to not check the nodes that go down" default:""`
+	MaxRepairRate float64 `help:"maximum number of segments of down nodes queued for repair per second" default:"100"`
+}
+
+// Run runs the node checker with configured values
+func (c NodeConfig) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if c.QueueAddress == "" {
+		return server.Run(ctx)
+	}
+
+	pointers := pointerdb.LoadFromContext(ctx)
+	if pointers == nil {
+		return Error.New("node checker requires the pointerdb responsibility")
+	}
+	cache := overlay.LoadFromContext(ctx)
+	if cache == nil {
+		return Error.New("overlay cache is not configured")
+	}
+	stats := statdb.LoadFromContext(ctx)
+	if stats == nil {
+		return Error.New("node checker requires the statdb responsibility")
+	}
+	if c.MaxRepairRate <= 0 {
+		return Error.New("max repair rate must be positive")
+	}
+
+	client, err := redis.NewClientFrom(c.QueueAddress)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	checker := NewNodeChecker(pointers, cache, queue.NewQueue(client), rate.NewLimiter(rate.Limit(c.MaxRepairRate), 1))
+	stats.Subscribe(checker.HandleEvent)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		if err := checker.Run(ctx); err != nil && err != context.Canceled {
+			zap.S().Errorf("Node checker stopped: %v", err)
+		}
+	}()
+
+	return server.Run(ctx)
+}
+
+// NodeChecker queues the segments of the storage nodes that went down for
+// repair, without waiting for the next pass of the Checker
+type NodeChecker struct {
+	pointers storage.KeyValueStore
+	cache    *overlay.Cache
+	queue    queue.RepairQueue
+	limiter  *rate.Limiter
+
+	mu      sync.Mutex
+	down    map[string]bool
+	pending []string
+	wake    chan struct{}
+}
+
+// NewNodeChecker creates a node checker of the segments of the pointer store,
+// which queues at most as many segments as the limiter allows
+func NewNodeChecker(pointers storage.KeyValueStore, cache *overlay.Cache, queue queue.RepairQueue, limiter *rate.Limiter) *NodeChecker {
+	return &NodeChecker{
+		pointers: pointers,
+		cache:    cache,
+		queue:    queue,
+		limiter:  limiter,
+		down:     map[string]bool{},
+		wake:     make(chan struct{}, 1),
+	}
+}
+
+// HandleEvent records the state of the node and schedules a check of its
+// segments if it went down. It does not block, so it can be subscribed to
+// statdb.
+func (c *NodeChecker) HandleEvent(event statdb.NodeEvent) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if event.State == statdb.NodeOnline {
+		delete(c.down, event.NodeID)
+		return
+	}
+	if c.down[event.NodeID] {
+		return
+	}
+	c.down[event.NodeID] = true
+	c.pending = append(c.pending, event.NodeID)
+
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// isDown returns whether the node went down and has not come back since
+func (c *NodeChecker) isDown(nodeID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.down[nodeID]
+}
+
+// next returns the next node to check, or false if there is none
+func (c *NodeChecker) next() (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.pending) == 0 {
+		return "", false
+	}
+	nodeID := c.pending[0]
+	c.pending = c.pending[1:]
+	return nodeID, true
+}
+
+// Run checks the nodes that went down, one at a time, until the context is
+// canceled
+func (c *NodeChecker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		nodeID, ok := c.next()
+		if !ok {
+			select {
+			case <-c.wake:
+				continue
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		if !c.isDown(nodeID) {
+			continue
+		}
+
+		zap.S().Infof("Checking the segments of down node %s", nodeID)
+		if err := c.CheckNode(ctx, nodeID); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			zap.S().Errorf("Node check of %s failed: %v", nodeID, err)
+		}
+	}
+}
+
+// CheckNode queues the segments with pieces on the node whose pieces on nodes
+// that are neither down nor missing from the overlay cache dropped below the
+// repair threshold. The pieces on the node itself count as lost.
+func (c *NodeChecker) CheckNode(ctx context.Context, nodeID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	isDown := func(id string) bool {
+		return id == nodeID || c.isDown(id)
+	}
+	return pointerdb.WalkNodeSegments(ctx, c.pointers, nodeID, func(path string, pointer *pb.Pointer) error {
+		seg, err := injuredSegment(ctx, c.cache, path, pointer, isDown)
+		if err != nil || seg == nil {
+			return err
+		}
+		if err = c.limiter.Wait(ctx); err != nil {
+			return err
+		}
+		return Error.Wrap(c.queue.Enqueue(seg))
+	})
+}
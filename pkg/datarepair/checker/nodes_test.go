@@ -0,0 +1,81 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package checker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"storj.io/storj/pkg/datarepair/queue"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestNodeChecker(t *testing.T) {
+	cache := &overlay.Cache{DB: teststore.New()}
+	for _, nodeID := range []string{"node1", "node2", "node3", "node4"} {
+		assert.NoError(t, cache.Put(nodeID, pb.Node{Id: nodeID}))
+	}
+	repairQueue := queue.NewQueue(teststore.New())
+
+	pointers := teststore.New()
+	for path, pointer := range map[string]*pb.Pointer{
+		"l/bucket/healthy": remotePointer(2, "node1", "node2", "node3"),
+		"l/bucket/injured": remotePointer(3, "node2", "node1", "node3", "node4"),
+		"l/bucket/other":   remotePointer(1, "node2", "node3"),
+	} {
+		value, err := proto.Marshal(pointer)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.NoError(t, pointers.Put(storage.Key(path), value))
+	}
+	if _, err := pointerdb.RebuildNodeIndex(ctx, pointers); err != nil {
+		t.Fatal(err)
+	}
+
+	checker := NewNodeChecker(pointers, cache, repairQueue, rate.NewLimiter(rate.Inf, 1))
+
+	// node4 is down but known to the overlay, so only the node checker
+	// counts its pieces as lost
+	checker.HandleEvent(statdb.NodeEvent{NodeID: "node4", State: statdb.NodeOffline})
+	checker.HandleEvent(statdb.NodeEvent{NodeID: "node4", State: statdb.NodeOnline})
+	checker.HandleEvent(statdb.NodeEvent{NodeID: "node4", State: statdb.NodeDisqualified})
+	assert.NoError(t, checker.CheckNode(ctx, "node1"))
+
+	seg, err := repairQueue.Dequeue()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "l/bucket/injured", seg.Path)
+		assert.Equal(t, []int32{1, 3}, seg.LostPieces)
+	}
+	_, err = repairQueue.Dequeue()
+	assert.Error(t, err)
+
+	// Run checks the nodes reported down in the background
+	runCtx, cancel := context.WithCancel(ctx)
+	done := make(chan error)
+	go func() { done <- checker.Run(runCtx) }()
+	checker.HandleEvent(statdb.NodeEvent{NodeID: "node2", State: statdb.NodeOffline})
+
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if seg, err = repairQueue.Dequeue(); err == nil {
+			break
+		}
+	}
+	if assert.NoError(t, err) {
+		assert.Equal(t, "l/bucket/injured", seg.Path)
+		assert.Equal(t, []int32{0, 3}, seg.LostPieces)
+	}
+	cancel()
+	assert.Equal(t, context.Canceled, <-done)
+}
@@ -0,0 +1,47 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limits
+
+import (
+	"context"
+	"time"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pointerdb/auth"
+	"storj.io/storj/pkg/provider"
+)
+
+// Config is a configuration struct that is everything you need to limit the
+// requests of the API keys to the responsibilities of the same provider.
+// Once a limit is set, the requests to pointerdb and the overlay need a valid
+// API key. The quotas need the accounting responsibility started before it.
+type Config struct {
+	RequestRate    float64       `help:"maximum requests per second of an API key and the keys restricted from it, or 0 for no limit" default:"0"`
+	RequestBurst   int           `help:"maximum requests of an API key at once" default:"100"`
+	StorageQuota   int64         `help:"maximum bytes stored by a project as of its last tally, or 0 for no quota" default:"0"`
+	BandwidthQuota int64         `help:"maximum bytes of the segments a project commits and downloads per quota period, or 0 for no quota" default:"0"`
+	QuotaPeriod    time.Duration `help:"period of the bandwidth quota" default:"720h"`
+}
+
+// Run implements the provider.Responsibility interface
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if c.RequestRate < 0 || (c.RequestRate > 0 && c.RequestBurst <= 0) {
+		return Error.New("invalid request rate %v with burst %d", c.RequestRate, c.RequestBurst)
+	}
+	if c.BandwidthQuota > 0 && c.QuotaPeriod <= 0 {
+		return Error.New("bandwidth quota requires a quota period")
+	}
+
+	tallies := accounting.LoadFromContext(ctx)
+	if (c.StorageQuota > 0 || c.BandwidthQuota > 0) && tallies == nil {
+		return Error.New("quotas require the accounting responsibility")
+	}
+
+	limiter := NewLimiter(c, auth.LoadFromContext(ctx), tallies)
+	server.AddInterceptors(limiter.UnaryInterceptor, limiter.StreamInterceptor)
+
+	return server.Run(ctx)
+}
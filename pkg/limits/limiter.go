@@ -0,0 +1,391 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limits
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
+)
+
+// storageCacheTTL is how long the stored bytes of a project are cached, as
+// the tallies change at most once per tally interval
+const storageCacheTTL = time.Minute
+
+// bandwidthCacheTTL is how long the used bandwidth of a project is cached
+// before it is read again, to see the bandwidth the other satellite processes
+// added
+const bandwidthCacheTTL = time.Minute
+
+// limitedServices are the prefixes of the methods whose requests need an API
+// key once a limit is configured
+var limitedServices = []string{"/pointerdb.PointerDB/", "/overlay.Overlay/"}
+
+// sweepInterval is how often the request rates of idle API keys are dropped
+const sweepInterval = time.Minute
+
+// apiKeyRequest is implemented by the requests that carry an API key
+type apiKeyRequest interface {
+	GetAPIKey() []byte
+}
+
+type keyRate struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+type projectBandwidth struct {
+	period  time.Time
+	bytes   int64
+	fetched time.Time
+}
+
+type projectStorage struct {
+	bytes   int64
+	fetched time.Time
+}
+
+// Limiter limits the request rate of every API key, along with the keys
+// restricted from it, and the storage and bandwidth of every project. Once a
+// limit is configured, the requests to pointerdb and the overlay without a
+// valid API key are rejected.
+//
+// The stored bytes of a project are those of its last tally. The bandwidth
+// of a project is the size of the segments it committed and of the segments
+// it got with their pieces or inline data in the current quota period. As
+// the satellite does not see the transfers to the storage nodes, this is an
+// upper bound of the bytes transferred: a download stopped early counts in
+// full. The bandwidth is kept with the tallies, so it is shared by the
+// satellite processes and survives restarts.
+type Limiter struct {
+	config  Config
+	keys    *auth.KeyStore
+	tallies *accounting.DB
+
+	mu        sync.Mutex
+	rates     map[string]*keyRate
+	lastSweep time.Time
+	bandwidth map[string]*projectBandwidth
+	storage   map[string]*projectStorage
+}
+
+// NewLimiter creates a limiter of the requests with the limits of config. The
+// projects of the API keys are looked up in keys, or the shared API key is
+// used if it is nil. tallies may be nil without a storage or bandwidth
+// quota.
+func NewLimiter(config Config, keys *auth.KeyStore, tallies *accounting.DB) *Limiter {
+	return &Limiter{
+		config:    config,
+		keys:      keys,
+		tallies:   tallies,
+		rates:     map[string]*keyRate{},
+		lastSweep: time.Now(),
+		bandwidth: map[string]*projectBandwidth{},
+		storage:   map[string]*projectStorage{},
+	}
+}
+
+// UnaryInterceptor is a grpc.UnaryServerInterceptor enforcing the limits
+func (l *Limiter) UnaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	if !l.limited() || !limitedMethod(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	var apiKey []byte
+	if keyReq, ok := req.(apiKeyRequest); ok {
+		apiKey = keyReq.GetAPIKey()
+	}
+	// the API key is authorized before it is given a request rate, so that
+	// invalid keys cannot fill the rates
+	authorization, err := auth.Authorize(ctx, l.keys, apiKey)
+	if err != nil {
+		if !macaroon.ErrUnauthorized.Has(err) {
+			zap.S().Errorf("err checking api key: %v", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return nil, status.Error(codes.Unauthenticated, "Invalid API credential")
+	}
+	if err = l.allow(apiKey); err != nil {
+		return nil, err
+	}
+
+	putBytes, upload, metered := requestBytes(req)
+	if !metered || (l.config.StorageQuota <= 0 && l.config.BandwidthQuota <= 0) {
+		return handler(ctx, req)
+	}
+	projectID := authorization.ProjectID
+	if err = l.checkQuotas(ctx, projectID, putBytes, upload); err != nil {
+		return nil, err
+	}
+
+	resp, err = handler(ctx, req)
+	if err == nil {
+		l.addBandwidth(ctx, projectID, transferredBytes(req, resp))
+	}
+	return resp, err
+}
+
+// limited returns whether any limit is configured
+func (l *Limiter) limited() bool {
+	return l.config.RequestRate > 0 || l.config.StorageQuota > 0 || l.config.BandwidthQuota > 0
+}
+
+// limitedMethod returns whether the requests of the method need an API key
+func limitedMethod(fullMethod string) bool {
+	for _, service := range limitedServices {
+		if strings.HasPrefix(fullMethod, service) {
+			return true
+		}
+	}
+	return false
+}
+
+// StreamInterceptor is a grpc.StreamServerInterceptor enforcing the request
+// rates on the messages the clients stream
+func (l *Limiter) StreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &limitedStream{ServerStream: ss, limiter: l})
+}
+
+type limitedStream struct {
+	grpc.ServerStream
+	limiter *Limiter
+}
+
+// RecvMsg implements grpc.ServerStream
+func (s *limitedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if keyReq, ok := m.(apiKeyRequest); ok {
+		return s.limiter.allow(keyReq.GetAPIKey())
+	}
+	return nil
+}
+
+// allow takes a request from the rate of the API key, or returns a
+// ResourceExhausted error with the time until the key may make it
+func (l *Limiter) allow(apiKey []byte) error {
+	if l.config.RequestRate <= 0 {
+		return nil
+	}
+	id := string(auth.KeyID(apiKey))
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) > sweepInterval {
+		// the rates that had the time to refill are dropped without loss
+		refill := time.Duration(float64(l.config.RequestBurst) / l.config.RequestRate * float64(time.Second))
+		for id, r := range l.rates {
+			if now.Sub(r.lastSeen) > refill {
+				delete(l.rates, id)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	r, ok := l.rates[id]
+	if !ok {
+		r = &keyRate{limiter: rate.NewLimiter(rate.Limit(l.config.RequestRate), l.config.RequestBurst)}
+		l.rates[id] = r
+	}
+	r.lastSeen = now
+
+	reservation := r.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return exhausted("api_key", "request rate of the API key exceeded", delay)
+	}
+	return nil
+}
+
+// checkQuotas returns a ResourceExhausted error if the project exceeded a
+// quota, or if uploading putBytes would exceed it
+func (l *Limiter) checkQuotas(ctx context.Context, projectID string, putBytes int64, upload bool) error {
+	subject := "project:" + projectID
+
+	if l.config.BandwidthQuota > 0 {
+		used, periodEnd, err := l.usedBandwidth(ctx, projectID)
+		if err != nil {
+			zap.S().Errorf("err reading used bandwidth: %v", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+		if used >= l.config.BandwidthQuota || used+putBytes > l.config.BandwidthQuota {
+			return exhausted(subject, "bandwidth quota of the project exceeded", time.Until(periodEnd))
+		}
+	}
+
+	if l.config.StorageQuota > 0 && upload {
+		stored, err := l.storedBytes(ctx, projectID)
+		if err != nil {
+			zap.S().Errorf("err reading stored bytes: %v", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+		if stored >= l.config.StorageQuota || stored+putBytes > l.config.StorageQuota {
+			return exhausted(subject, "storage quota of the project exceeded", 0)
+		}
+	}
+	return nil
+}
+
+// usedBandwidth returns the bandwidth the project used in the current quota
+// period and the end of the period
+func (l *Limiter) usedBandwidth(ctx context.Context, projectID string) (used int64, periodEnd time.Time, err error) {
+	period := time.Now().Truncate(l.config.QuotaPeriod)
+	periodEnd = period.Add(l.config.QuotaPeriod)
+
+	l.mu.Lock()
+	cached, ok := l.bandwidth[projectID]
+	l.mu.Unlock()
+	if ok && cached.period.Equal(period) && time.Since(cached.fetched) < bandwidthCacheTTL {
+		return cached.bytes, periodEnd, nil
+	}
+
+	used, err = l.tallies.UsedBandwidth(ctx, projectID, period)
+	if err != nil {
+		return 0, periodEnd, err
+	}
+
+	l.mu.Lock()
+	l.bandwidth[projectID] = &projectBandwidth{period: period, bytes: used, fetched: time.Now()}
+	l.mu.Unlock()
+	return used, periodEnd, nil
+}
+
+// addBandwidth adds bytes to the bandwidth the project used in the current
+// quota period
+func (l *Limiter) addBandwidth(ctx context.Context, projectID string, bytes int64) {
+	if l.config.BandwidthQuota <= 0 || bytes == 0 {
+		return
+	}
+	period := time.Now().Truncate(l.config.QuotaPeriod)
+
+	if err := l.tallies.AddBandwidth(ctx, projectID, period, bytes); err != nil {
+		// the request succeeded, so it is not failed for its accounting
+		zap.S().Errorf("err adding used bandwidth: %v", err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if cached, ok := l.bandwidth[projectID]; ok && cached.period.Equal(period) {
+		l.bandwidth[projectID] = &projectBandwidth{period: period, bytes: cached.bytes + bytes, fetched: cached.fetched}
+	}
+}
+
+// storedBytes returns the bytes the project stored as of its last tally
+func (l *Limiter) storedBytes(ctx context.Context, projectID string) (int64, error) {
+	l.mu.Lock()
+	cached, ok := l.storage[projectID]
+	l.mu.Unlock()
+	if ok && time.Since(cached.fetched) < storageCacheTTL {
+		return cached.bytes, nil
+	}
+
+	bytes, err := l.tallies.StoredBytes(ctx, projectID)
+	if err != nil {
+		return 0, err
+	}
+
+	l.mu.Lock()
+	l.storage[projectID] = &projectStorage{bytes: bytes, fetched: time.Now()}
+	l.mu.Unlock()
+	return bytes, nil
+}
+
+// requestBytes returns the size of the segments committed by the request,
+// whether the request is part of an upload, and whether it is checked
+// against the quotas. Choosing the storage nodes starts an upload.
+func requestBytes(req interface{}) (putBytes int64, upload, metered bool) {
+	switch req := req.(type) {
+	case *pb.PutRequest:
+		return req.GetPointer().GetSize(), true, true
+	case *pb.BatchPutRequest:
+		for _, put := range req.GetRequests() {
+			putBytes += put.GetPointer().GetSize()
+		}
+		return putBytes, true, true
+	case *pb.FindStorageNodesRequest:
+		return 0, true, true
+	case *pb.GetRequest, *pb.BatchGetRequest:
+		return 0, false, true
+	}
+	return 0, false, false
+}
+
+// transferredBytes returns the size of the segments committed or downloaded
+// by the request. A segment is downloaded when it is got with the locations
+// of its pieces or with its inline data; getting its metadata only is free.
+func transferredBytes(req interface{}, resp interface{}) (bytes int64) {
+	switch req := req.(type) {
+	case *pb.PutRequest:
+		return req.GetPointer().GetSize()
+	case *pb.BatchPutRequest:
+		results, _ := resp.(*pb.BatchPutResponse)
+		for i, result := range results.GetResults() {
+			if i < len(req.GetRequests()) && codes.Code(result.GetCode()) == codes.OK {
+				bytes += req.GetRequests()[i].GetPointer().GetSize()
+			}
+		}
+		return bytes
+	}
+
+	switch resp := resp.(type) {
+	case *pb.GetResponse:
+		return downloadedBytes(resp.GetPointer())
+	case *pb.BatchGetResponse:
+		for _, item := range resp.GetItems() {
+			bytes += downloadedBytes(item.GetPointer())
+		}
+	}
+	return bytes
+}
+
+// downloadedBytes returns the size of the segment of the pointer if the
+// pointer allows downloading it
+func downloadedBytes(pointer *pb.Pointer) int64 {
+	if pointer.GetRemote() == nil && len(pointer.GetInlineSegment()) == 0 {
+		return 0
+	}
+	return pointer.GetSize()
+}
+
+// exhausted returns a ResourceExhausted error with the violated limit and, if
+// retryDelay is positive, when the request may be retried. The hint is in the
+// message too, for the clients that do not read the details.
+func exhausted(subject, description string, retryDelay time.Duration) error {
+	details := []proto.Message{&errdetails.QuotaFailure{
+		Violations: []*errdetails.QuotaFailure_Violation{{Subject: subject, Description: description}},
+	}}
+	message := description
+	if retryDelay > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryDelay)})
+		message = fmt.Sprintf("%s, retry in %v", description, retryDelay.Round(time.Millisecond))
+	}
+
+	st := status.New(codes.ResourceExhausted, message)
+	if withDetails, err := st.WithDetails(details...); err == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package limits
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
+	"storj.io/storj/storage/teststore"
+)
+
+var ctx = context.Background()
+
+const (
+	pointerdbMethod = "/pointerdb.PointerDB/Get"
+	overlayMethod   = "/overlay.Overlay/FindStorageNodes"
+)
+
+// retryDelay returns the retry hint of a ResourceExhausted error, or 0 if it
+// has none
+func retryDelay(t *testing.T, err error) time.Duration {
+	st := status.Convert(err)
+	if !assert.Equal(t, codes.ResourceExhausted, st.Code()) {
+		return 0
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok {
+			delay, err := ptypes.Duration(info.RetryDelay)
+			assert.NoError(t, err)
+			return delay
+		}
+	}
+	return 0
+}
+
+func call(l *Limiter, method string, req interface{}, resp interface{}) error {
+	_, err := l.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return resp, nil
+	})
+	return err
+}
+
+// newKey creates a project in keys and returns its ID and an API key for it
+func newKey(t *testing.T, keys *auth.KeyStore) (projectID string, apiKey []byte) {
+	project, err := keys.CreateProject(ctx, "project")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := keys.Create(ctx, project.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialized, err := key.Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return project.Id, []byte(serialized)
+}
+
+// openTallies opens an accounting database in a temporary directory and
+// returns a function removing it
+func openTallies(t *testing.T) (*accounting.DB, func()) {
+	tempdir, err := ioutil.TempDir("", "storj-limits")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tallies, err := accounting.Open("sqlite://" + filepath.Join(tempdir, "accounting.db"))
+	if err != nil {
+		_ = os.RemoveAll(tempdir)
+		t.Fatal(err)
+	}
+	return tallies, func() {
+		assert.NoError(t, tallies.Close())
+		_ = os.RemoveAll(tempdir)
+	}
+}
+
+func TestRequestRate(t *testing.T) {
+	keys := auth.NewKeyStore(teststore.New())
+	_, key := newKey(t, keys)
+	_, otherKey := newKey(t, keys)
+	l := NewLimiter(Config{RequestRate: 1, RequestBurst: 2}, keys, nil)
+
+	assert.NoError(t, call(l, pointerdbMethod, &pb.ListRequest{APIKey: key}, &pb.ListResponse{}))
+	assert.NoError(t, call(l, pointerdbMethod, &pb.ListRequest{APIKey: key}, &pb.ListResponse{}))
+	err := call(l, pointerdbMethod, &pb.ListRequest{APIKey: key}, &pb.ListResponse{})
+	delay := retryDelay(t, err)
+	assert.True(t, delay > 0 && delay <= time.Second, delay)
+
+	// other keys have their own rates
+	assert.NoError(t, call(l, overlayMethod, &pb.LookupRequest{APIKey: otherKey}, &pb.LookupResponse{}))
+}
+
+func TestAPIKeyRequired(t *testing.T) {
+	keys := auth.NewKeyStore(teststore.New())
+	_, key := newKey(t, keys)
+	l := NewLimiter(Config{RequestRate: 100, RequestBurst: 100}, keys, nil)
+
+	for _, method := range []string{pointerdbMethod, overlayMethod} {
+		for _, apiKey := range [][]byte{nil, []byte("invalid")} {
+			err := call(l, method, &pb.LookupRequest{APIKey: apiKey}, &pb.LookupResponse{})
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), method)
+		}
+		assert.NoError(t, call(l, method, &pb.LookupRequest{APIKey: key}, &pb.LookupResponse{}))
+	}
+	// the invalid keys are not given request rates
+	assert.Len(t, l.rates, 1)
+
+	// the other services and the requests without limits need no key
+	assert.NoError(t, call(l, "/overlay.Nodes/Query", &pb.QueryRequest{}, &pb.QueryResponse{}))
+	unlimited := NewLimiter(Config{}, keys, nil)
+	assert.NoError(t, call(unlimited, overlayMethod, &pb.LookupRequest{}, &pb.LookupResponse{}))
+}
+
+func TestBandwidthQuota(t *testing.T) {
+	tallies, cleanup := openTallies(t)
+	defer cleanup()
+
+	keys := auth.NewKeyStore(teststore.New())
+	projectID, key := newKey(t, keys)
+	config := Config{BandwidthQuota: 100, QuotaPeriod: time.Hour}
+	l := NewLimiter(config, keys, tallies)
+
+	put := func(size int64) error {
+		return call(l, pointerdbMethod, &pb.PutRequest{Pointer: &pb.Pointer{Size: size}, APIKey: key}, &pb.PutResponse{})
+	}
+	get := func(pointer *pb.Pointer) error {
+		return call(l, pointerdbMethod, &pb.GetRequest{APIKey: key}, &pb.GetResponse{Pointer: pointer})
+	}
+	remote := func(size int64) *pb.Pointer {
+		return &pb.Pointer{Size: size, Remote: &pb.RemoteSegment{}}
+	}
+
+	assert.NoError(t, put(40))
+	delay := retryDelay(t, put(61))
+	assert.True(t, delay > 0 && delay <= time.Hour, delay)
+
+	// only the puts that succeeded count
+	err := call(l, pointerdbMethod, &pb.BatchPutRequest{
+		Requests: []*pb.PutRequest{{Pointer: &pb.Pointer{Size: 10}}, {Pointer: &pb.Pointer{Size: 50}}},
+		APIKey:   key,
+	}, &pb.BatchPutResponse{
+		Results: []*pb.BatchResult{{Code: int32(codes.OK)}, {Code: int32(codes.NotFound)}},
+	})
+	assert.NoError(t, err)
+
+	// the metadata of the segments is free
+	assert.NoError(t, get(&pb.Pointer{Size: 1000}))
+	err = call(l, pointerdbMethod, &pb.BatchGetRequest{APIKey: key}, &pb.BatchGetResponse{
+		Items: []*pb.BatchGetResponse_Item{{Pointer: &pb.Pointer{Size: 1000}}, {Pointer: remote(30)}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, get(&pb.Pointer{Size: 20, InlineSegment: []byte("inline")}))
+
+	used, err := tallies.UsedBandwidth(ctx, projectID, time.Now().Truncate(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), used)
+
+	// the bandwidth is kept with the tallies, so it is shared by the limiters
+	for _, limiter := range []*Limiter{l, NewLimiter(config, keys, tallies)} {
+		l = limiter
+		delay = retryDelay(t, get(remote(1)))
+		assert.True(t, delay > 0 && delay <= time.Hour, delay)
+		// no storage nodes are chosen for uploads either
+		err = call(l, overlayMethod, &pb.FindStorageNodesRequest{APIKey: key}, &pb.FindStorageNodesResponse{})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+	// the other requests do not count
+	assert.NoError(t, call(l, pointerdbMethod, &pb.ListRequest{APIKey: key}, &pb.ListResponse{}))
+}
+
+func TestStorageQuota(t *testing.T) {
+	tallies, cleanup := openTallies(t)
+	defer cleanup()
+
+	keys := auth.NewKeyStore(teststore.New())
+	projectID, key := newKey(t, keys)
+	err := tallies.SaveTallies(ctx, time.Now(), []accounting.Tally{{ProjectID: projectID, Bucket: "bucket", RemoteBytes: 900}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	l := NewLimiter(Config{StorageQuota: 1000}, keys, tallies)
+	put := func(sizes ...int64) error {
+		req := &pb.BatchPutRequest{APIKey: key}
+		for _, size := range sizes {
+			req.Requests = append(req.Requests, &pb.PutRequest{Pointer: &pb.Pointer{Size: size}})
+		}
+		return call(l, pointerdbMethod, req, &pb.BatchPutResponse{})
+	}
+
+	assert.NoError(t, put(50, 50))
+	err = put(50, 51)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, time.Duration(0), retryDelay(t, err))
+	assert.NoError(t, call(l, pointerdbMethod, &pb.GetRequest{APIKey: key}, &pb.GetResponse{Pointer: &pb.Pointer{Size: 1000}}))
+	assert.NoError(t, call(l, overlayMethod, &pb.FindStorageNodesRequest{APIKey: key}, &pb.FindStorageNodesResponse{}))
+
+	// a full project cannot choose storage nodes for uploads
+	err = tallies.SaveTallies(ctx, time.Now(), []accounting.Tally{{ProjectID: projectID, Bucket: "bucket", RemoteBytes: 1000}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	full := NewLimiter(Config{StorageQuota: 1000}, keys, tallies)
+	err = call(full, overlayMethod, &pb.FindStorageNodesRequest{APIKey: key}, &pb.FindStorageNodesResponse{})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
@@ -0,0 +1,201 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"crypto/rand"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58/base58"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+)
+
+// Op is the kind of operation a request performs
+type Op int
+
+const (
+	// OpRead reads a path
+	OpRead Op = iota + 1
+	// OpWrite creates or replaces a path
+	OpWrite
+	// OpList lists the paths below a prefix
+	OpList
+	// OpDelete deletes a path
+	OpDelete
+)
+
+// Action is an operation on a path an API key is checked against. The
+// bucket and the encrypted path are empty for requests that do not
+// concern a path.
+type Action struct {
+	Op            Op
+	Bucket        string
+	EncryptedPath string
+	Time          time.Time
+}
+
+// APIKey is an API key backed by a macaroon
+type APIKey struct {
+	mac *Macaroon
+}
+
+// NewAPIKey returns a new unrestricted API key for the root secret, with a
+// random ID
+func NewAPIKey(secret []byte) (*APIKey, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &APIKey{mac: NewUnrestricted(id, secret)}, nil
+}
+
+// ParseAPIKey parses a serialized API key
+func ParseAPIKey(key string) (*APIKey, error) {
+	data, err := base58.Decode(key)
+	if err != nil {
+		return nil, Error.New("invalid api key: %v", err)
+	}
+	mac := &pb.Macaroon{}
+	if err = proto.Unmarshal(data, mac); err != nil {
+		return nil, Error.New("invalid api key: %v", err)
+	}
+	if len(mac.GetHead()) == 0 || len(mac.GetTail()) == 0 {
+		return nil, Error.New("invalid api key: missing head or tail")
+	}
+	return &APIKey{mac: &Macaroon{head: mac.GetHead(), caveats: mac.GetCaveats(), tail: mac.GetTail()}}, nil
+}
+
+// ID returns the ID of the root secret of the API key. All API keys
+// restricted from the same key have the same ID.
+func (a *APIKey) ID() []byte {
+	return a.mac.Head()
+}
+
+// Serialize returns the API key as a string
+func (a *APIKey) Serialize() (string, error) {
+	data, err := proto.Marshal(&pb.Macaroon{
+		Head:    a.mac.head,
+		Caveats: a.mac.caveats,
+		Tail:    a.mac.tail,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base58.Encode(data), nil
+}
+
+// Restrict returns a copy of the API key that is further restricted by the
+// caveat. It does not need the root secret, so every holder of an API key
+// can hand out a weaker one.
+func (a *APIKey) Restrict(caveat pb.Caveat) (*APIKey, error) {
+	data, err := proto.Marshal(&caveat)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return &APIKey{mac: a.mac.AddFirstPartyCaveat(data)}, nil
+}
+
+// Validate returns an ErrUnauthorized error unless the API key was derived
+// from the root secret
+func (a *APIKey) Validate(secret []byte) error {
+	if !a.mac.Validate(secret) {
+		return ErrUnauthorized.New("invalid api key")
+	}
+	return nil
+}
+
+// Check returns an ErrUnauthorized error unless the API key was derived
+// from the root secret and all of its caveats allow the action
+func (a *APIKey) Check(secret []byte, action Action) error {
+	if err := a.Validate(secret); err != nil {
+		return err
+	}
+
+	for _, data := range a.mac.caveats {
+		caveat := pb.Caveat{}
+		if err := proto.Unmarshal(data, &caveat); err != nil {
+			return ErrUnauthorized.New("invalid caveat: %v", err)
+		}
+		if !allows(&caveat, action) {
+			return ErrUnauthorized.New("action not allowed")
+		}
+	}
+	return nil
+}
+
+// allows reports whether the caveat allows the action
+func allows(caveat *pb.Caveat, action Action) bool {
+	switch action.Op {
+	case OpRead:
+		if caveat.DisallowReads {
+			return false
+		}
+	case OpWrite:
+		if caveat.DisallowWrites {
+			return false
+		}
+	case OpList:
+		if caveat.DisallowLists {
+			return false
+		}
+	case OpDelete:
+		if caveat.DisallowDeletes {
+			return false
+		}
+	default:
+		return false
+	}
+
+	if caveat.NotBefore != nil {
+		notBefore, err := ptypes.Timestamp(caveat.NotBefore)
+		if err != nil || action.Time.Before(notBefore) {
+			return false
+		}
+	}
+	if caveat.NotAfter != nil {
+		notAfter, err := ptypes.Timestamp(caveat.NotAfter)
+		if err != nil || action.Time.After(notAfter) {
+			return false
+		}
+	}
+
+	if len(caveat.AllowedPaths) == 0 {
+		return true
+	}
+	for _, allowed := range caveat.AllowedPaths {
+		if allowsPath(allowed, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowsPath reports whether the path of the action is below the allowed
+// path. Listing the paths above the allowed path and reading the bucket
+// itself are allowed too, so the allowed objects can be found.
+func allowsPath(allowed *pb.Caveat_Path, action Action) bool {
+	if action.Op == OpList && action.Bucket == "" {
+		return true
+	}
+	if action.Bucket == "" || action.Bucket != allowed.GetBucket() {
+		return false
+	}
+
+	path := paths.New(action.EncryptedPath)
+	prefix := paths.New(allowed.GetEncryptedPathPrefix())
+	switch {
+	case path.HasPrefix(prefix):
+		return true
+	case action.Op == OpList:
+		return prefix.HasPrefix(path)
+	case action.Op == OpRead:
+		return len(path) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/pb"
+)
+
+func TestAPIKey(t *testing.T) {
+	secret, err := NewSecret()
+	if !assert.NoError(t, err) {
+		return
+	}
+	key, err := NewAPIKey(secret)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	now := time.Now()
+	notAfter, err := ptypes.TimestampProto(now.Add(time.Hour))
+	assert.NoError(t, err)
+
+	restricted, err := key.Restrict(pb.Caveat{
+		DisallowDeletes: true,
+		AllowedPaths: []*pb.Caveat_Path{
+			{Bucket: "bucket", EncryptedPathPrefix: "a/b"},
+			{Bucket: "other"},
+		},
+		NotAfter: notAfter,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	serialized, err := restricted.Serialize()
+	if !assert.NoError(t, err) {
+		return
+	}
+	restricted, err = ParseAPIKey(serialized)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, key.ID(), restricted.ID())
+
+	_, err = ParseAPIKey("not a key")
+	assert.Error(t, err)
+
+	for i, tt := range []struct {
+		action  Action
+		allowed bool
+	}{
+		{Action{Op: OpRead, Bucket: "bucket", EncryptedPath: "a/b/c", Time: now}, true},
+		{Action{Op: OpWrite, Bucket: "bucket", EncryptedPath: "a/b", Time: now}, true},
+		{Action{Op: OpWrite, Bucket: "other", EncryptedPath: "x", Time: now}, true},
+		{Action{Op: OpDelete, Bucket: "bucket", EncryptedPath: "a/b/c", Time: now}, false},
+		{Action{Op: OpRead, Bucket: "bucket", EncryptedPath: "a/bc", Time: now}, false},
+		{Action{Op: OpRead, Bucket: "bucket", EncryptedPath: "a", Time: now}, false},
+		{Action{Op: OpWrite, Bucket: "third", Time: now}, false},
+		{Action{Op: OpRead, Bucket: "bucket", EncryptedPath: "a/b", Time: now.Add(2 * time.Hour)}, false},
+		// the paths above the allowed prefix can be listed and the bucket
+		// can be read
+		{Action{Op: OpList, Time: now}, true},
+		{Action{Op: OpList, Bucket: "bucket", EncryptedPath: "a", Time: now}, true},
+		{Action{Op: OpList, Bucket: "bucket", EncryptedPath: "x", Time: now}, false},
+		{Action{Op: OpRead, Bucket: "bucket", Time: now}, true},
+		{Action{Op: OpWrite, Bucket: "bucket", Time: now}, false},
+		// actions without a path are not allowed for path restricted keys
+		{Action{Op: OpWrite, Time: now}, false},
+	} {
+		err := restricted.Check(secret, tt.action)
+		if tt.allowed {
+			assert.NoError(t, err, i)
+		} else {
+			assert.True(t, ErrUnauthorized.Has(err), i)
+		}
+
+		assert.NoError(t, key.Check(secret, tt.action), i)
+		assert.True(t, ErrUnauthorized.Has(restricted.Check([]byte("other secret"), tt.action)), i)
+	}
+}
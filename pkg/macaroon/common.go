@@ -0,0 +1,15 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"github.com/zeebo/errs"
+)
+
+var (
+	// Error is the errs class of standard macaroon errors
+	Error = errs.Class("macaroon error")
+	// ErrUnauthorized is the errs class of API keys that do not allow a request
+	ErrUnauthorized = errs.Class("api key unauthorized")
+)
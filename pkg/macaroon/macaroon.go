@@ -0,0 +1,88 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+)
+
+// Macaroon is a bearer token whose permissions can be restricted by anyone
+// holding it, without contacting its issuer. The issuer keeps the root
+// secret identified by the head and verifies that the tail is the chain of
+// HMACs over the head and the caveats, keyed with the root secret.
+type Macaroon struct {
+	head    []byte
+	caveats [][]byte
+	tail    []byte
+}
+
+// NewSecret returns a new random root secret
+func NewSecret() ([]byte, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return secret, nil
+}
+
+// NewUnrestricted returns a macaroon without caveats for the root secret,
+// identified by head
+func NewUnrestricted(head, secret []byte) *Macaroon {
+	return &Macaroon{
+		head: append([]byte(nil), head...),
+		tail: sign(secret, head),
+	}
+}
+
+// AddFirstPartyCaveat returns a copy of the macaroon restricted by the
+// caveat
+func (m *Macaroon) AddFirstPartyCaveat(caveat []byte) *Macaroon {
+	restricted := m.copy()
+	restricted.caveats = append(restricted.caveats, append([]byte(nil), caveat...))
+	restricted.tail = sign(m.tail, caveat)
+	return restricted
+}
+
+// Validate reports whether the macaroon was derived from the root secret
+func (m *Macaroon) Validate(secret []byte) bool {
+	tail := sign(secret, m.head)
+	for _, caveat := range m.caveats {
+		tail = sign(tail, caveat)
+	}
+	return hmac.Equal(tail, m.tail)
+}
+
+// Head returns the head, which identifies the root secret
+func (m *Macaroon) Head() []byte {
+	return append([]byte(nil), m.head...)
+}
+
+// Caveats returns the caveats in the order they were added
+func (m *Macaroon) Caveats() [][]byte {
+	return m.copy().caveats
+}
+
+// Tail returns the signature over the head and the caveats
+func (m *Macaroon) Tail() []byte {
+	return append([]byte(nil), m.tail...)
+}
+
+func (m *Macaroon) copy() *Macaroon {
+	c := &Macaroon{
+		head: append([]byte(nil), m.head...),
+		tail: append([]byte(nil), m.tail...),
+	}
+	for _, caveat := range m.caveats {
+		c.caveats = append(c.caveats, append([]byte(nil), caveat...))
+	}
+	return c
+}
+
+func sign(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package macaroon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMacaroon(t *testing.T) {
+	secret, err := NewSecret()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	mac := NewUnrestricted([]byte("head"), secret)
+	assert.True(t, mac.Validate(secret))
+	assert.False(t, mac.Validate([]byte("other secret")))
+	assert.Equal(t, []byte("head"), mac.Head())
+	assert.Empty(t, mac.Caveats())
+
+	restricted := mac.AddFirstPartyCaveat([]byte("first")).AddFirstPartyCaveat([]byte("second"))
+	assert.True(t, restricted.Validate(secret))
+	assert.Equal(t, [][]byte{[]byte("first"), []byte("second")}, restricted.Caveats())
+	assert.Equal(t, mac.Head(), restricted.Head())
+	assert.NotEqual(t, mac.Tail(), restricted.Tail())
+
+	// the original macaroon is not changed
+	assert.Empty(t, mac.Caveats())
+
+	// caveats cannot be removed without the secret
+	removed := &Macaroon{head: restricted.head, caveats: restricted.caveats[:1], tail: restricted.tail}
+	assert.False(t, removed.Validate(secret))
+}
@@ -0,0 +1,129 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58/base58"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/storage/buckets"
+)
+
+// Share returns a serialized access token to the objects below prefix in the
+// bucket. Whoever holds the token can use the network with the given API key
+// and decrypt the objects below prefix, but nothing above it. A macaroon API
+// key is restricted to the objects below prefix.
+func (c Config) Share(ctx context.Context, identity *provider.FullIdentity, bucket string, prefix paths.Path, apiKey string) (token string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bs, err := c.GetBucketStore(ctx, identity)
+	if err != nil {
+		return "", err
+	}
+	scope, err := bs.Share(ctx, bucket, prefix)
+	if err != nil {
+		return "", err
+	}
+
+	pointerDBAddr, overlayAddr := c.PointerDBAddr, c.OverlayAddr
+	if c.AccessToken != "" {
+		// the addresses and, unless another is given, the API key of an
+		// imported token are passed on
+		access, err := ParseAccessToken(c.AccessToken)
+		if err != nil {
+			return "", err
+		}
+		pointerDBAddr, overlayAddr = access.GetPointerDbAddr(), access.GetOverlayAddr()
+		if apiKey == "" {
+			apiKey = access.GetApiKey()
+		}
+	}
+
+	// a macaroon API key is restricted to the shared objects, so the
+	// satellite enforces the scope too
+	if _, err := macaroon.ParseAPIKey(apiKey); err == nil {
+		apiKey, err = restrictAPIKey(apiKey, pb.Caveat{
+			AllowedPaths: []*pb.Caveat_Path{scopePath(scope.Bucket, scope.EncryptedPrefix)},
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return NewAccessToken(pointerDBAddr, overlayAddr, apiKey, scope)
+}
+
+// NewAccessToken serializes the access to the scope through the satellite at
+// the given addresses
+func NewAccessToken(pointerDBAddr, overlayAddr, apiKey string, scope buckets.Scope) (token string, err error) {
+	created, err := ptypes.TimestampProto(scope.Meta.Created)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	data, err := proto.Marshal(&pb.AccessToken{
+		PointerDbAddr:    pointerDBAddr,
+		OverlayAddr:      overlayAddr,
+		ApiKey:           apiKey,
+		Bucket:           scope.Bucket,
+		BucketCreated:    created,
+		PathCipher:       int32(scope.Meta.PathCipher),
+		Versioning:       scope.Meta.Versioning.Enabled,
+		VersionRetention: int32(scope.Meta.Versioning.Retention),
+		Prefix:           scope.Prefix.String(),
+		EncryptedPrefix:  scope.EncryptedPrefix.String(),
+		PathKey:          scope.PathKey,
+		StreamKey:        scope.StreamKey,
+	})
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base58.Encode(data), nil
+}
+
+// ParseAccessToken deserializes an access token
+func ParseAccessToken(token string) (*pb.AccessToken, error) {
+	data, err := base58.Decode(token)
+	if err != nil {
+		return nil, Error.New("invalid access token: %v", err)
+	}
+	access := &pb.AccessToken{}
+	err = proto.Unmarshal(data, access)
+	if err != nil {
+		return nil, Error.New("invalid access token: %v", err)
+	}
+	if access.GetBucket() == "" || len(access.GetPathKey()) == 0 || len(access.GetStreamKey()) == 0 {
+		return nil, Error.New("invalid access token: missing bucket or keys")
+	}
+	return access, nil
+}
+
+// accessScope returns the scope the access token gives access to
+func accessScope(access *pb.AccessToken) (buckets.Scope, error) {
+	created, err := ptypes.Timestamp(access.GetBucketCreated())
+	if err != nil {
+		return buckets.Scope{}, Error.Wrap(err)
+	}
+	return buckets.Scope{
+		Bucket: access.GetBucket(),
+		Meta: buckets.Meta{
+			Created:    created,
+			PathCipher: paths.Cipher(access.GetPathCipher()),
+			Versioning: buckets.Versioning{
+				Enabled:   access.GetVersioning(),
+				Retention: int(access.GetVersionRetention()),
+			},
+		},
+		Prefix:          paths.New(access.GetPrefix()),
+		EncryptedPrefix: paths.New(access.GetEncryptedPrefix()),
+		PathKey:         access.GetPathKey(),
+		StreamKey:       access.GetStreamKey(),
+	}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/storage/buckets"
+	"storj.io/storj/pkg/storage/objects"
+)
+
+func TestAccessToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rootKey := []byte("root key")
+	created := time.Unix(1538000000, 0).UTC()
+
+	mockOS := NewMockStore(ctrl)
+	mockOS.EXPECT().Meta(gomock.Any(), paths.New("bucket")).Return(objects.Meta{
+		Modified:         created,
+		SerializableMeta: objects.SerializableMeta{UserDefined: map[string]string{"path-encryption": "aesgcm"}},
+	}, nil).AnyTimes()
+
+	bs := buckets.NewStore(mockOS, rootKey)
+	scope, err := bs.Share(ctx, "bucket", paths.New("dir/sub"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the prefix is encrypted the way it is in the full path
+	bucketKey, err := paths.New("bucket").DeriveKey(rootKey, 1)
+	assert.NoError(t, err)
+	encrypted, err := paths.New("dir/sub/object").EncryptWith(paths.AESGCM, bucketKey)
+	assert.NoError(t, err)
+	assert.Equal(t, paths.New("dir/sub"), scope.Prefix)
+	assert.Equal(t, encrypted[:2], scope.EncryptedPrefix)
+	assert.Equal(t, paths.New("bucket").Append(encrypted[:2]...), scope.StreamPrefix())
+
+	// and the keys of the prefix derive the same keys as the root key
+	pathKey, err := paths.New("dir/sub").DeriveKey(bucketKey, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, pathKey, scope.PathKey)
+	fullPath := encrypted.Prepend("bucket")
+	contentKey, err := fullPath.DeriveContentKey(rootKey)
+	assert.NoError(t, err)
+	scopedContentKey, err := fullPath[len(scope.StreamPrefix()):].DeriveContentKey(scope.StreamKey)
+	assert.NoError(t, err)
+	assert.Equal(t, contentKey, scopedContentKey)
+
+	token, err := NewAccessToken("pointerdb:7778", "overlay:7777", "api key", scope)
+	if !assert.NoError(t, err) {
+		return
+	}
+	access, err := ParseAccessToken(token)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "pointerdb:7778", access.GetPointerDbAddr())
+	assert.Equal(t, "overlay:7777", access.GetOverlayAddr())
+	assert.Equal(t, "api key", access.GetApiKey())
+	imported, err := accessScope(access)
+	if assert.NoError(t, err) {
+		assert.Equal(t, scope, imported)
+	}
+
+	// a scoped store sees only its bucket and the paths below its prefix
+	scoped := buckets.NewScopedStore(mockOS, imported)
+	meta, err := scoped.Get(ctx, "bucket")
+	if assert.NoError(t, err) {
+		assert.Equal(t, created, meta.Created)
+		assert.Equal(t, paths.AESGCM, meta.PathCipher)
+	}
+	_, err = scoped.Get(ctx, "other")
+	assert.Error(t, err)
+	_, err = scoped.Put(ctx, "other", paths.AESGCM)
+	assert.True(t, buckets.ScopeError.Has(err))
+	_, err = scoped.Share(ctx, "bucket", paths.New("dir"))
+	assert.True(t, buckets.ScopeError.Has(err))
+
+	objStore, err := scoped.GetObjectStore(ctx, "bucket")
+	if assert.NoError(t, err) {
+		_, err = objStore.Meta(ctx, paths.New("dir/other"))
+		assert.True(t, buckets.ScopeError.Has(err))
+	}
+
+	// and can share narrower prefixes
+	narrower, err := scoped.Share(ctx, "bucket", paths.New("dir/sub/deeper"))
+	if assert.NoError(t, err) {
+		direct, err := bs.Share(ctx, "bucket", paths.New("dir/sub/deeper"))
+		assert.NoError(t, err)
+		assert.Equal(t, direct, narrower)
+	}
+
+	_, err = ParseAccessToken("not a token")
+	assert.Error(t, err)
+}
@@ -0,0 +1,57 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/provider"
+)
+
+// RestrictAPIKey returns the API key restricted by the caveat and, if bucket
+// is not empty, to the objects below prefix in the bucket. The key is
+// restricted without the satellite, which is only asked for the path
+// encryption of the bucket.
+func (c Config) RestrictAPIKey(ctx context.Context, identity *provider.FullIdentity, apiKey string, caveat pb.Caveat, bucket string, prefix paths.Path) (restricted string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket != "" {
+		bs, err := c.GetBucketStore(ctx, identity)
+		if err != nil {
+			return "", err
+		}
+		scope, err := bs.Share(ctx, bucket, prefix)
+		if err != nil {
+			return "", err
+		}
+		caveat.AllowedPaths = append(caveat.AllowedPaths, scopePath(scope.Bucket, scope.EncryptedPrefix))
+	}
+
+	return restrictAPIKey(apiKey, caveat)
+}
+
+// restrictAPIKey restricts the serialized macaroon API key by the caveat
+func restrictAPIKey(apiKey string, caveat pb.Caveat) (string, error) {
+	key, err := macaroon.ParseAPIKey(apiKey)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	key, err = key.Restrict(caveat)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return key.Serialize()
+}
+
+// scopePath returns the caveat path allowing the encrypted paths below
+// encryptedPrefix in the bucket
+func scopePath(bucket string, encryptedPrefix paths.Path) *pb.Caveat_Path {
+	return &pb.Caveat_Path{
+		Bucket:              bucket,
+		EncryptedPathPrefix: encryptedPrefix.String(),
+	}
+}
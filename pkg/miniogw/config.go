@@ -5,6 +5,8 @@ package miniogw
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"os"
 
 	"github.com/minio/cli"
@@ -14,6 +16,7 @@ import (
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/miniogw/logging"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/provider"
 	"storj.io/storj/pkg/storage/buckets"
@@ -38,9 +41,15 @@ type RSConfig struct {
 // EncryptionConfig is a configuration struct that keeps details about
 // encrypting segments
 type EncryptionConfig struct {
-	EncKey       string `help:"root key for encrypting the data"`
+	EncKey       string `help:"root key for encrypting the data, or the passphrase to derive it from if enc-salt is set"`
+	EncKeyFile   string `help:"path to a file holding the root key or passphrase, which must not be accessible by other users"`
+	EncKeyEnv    string `help:"environment variable holding the root key or passphrase" default:"STORJ_ENC_KEY"`
+	EncSalt      string `help:"salt for deriving the root key from a passphrase, or empty to use the key as is"`
 	EncBlockSize int    `help:"size (in bytes) of encrypted blocks" default:"1024"`
 	EncType      int    `help:"Type of encryption to use (1=AES-GCM, 2=SecretBox)" default:"1"`
+	PathEncType  int    `help:"Type of path encryption of new buckets (0=unencrypted, 1=AES-GCM, 2=AES-SIV)" default:"1"`
+
+	ConvergentEncryption bool `help:"derive the segment keys from the content, so segments uploaded before with the same API key are not uploaded again. Reveals equal content to everyone knowing the API key." default:"false"`
 }
 
 // MinioConfig is a configuration struct that keeps details about starting
@@ -59,8 +68,14 @@ type ClientConfig struct {
 	PointerDBAddr string `help:"Address to contact pointerdb server through"`
 
 	APIKey        string `help:"API Key (TODO: this needs to change to macaroons somehow)"`
+	AccessToken   string `help:"access token to a shared path prefix, used instead of the addresses, the API key and the encryption key"`
 	MaxInlineSize int    `help:"max inline segment size in bytes" default:"4096"`
 	SegmentSize   int64  `help:"the size of a segment in bytes" default:"64000000"`
+
+	UploadSegments   int  `help:"the number of segments of an object to upload in parallel" default:"1"`
+	PrefetchSegments int  `help:"the number of segments of an object to download ahead of the reader" default:"2"`
+	VerifyChecksum   bool `help:"verify the checksum of the objects downloaded in full" default:"true"`
+	SegmentBufferMem int  `help:"maximum memory (in bytes) for buffering segments of a single upload or download" default:"0x10000000"`
 }
 
 // Config is a general miniogw configuration struct. This should be everything
@@ -121,19 +136,100 @@ func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *provi
 	return Error.New("unexpected minio exit")
 }
 
-// GetBucketStore returns an implementation of buckets.Store
+// GetBucketStore returns an implementation of buckets.Store. With an access
+// token, the store can only access the shared objects.
 func (c Config) GetBucketStore(ctx context.Context, identity *provider.FullIdentity) (bs buckets.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if c.AccessToken != "" {
+		access, err := ParseAccessToken(c.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		scope, err := accessScope(access)
+		if err != nil {
+			return nil, err
+		}
+		segments, err := c.getSegmentStore(identity, access.GetOverlayAddr(), access.GetPointerDbAddr(), access.GetApiKey())
+		if err != nil {
+			return nil, err
+		}
+		stream, err := streams.NewScopedStreamStore(segments, c.SegmentSize, scope.StreamPrefix(), scope.StreamKey,
+			c.EncBlockSize, c.EncType, c.UploadSegments, c.PrefetchSegments, c.SegmentBufferMem)
+		if err != nil {
+			return nil, err
+		}
+		stream, err = c.convergent(stream, access.GetApiKey())
+		if err != nil {
+			return nil, err
+		}
+		stream, err = c.verification(stream)
+		if err != nil {
+			return nil, err
+		}
+		return buckets.NewScopedStore(objects.NewStore(stream), scope), nil
+	}
+
+	rootKey, err := c.RootKey()
+	if err != nil {
+		return nil, err
+	}
+	segments, err := c.getSegmentStore(identity, c.OverlayAddr, c.PointerDBAddr, c.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := streams.NewStreamStore(segments, c.SegmentSize, string(rootKey), c.EncBlockSize, c.EncType, c.UploadSegments, c.PrefetchSegments, c.SegmentBufferMem)
+	if err != nil {
+		return nil, err
+	}
+	stream, err = c.convergent(stream, c.APIKey)
+	if err != nil {
+		return nil, err
+	}
+	stream, err = c.verification(stream)
+	if err != nil {
+		return nil, err
+	}
+	obj := objects.NewStore(stream)
+
+	return buckets.NewStore(obj, rootKey), nil
+}
+
+// convergent enables convergent encryption on the stream store if it is
+// configured. The convergence secret is derived from the API key, so the
+// segments are deduplicated among all uploads with the same API key.
+func (c Config) convergent(stream streams.Store, apiKey string) (streams.Store, error) {
+	if !c.ConvergentEncryption {
+		return stream, nil
+	}
+	if apiKey == "" {
+		return nil, Error.New("convergent encryption requires an API key")
+	}
+	mac := hmac.New(sha256.New, []byte(apiKey))
+	_, _ = mac.Write([]byte("convergent encryption"))
+	return streams.WithConvergentEncryption(stream, mac.Sum(nil))
+}
+
+// verification turns off the checksum verification of the stream store if
+// it is not configured
+func (c Config) verification(stream streams.Store) (streams.Store, error) {
+	if c.VerifyChecksum {
+		return stream, nil
+	}
+	return streams.WithoutChecksumVerification(stream)
+}
+
+// getSegmentStore returns the segment store of the satellite at the given
+// addresses
+func (c Config) getSegmentStore(identity *provider.FullIdentity, overlayAddr, pointerDBAddr, apiKey string) (segment.Store, error) {
 	t := transport.NewClient(identity)
 
-	var oc overlay.Client
-	oc, err = overlay.NewOverlayClient(identity, c.OverlayAddr)
+	oc, err := overlay.NewOverlayClient(identity, overlayAddr, []byte(apiKey))
 	if err != nil {
 		return nil, err
 	}
 
-	pdb, err := pdbclient.NewClient(identity, c.PointerDBAddr, []byte(c.APIKey))
+	pdb, err := pdbclient.NewClient(identity, pointerDBAddr, []byte(apiKey))
 	if err != nil {
 		return nil, err
 	}
@@ -148,19 +244,11 @@ func (c Config) GetBucketStore(ctx context.Context, identity *provider.FullIdent
 		return nil, err
 	}
 
-	segments := segment.NewSegmentStore(oc, ec, pdb, rs, c.MaxInlineSize)
-
 	if c.ErasureShareSize*c.MinThreshold%c.EncBlockSize != 0 {
-		err = Error.New("EncryptionBlockSize must be a multiple of ErasureShareSize * RS MinThreshold")
-		return nil, err
+		return nil, Error.New("EncryptionBlockSize must be a multiple of ErasureShareSize * RS MinThreshold")
 	}
-	stream, err := streams.NewStreamStore(segments, c.SegmentSize, c.EncKey, c.EncBlockSize, c.EncType)
-	if err != nil {
-		return nil, err
-	}
-	obj := objects.NewStore(stream)
 
-	return buckets.NewStore(obj), nil
+	return segment.NewSegmentStore(oc, ec, pdb, rs, c.MaxInlineSize), nil
 }
 
 // NewGateway creates a new minio Gateway
@@ -172,5 +260,5 @@ func (c Config) NewGateway(ctx context.Context, identity *provider.FullIdentity)
 		return nil, err
 	}
 
-	return NewStorjGateway(bs), nil
+	return NewStorjGateway(bs, paths.Cipher(c.PathEncType)), nil
 }
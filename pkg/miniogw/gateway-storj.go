@@ -29,15 +29,17 @@ var (
 	Error = errs.Class("Storj Gateway error")
 )
 
-// NewStorjGateway creates a *Storj object from an existing ObjectStore
-func NewStorjGateway(bs buckets.Store) *Storj {
-	return &Storj{bs: bs, multipart: NewMultipartUploads()}
+// NewStorjGateway creates a *Storj object from an existing ObjectStore. The
+// paths of the objects in new buckets are encrypted with pathCipher.
+func NewStorjGateway(bs buckets.Store, pathCipher paths.Cipher) *Storj {
+	return &Storj{bs: bs, pathCipher: pathCipher, multipart: NewMultipartUploads()}
 }
 
 //Storj is the implementation of a minio cmd.Gateway
 type Storj struct {
-	bs        buckets.Store
-	multipart *MultipartUploads
+	bs         buckets.Store
+	pathCipher paths.Cipher
+	multipart  *MultipartUploads
 }
 
 // Name implements cmd.Gateway
@@ -56,6 +58,13 @@ func (s *Storj) Production() bool {
 	return false
 }
 
+// storjObjects is the minio object layer of the gateway. In the buckets with
+// versioning, overwriting or deleting an object keeps the previous version,
+// but the gateway does not serve S3 versioning: the minio version it is built
+// on has no version IDs in its object layer and no ListObjectVersions or
+// bucket versioning routes, and the minio releases that have them replace
+// the whole object layer interface. The versions are reachable with the
+// object store only, and versioning is enabled with uplink mb --versioning.
 type storjObjects struct {
 	minio.GatewayUnsupported
 	storj *Storj
@@ -342,7 +351,7 @@ func (s *storjObjects) MakeBucketWithLocation(ctx context.Context,
 	if !storage.ErrKeyNotFound.Has(err) {
 		return err
 	}
-	_, err = s.storj.bs.Put(ctx, bucket)
+	_, err = s.storj.bs.Put(ctx, bucket, s.storj.pathCipher)
 	return err
 }
 
@@ -350,24 +359,51 @@ func (s *storjObjects) CopyObject(ctx context.Context, srcBucket, srcObject, des
 	destObject string, srcInfo minio.ObjectInfo) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	rr, err := s.getObject(ctx, srcBucket, srcObject)
-	if err != nil {
-		return objInfo, err
+	serMetaInfo := objects.SerializableMeta{
+		ContentType: srcInfo.ContentType,
+		UserDefined: srcInfo.UserDefined,
 	}
 
-	r, err := rr.Range(ctx, 0, rr.Size())
+	// the copy shares the data of the source object, so nothing is
+	// downloaded or uploaded
+	m, err := s.storj.bs.CopyObject(ctx, srcBucket, paths.New(srcObject), destBucket, paths.New(destObject), serMetaInfo)
 	if err != nil {
 		return objInfo, err
 	}
 
-	defer utils.LogClose(r)
+	return minio.ObjectInfo{
+		Name:        destObject,
+		Bucket:      destBucket,
+		ModTime:     m.Modified,
+		Size:        m.Size,
+		ETag:        m.Checksum,
+		ContentType: m.ContentType,
+		UserDefined: m.UserDefined,
+	}, nil
+}
 
-	serMetaInfo := objects.SerializableMeta{
-		ContentType: srcInfo.ContentType,
-		UserDefined: srcInfo.UserDefined,
+// MoveObject moves an object within or across buckets as a copy followed by
+// a delete of the source. S3 has no rename, so this is what clients doing a
+// copy-and-delete end up with; the object data is not transferred, only the
+// keys of its segments are re-wrapped for the destination path.
+func (s *storjObjects) MoveObject(ctx context.Context, srcBucket, srcObject, destBucket,
+	destObject string) (objInfo minio.ObjectInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	m, err := s.storj.bs.MoveObject(ctx, srcBucket, paths.New(srcObject), destBucket, paths.New(destObject))
+	if err != nil {
+		return objInfo, err
 	}
 
-	return s.putObject(ctx, destBucket, destObject, r, serMetaInfo)
+	return minio.ObjectInfo{
+		Name:        destObject,
+		Bucket:      destBucket,
+		ModTime:     m.Modified,
+		Size:        m.Size,
+		ETag:        m.Checksum,
+		ContentType: m.ContentType,
+		UserDefined: m.UserDefined,
+	}, nil
 }
 
 func (s *storjObjects) putObject(ctx context.Context, bucket, object string, r io.Reader,
@@ -39,24 +39,20 @@ func TestCopyObject(t *testing.T) {
 	mockBS := mock_buckets.NewMockStore(ctrl)
 	b := Storj{bs: mockBS}
 
-	mockOS := NewMockStore(ctrl)
-
 	storjObj := storjObjects{storj: &b}
 
 	for i, example := range []struct {
-		bucket, srcObject string
-		destObject        string
-		data              string
-		getErr            error
-		putErr            error
-		errString         string
+		srcBucket, srcObject   string
+		destBucket, destObject string
+		copyErr                error
+		errString              string
 	}{
 		// happy scenario
-		{"mybucket", "mySrcObj", "myDestObj", "abcdef", nil, nil, ""},
-		// error returned by the objects.Get()
-		{"mybucket", "mySrcObj", "myDestObj", "abcdef", errors.New("some Get err"), nil, "some Get err"},
-		// error returned by the objects.Put()
-		{"mybucket", "mySrcObj", "myDestObj", "abcdef", nil, errors.New("some Put err"), "some Put err"},
+		{"mybucket", "mySrcObj", "mybucket", "myDestObj", nil, ""},
+		// copy to another bucket
+		{"mybucket", "mySrcObj", "otherbucket", "myDestObj", nil, ""},
+		// error returned by the buckets.CopyObject()
+		{"mybucket", "mySrcObj", "mybucket", "myDestObj", errors.New("some Copy err"), "some Copy err"},
 	} {
 		errTag := fmt.Sprintf("Test case #%d", i)
 
@@ -83,42 +79,81 @@ func TestCopyObject(t *testing.T) {
 		}
 
 		srcInfo := minio.ObjectInfo{
-			Bucket:      example.bucket,
+			Bucket:      example.srcBucket,
 			Name:        example.srcObject,
 			Size:        1234,
 			ContentType: serMeta.ContentType,
 			UserDefined: serMeta.UserDefined,
 		}
 
-		rr := ranger.ByteRanger([]byte(example.data))
-		r, err := rr.Range(ctx, 0, rr.Size())
+		mockBS.EXPECT().CopyObject(gomock.Any(),
+			example.srcBucket, paths.New(example.srcObject),
+			example.destBucket, paths.New(example.destObject),
+			serMeta).Return(meta, example.copyErr)
+
+		objInfo, err := storjObj.CopyObject(ctx, example.srcBucket, example.srcObject, example.destBucket, example.destObject, srcInfo)
+
 		if err != nil {
-			t.Fatal(err)
+			assert.EqualError(t, err, example.errString, errTag)
+		} else {
+			assert.NoError(t, err, errTag)
+			assert.NotNil(t, objInfo, errTag)
+			assert.Equal(t, example.destBucket, objInfo.Bucket, errTag)
+			assert.Equal(t, example.destObject, objInfo.Name, errTag)
+			assert.Equal(t, meta.Modified, objInfo.ModTime, errTag)
+			assert.Equal(t, meta.Size, objInfo.Size, errTag)
+			assert.Equal(t, meta.Checksum, objInfo.ETag, errTag)
+			assert.Equal(t, meta.UserDefined, objInfo.UserDefined, errTag)
 		}
+	}
+}
 
-		// if o.Get returns an error, only expect GetObjectStore once, do not expect Put
-		if example.errString != "some Get err" {
-			mockBS.EXPECT().GetObjectStore(gomock.Any(), example.bucket).Return(mockOS, nil).Times(2)
-			mockOS.EXPECT().Get(gomock.Any(), paths.New(example.srcObject)).Return(rr, meta, example.getErr)
-			mockOS.EXPECT().Put(gomock.Any(), paths.New(example.destObject), r, serMeta, time.Time{}).Return(meta, example.putErr)
-		} else {
-			mockBS.EXPECT().GetObjectStore(gomock.Any(), example.bucket).Return(mockOS, nil)
-			mockOS.EXPECT().Get(gomock.Any(), paths.New(example.srcObject)).Return(rr, meta, example.getErr)
+func TestMoveObject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBS := mock_buckets.NewMockStore(ctrl)
+	b := Storj{bs: mockBS}
+
+	storjObj := storjObjects{storj: &b}
+
+	for i, example := range []struct {
+		srcBucket, srcObject   string
+		destBucket, destObject string
+		moveErr                error
+		errString              string
+	}{
+		// happy scenario
+		{"mybucket", "mySrcObj", "mybucket", "myDestObj", nil, ""},
+		// move to another bucket
+		{"mybucket", "mySrcObj", "otherbucket", "myDestObj", nil, ""},
+		// error returned by the buckets.MoveObject()
+		{"mybucket", "mySrcObj", "mybucket", "myDestObj", errors.New("some Move err"), "some Move err"},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		meta := objects.Meta{
+			SerializableMeta: objects.SerializableMeta{ContentType: "media/foo"},
+			Size:             1234,
+			Checksum:         "test-checksum",
 		}
 
-		objInfo, err := storjObj.CopyObject(ctx, example.bucket, example.srcObject, example.bucket, example.destObject, srcInfo)
+		mockBS.EXPECT().MoveObject(gomock.Any(),
+			example.srcBucket, paths.New(example.srcObject),
+			example.destBucket, paths.New(example.destObject),
+		).Return(meta, example.moveErr)
+
+		objInfo, err := storjObj.MoveObject(ctx, example.srcBucket, example.srcObject, example.destBucket, example.destObject)
 
 		if err != nil {
 			assert.EqualError(t, err, example.errString, errTag)
 		} else {
 			assert.NoError(t, err, errTag)
-			assert.NotNil(t, objInfo, errTag)
-			assert.Equal(t, example.bucket, objInfo.Bucket, errTag)
+			assert.Equal(t, example.destBucket, objInfo.Bucket, errTag)
 			assert.Equal(t, example.destObject, objInfo.Name, errTag)
-			assert.Equal(t, meta.Modified, objInfo.ModTime, errTag)
 			assert.Equal(t, meta.Size, objInfo.Size, errTag)
 			assert.Equal(t, meta.Checksum, objInfo.ETag, errTag)
-			assert.Equal(t, meta.UserDefined, objInfo.UserDefined, errTag)
+			assert.Equal(t, meta.ContentType, objInfo.ContentType, errTag)
 		}
 	}
 }
@@ -511,7 +546,7 @@ func TestMakeBucketWithLocation(t *testing.T) {
 		errTag := fmt.Sprintf("Test case #%d", i)
 		mockBS.EXPECT().Get(gomock.Any(), gomock.Any()).Return(buckets.Meta{Created: exp}, example.bucketStatus)
 		if storage.ErrKeyNotFound.Has(example.bucketStatus) {
-			mockBS.EXPECT().Put(gomock.Any(), example.bucket).Return(buckets.Meta{Created: example.meta}, nil)
+			mockBS.EXPECT().Put(gomock.Any(), example.bucket, gomock.Any()).Return(buckets.Meta{Created: example.meta}, nil)
 		}
 
 		err := storjObj.MakeBucketWithLocation(ctx, example.bucket, "location")
@@ -559,3 +594,46 @@ func TestListBuckets(t *testing.T) {
 		assert.NoError(t, err, errTag)
 	}
 }
+
+func TestListMultipartUploads(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBS := mock_buckets.NewMockStore(ctrl)
+	b := Storj{bs: mockBS, multipart: NewMultipartUploads()}
+	mockOS := NewMockStore(ctrl)
+	storjObj := storjObjects{storj: &b}
+
+	bucket := "test-bucket"
+	started := time.Now()
+	upload, err := b.multipart.Create(bucket, "test-prefix/active.txt", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mockBS.EXPECT().GetObjectStore(gomock.Any(), bucket).Return(mockOS, nil)
+	mockOS.EXPECT().ListPending(gomock.Any(), paths.New("test-prefix"), paths.New("marker"), nil, true, 10).
+		Return([]objects.ListItem{
+			{Path: paths.New("active.txt"), Meta: objects.Meta{Modified: started}},
+			{Path: paths.New("failed.txt"), Meta: objects.Meta{Modified: started}},
+		}, true, nil)
+
+	result, err := storjObj.ListMultipartUploads(ctx, bucket, "test-prefix", "marker", "", "", 10)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.True(t, result.IsTruncated)
+	assert.Equal(t, "failed.txt", result.NextKeyMarker)
+	assert.Equal(t, []minio.MultipartInfo{
+		{Object: "test-prefix/active.txt", UploadID: upload.ID, Initiated: started},
+		{Object: "test-prefix/failed.txt", UploadID: failedUploadID, Initiated: started},
+	}, result.Uploads)
+
+	mockBS.EXPECT().GetObjectStore(gomock.Any(), bucket).Return(mockOS, nil).Times(2)
+	mockOS.EXPECT().Abort(gomock.Any(), paths.New("test-prefix/failed.txt")).Return(nil)
+	assert.NoError(t, storjObj.AbortMultipartUpload(ctx, bucket, "test-prefix/failed.txt", failedUploadID))
+
+	mockOS.EXPECT().Abort(gomock.Any(), paths.New("missing.txt")).Return(storage.ErrKeyNotFound.New("missing.txt"))
+	err = storjObj.AbortMultipartUpload(ctx, bucket, "missing.txt", failedUploadID)
+	assert.Equal(t, minio.InvalidUploadID{UploadID: failedUploadID}, err)
+}
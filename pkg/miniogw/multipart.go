@@ -14,8 +14,13 @@ import (
 
 	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/storage"
 )
 
+// failedUploadID is the upload ID of the uploads that failed or were left
+// behind by a stopped gateway. They cannot be continued, only aborted.
+const failedUploadID = "Failed"
+
 func (s *storjObjects) NewMultipartUpload(ctx context.Context, bucket, object string, metadata map[string]string) (uploadID string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -102,6 +107,18 @@ func (s *storjObjects) PutObjectPart(ctx context.Context, bucket, object, upload
 func (s *storjObjects) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	if uploadID == failedUploadID {
+		o, err := s.storj.bs.GetObjectStore(ctx, bucket)
+		if err != nil {
+			return err
+		}
+		err = o.Abort(ctx, paths.New(object))
+		if storage.ErrKeyNotFound.Has(err) {
+			err = minio.InvalidUploadID{UploadID: uploadID}
+		}
+		return err
+	}
+
 	uploads := s.storj.multipart
 
 	upload, err := uploads.Remove(bucket, object, uploadID)
@@ -135,6 +152,61 @@ func (s *storjObjects) CompleteMultipartUpload(ctx context.Context, bucket, obje
 	return result.Info, result.Error
 }
 
+// ListMultipartUploads lists the uploads in progress and the failed uploads
+// whose segments are still stored. The objects have at most one upload each,
+// so the upload ID marker is not needed.
+func (s *storjObjects) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if delimiter != "" && delimiter != "/" {
+		return minio.ListMultipartsInfo{}, Error.New("delimiter %s not supported", delimiter)
+	}
+
+	recursive := delimiter == ""
+
+	o, err := s.storj.bs.GetObjectStore(ctx, bucket)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, err
+	}
+	items, more, err := o.ListPending(ctx, paths.New(prefix), paths.New(keyMarker), nil, recursive, maxUploads)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, err
+	}
+
+	result = minio.ListMultipartsInfo{
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		IsTruncated:    more,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
+	}
+	for _, item := range items {
+		path := item.Path
+		if recursive {
+			path = path.Prepend(prefix)
+		}
+		if item.IsPrefix {
+			result.CommonPrefixes = append(result.CommonPrefixes, path.String()+"/")
+			continue
+		}
+		uploadID := failedUploadID
+		if upload, ok := s.storj.multipart.Find(bucket, path.String()); ok {
+			uploadID = upload.ID
+		}
+		result.Uploads = append(result.Uploads, minio.MultipartInfo{
+			Object:    path.String(),
+			UploadID:  uploadID,
+			Initiated: item.Meta.Modified,
+		})
+	}
+	if more && len(items) > 0 {
+		result.NextKeyMarker = items[len(items)-1].Path.String()
+	}
+
+	return result, nil
+}
+
 func (s *storjObjects) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
 	uploads := s.storj.multipart
 	upload, err := uploads.Get(bucket, object, uploadID)
@@ -229,6 +301,19 @@ func (uploads *MultipartUploads) Get(bucket, object, uploadID string) (*Multipar
 	return upload, nil
 }
 
+// Find finds the upload in progress to the object
+func (uploads *MultipartUploads) Find(bucket, object string) (*MultipartUpload, bool) {
+	uploads.mu.RLock()
+	defer uploads.mu.RUnlock()
+
+	for _, upload := range uploads.pending {
+		if upload.Bucket == bucket && upload.Object == object {
+			return upload, true
+		}
+	}
+	return nil, false
+}
+
 // Remove returns and removes a pending upload
 func (uploads *MultipartUploads) Remove(bucket, object, uploadID string) (*MultipartUpload, error) {
 	uploads.mu.RLock()
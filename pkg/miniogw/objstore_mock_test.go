@@ -43,6 +43,44 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// Abort mocks base method
+func (m *MockStore) Abort(arg0 context.Context, arg1 paths.Path) error {
+	ret := m.ctrl.Call(m, "Abort", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Abort indicates an expected call of Abort
+func (mr *MockStoreMockRecorder) Abort(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Abort", reflect.TypeOf((*MockStore)(nil).Abort), arg0, arg1)
+}
+
+// Archive mocks base method
+func (m *MockStore) Archive(arg0 context.Context, arg1 paths.Path) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "Archive", arg0, arg1)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Archive indicates an expected call of Archive
+func (mr *MockStoreMockRecorder) Archive(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Archive", reflect.TypeOf((*MockStore)(nil).Archive), arg0, arg1)
+}
+
+// Copy mocks base method
+func (m *MockStore) Copy(arg0 context.Context, arg1, arg2 paths.Path, arg3 objects.SerializableMeta) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockStoreMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockStore)(nil).Copy), arg0, arg1, arg2, arg3)
+}
+
 // Delete mocks base method
 func (m *MockStore) Delete(arg0 context.Context, arg1 paths.Path) error {
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
@@ -55,6 +93,18 @@ func (mr *MockStoreMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), arg0, arg1)
 }
 
+// DeleteVersion mocks base method
+func (m *MockStore) DeleteVersion(arg0 context.Context, arg1 paths.Path, arg2 string) error {
+	ret := m.ctrl.Call(m, "DeleteVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVersion indicates an expected call of DeleteVersion
+func (mr *MockStoreMockRecorder) DeleteVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockStore)(nil).DeleteVersion), arg0, arg1, arg2)
+}
+
 // Get mocks base method
 func (m *MockStore) Get(arg0 context.Context, arg1 paths.Path) (ranger.Ranger, objects.Meta, error) {
 	ret := m.ctrl.Call(m, "Get", arg0, arg1)
@@ -69,6 +119,20 @@ func (mr *MockStoreMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), arg0, arg1)
 }
 
+// GetVersion mocks base method
+func (m *MockStore) GetVersion(arg0 context.Context, arg1 paths.Path, arg2 string) (ranger.Ranger, objects.Meta, error) {
+	ret := m.ctrl.Call(m, "GetVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(ranger.Ranger)
+	ret1, _ := ret[1].(objects.Meta)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVersion indicates an expected call of GetVersion
+func (mr *MockStoreMockRecorder) GetVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockStore)(nil).GetVersion), arg0, arg1, arg2)
+}
+
 // List mocks base method
 func (m *MockStore) List(arg0 context.Context, arg1, arg2, arg3 paths.Path, arg4 bool, arg5 int, arg6 uint32) ([]objects.ListItem, bool, error) {
 	ret := m.ctrl.Call(m, "List", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
@@ -83,6 +147,46 @@ func (mr *MockStoreMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// ListPending mocks base method
+func (m *MockStore) ListPending(arg0 context.Context, arg1, arg2, arg3 paths.Path, arg4 bool, arg5 int) ([]objects.ListItem, bool, error) {
+	ret := m.ctrl.Call(m, "ListPending", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]objects.ListItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListPending indicates an expected call of ListPending
+func (mr *MockStoreMockRecorder) ListPending(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPending", reflect.TypeOf((*MockStore)(nil).ListPending), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ListStream mocks base method
+func (m *MockStore) ListStream(arg0 context.Context, arg1, arg2 paths.Path, arg3 bool, arg4 uint32, arg5 func(objects.ListItem) error) error {
+	ret := m.ctrl.Call(m, "ListStream", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockStoreMockRecorder) ListStream(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockStore)(nil).ListStream), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// ListVersions mocks base method
+func (m *MockStore) ListVersions(arg0 context.Context, arg1, arg2, arg3 paths.Path, arg4 int, arg5 uint32) ([]objects.ListItem, bool, error) {
+	ret := m.ctrl.Call(m, "ListVersions", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]objects.ListItem)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListVersions indicates an expected call of ListVersions
+func (mr *MockStoreMockRecorder) ListVersions(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockStore)(nil).ListVersions), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Meta mocks base method
 func (m *MockStore) Meta(arg0 context.Context, arg1 paths.Path) (objects.Meta, error) {
 	ret := m.ctrl.Call(m, "Meta", arg0, arg1)
@@ -96,6 +200,32 @@ func (mr *MockStoreMockRecorder) Meta(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Meta", reflect.TypeOf((*MockStore)(nil).Meta), arg0, arg1)
 }
 
+// MetaVersion mocks base method
+func (m *MockStore) MetaVersion(arg0 context.Context, arg1 paths.Path, arg2 string) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "MetaVersion", arg0, arg1, arg2)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MetaVersion indicates an expected call of MetaVersion
+func (mr *MockStoreMockRecorder) MetaVersion(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MetaVersion", reflect.TypeOf((*MockStore)(nil).MetaVersion), arg0, arg1, arg2)
+}
+
+// Move mocks base method
+func (m *MockStore) Move(arg0 context.Context, arg1, arg2 paths.Path) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockStoreMockRecorder) Move(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockStore)(nil).Move), arg0, arg1, arg2)
+}
+
 // Put mocks base method
 func (m *MockStore) Put(arg0 context.Context, arg1 paths.Path, arg2 io.Reader, arg3 objects.SerializableMeta, arg4 time.Time) (objects.Meta, error) {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2, arg3, arg4)
@@ -108,3 +238,16 @@ func (m *MockStore) Put(arg0 context.Context, arg1 paths.Path, arg2 io.Reader, a
 func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2, arg3, arg4)
 }
+
+// Resume mocks base method
+func (m *MockStore) Resume(arg0 context.Context, arg1 paths.Path, arg2 io.Reader, arg3 objects.SerializableMeta, arg4 time.Time) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "Resume", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resume indicates an expected call of Resume
+func (mr *MockStoreMockRecorder) Resume(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resume", reflect.TypeOf((*MockStore)(nil).Resume), arg0, arg1, arg2, arg3, arg4)
+}
@@ -0,0 +1,131 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/mr-tron/base58/base58"
+	"golang.org/x/crypto/argon2"
+
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/storage/buckets"
+	streams "storj.io/storj/pkg/storage/streams"
+)
+
+// parameters of Argon2id for deriving the root key from a passphrase
+const (
+	kdfTime    = 1
+	kdfMemory  = 64 * 1024
+	kdfThreads = 4
+	kdfKeyLen  = 32
+	saltLen    = 16
+)
+
+// RootKey returns the root encryption key. It is read from the key file, the
+// environment variable or the configuration, in this order. If a salt is
+// configured, it is a passphrase the root key is derived from.
+func (c EncryptionConfig) RootKey() ([]byte, error) {
+	secret, err := c.rootSecret()
+	if err != nil {
+		return nil, err
+	}
+	if c.EncSalt == "" {
+		return []byte(secret), nil
+	}
+	return DeriveRootKey(secret, c.EncSalt)
+}
+
+// rootSecret returns the configured root key or passphrase
+func (c EncryptionConfig) rootSecret() (string, error) {
+	if c.EncKeyFile != "" {
+		return ReadKeyFile(c.EncKeyFile)
+	}
+	if c.EncKeyEnv != "" {
+		if secret := os.Getenv(c.EncKeyEnv); secret != "" {
+			return secret, nil
+		}
+	}
+	if c.EncKey != "" {
+		return c.EncKey, nil
+	}
+	return "", Error.New("no encryption key configured")
+}
+
+// ReadKeyFile reads a root key or passphrase from a file, which must not be
+// accessible by other users
+func ReadKeyFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	// file permissions are not reliable on windows
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0077 != 0 {
+		return "", Error.New("key file %s must not be accessible by other users, its permissions are %v", path, info.Mode().Perm())
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	secret := strings.TrimRight(string(data), "\r\n")
+	if secret == "" {
+		return "", Error.New("key file %s is empty", path)
+	}
+	return secret, nil
+}
+
+// DeriveRootKey derives the root key from a passphrase and a base58 encoded
+// salt with Argon2id
+func DeriveRootKey(passphrase, salt string) ([]byte, error) {
+	saltBytes, err := base58.Decode(salt)
+	if err != nil {
+		return nil, Error.New("invalid salt: %v", err)
+	}
+	return argon2.IDKey([]byte(passphrase), saltBytes, kdfTime, kdfMemory, kdfThreads, kdfKeyLen), nil
+}
+
+// NewSalt returns a new random base58 encoded salt for DeriveRootKey
+func NewSalt() (string, error) {
+	var salt [saltLen]byte
+	_, err := rand.Read(salt[:])
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return base58.Encode(salt[:]), nil
+}
+
+// RotateKey re-encrypts all buckets and objects with the keys derived from
+// newKey instead of the configured root key, without moving their data
+func (c Config) RotateKey(ctx context.Context, identity *provider.FullIdentity, newKey []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if c.AccessToken != "" {
+		return Error.New("the root key cannot be rotated with an access token")
+	}
+	oldKey, err := c.RootKey()
+	if err != nil {
+		return err
+	}
+
+	segments, err := c.getSegmentStore(identity, c.OverlayAddr, c.PointerDBAddr, c.APIKey)
+	if err != nil {
+		return err
+	}
+	src, err := streams.NewStreamStore(segments, c.SegmentSize, string(oldKey), c.EncBlockSize, c.EncType, c.UploadSegments, c.PrefetchSegments, c.SegmentBufferMem)
+	if err != nil {
+		return err
+	}
+	dst, err := streams.NewStreamStore(segments, c.SegmentSize, string(newKey), c.EncBlockSize, c.EncType, c.UploadSegments, c.PrefetchSegments, c.SegmentBufferMem)
+	if err != nil {
+		return err
+	}
+
+	return buckets.RotateKey(ctx, src, dst, oldKey, newKey)
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRootKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rootkey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	keyFile := filepath.Join(dir, "key")
+	err = ioutil.WriteFile(keyFile, []byte("file key\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const env = "STORJ_TEST_ENC_KEY"
+	err = os.Setenv(env, "env key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Unsetenv(env) }()
+
+	// the key file comes before the environment and the configuration
+	key, err := EncryptionConfig{EncKey: "config key", EncKeyEnv: env, EncKeyFile: keyFile}.RootKey()
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("file key"), key)
+	}
+	key, err = EncryptionConfig{EncKey: "config key", EncKeyEnv: env}.RootKey()
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("env key"), key)
+	}
+	key, err = EncryptionConfig{EncKey: "config key", EncKeyEnv: "STORJ_TEST_UNSET"}.RootKey()
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("config key"), key)
+	}
+	_, err = EncryptionConfig{}.RootKey()
+	assert.Error(t, err)
+
+	// with a salt the key is a passphrase
+	salt, err := NewSalt()
+	if !assert.NoError(t, err) {
+		return
+	}
+	derived, err := EncryptionConfig{EncKey: "passphrase", EncSalt: salt}.RootKey()
+	if assert.NoError(t, err) {
+		assert.Len(t, derived, 32)
+		again, err := DeriveRootKey("passphrase", salt)
+		assert.NoError(t, err)
+		assert.Equal(t, derived, again)
+	}
+	otherSalt, err := NewSalt()
+	if assert.NoError(t, err) {
+		other, err := DeriveRootKey("passphrase", otherSalt)
+		assert.NoError(t, err)
+		assert.NotEqual(t, derived, other)
+	}
+	_, err = DeriveRootKey("passphrase", "not base58!")
+	assert.Error(t, err)
+
+	// key files accessible by other users are refused
+	if runtime.GOOS != "windows" {
+		assert.NoError(t, os.Chmod(keyFile, 0644))
+		_, err = ReadKeyFile(keyFile)
+		assert.Error(t, err)
+	}
+}
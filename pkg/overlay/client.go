@@ -34,10 +34,12 @@ type Client interface {
 // Overlay is the overlay concrete implementation of the client interface
 type Overlay struct {
 	client pb.OverlayClient
+	apiKey []byte
 }
 
-// NewOverlayClient returns a new intialized Overlay Client
-func NewOverlayClient(identity *provider.FullIdentity, address string) (*Overlay, error) {
+// NewOverlayClient returns a new intialized Overlay Client, whose requests
+// carry the API key
+func NewOverlayClient(identity *provider.FullIdentity, address string, apiKey []byte) (*Overlay, error) {
 	dialOpt, err := identity.DialOption()
 	if err != nil {
 		return nil, err
@@ -49,6 +51,7 @@ func NewOverlayClient(identity *provider.FullIdentity, address string) (*Overlay
 
 	return &Overlay{
 		client: c,
+		apiKey: apiKey,
 	}, nil
 }
 
@@ -62,6 +65,7 @@ func (o *Overlay) Choose(ctx context.Context, amount int, space int64) ([]*pb.No
 		Opts: &pb.OverlayOptions{Amount: int64(amount), Restrictions: &pb.NodeRestrictions{
 			FreeDisk: space,
 		}},
+		APIKey: o.apiKey,
 	})
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -72,7 +76,7 @@ func (o *Overlay) Choose(ctx context.Context, amount int, space int64) ([]*pb.No
 
 // Lookup provides a Node with the given ID
 func (o *Overlay) Lookup(ctx context.Context, nodeID dht.NodeID) (*pb.Node, error) {
-	resp, err := o.client.Lookup(ctx, &pb.LookupRequest{NodeID: nodeID.String()})
+	resp, err := o.client.Lookup(ctx, &pb.LookupRequest{NodeID: nodeID.String(), APIKey: o.apiKey})
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +86,7 @@ func (o *Overlay) Lookup(ctx context.Context, nodeID dht.NodeID) (*pb.Node, erro
 
 //BulkLookup provides a list of Nodes with the given IDs
 func (o *Overlay) BulkLookup(ctx context.Context, nodeIDs []dht.NodeID) ([]*pb.Node, error) {
-	var reqs pb.LookupRequests
+	reqs := pb.LookupRequests{APIKey: o.apiKey}
 	for _, v := range nodeIDs {
 		reqs.Lookuprequest = append(reqs.Lookuprequest, &pb.LookupRequest{NodeID: v.String()})
 	}
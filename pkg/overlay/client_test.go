@@ -45,7 +45,7 @@ func TestNewOverlayClient(t *testing.T) {
 		identity, err := ca.NewIdentity()
 		assert.NoError(t, err)
 
-		oc, err := NewOverlayClient(identity, v.address)
+		oc, err := NewOverlayClient(identity, v.address, nil)
 		assert.NoError(t, err)
 
 		assert.NotNil(t, oc)
@@ -81,7 +81,7 @@ func TestChoose(t *testing.T) {
 		identity, err := ca.NewIdentity()
 		assert.NoError(t, err)
 
-		oc, err := NewOverlayClient(identity, lis.Addr().String())
+		oc, err := NewOverlayClient(identity, lis.Addr().String(), nil)
 		assert.NoError(t, err)
 
 		assert.NotNil(t, oc)
@@ -118,7 +118,7 @@ func TestLookup(t *testing.T) {
 		identity, err := ca.NewIdentity()
 		assert.NoError(t, err)
 
-		oc, err := NewOverlayClient(identity, lis.Addr().String())
+		oc, err := NewOverlayClient(identity, lis.Addr().String(), nil)
 		assert.NoError(t, err)
 
 		assert.NotNil(t, oc)
@@ -154,7 +154,7 @@ func TestBulkLookup(t *testing.T) {
 		identity, err := ca.NewIdentity()
 		assert.NoError(t, err)
 
-		oc, err := NewOverlayClient(identity, lis.Addr().String())
+		oc, err := NewOverlayClient(identity, lis.Addr().String(), nil)
 		assert.NoError(t, err)
 
 		assert.NotNil(t, oc)
@@ -186,7 +186,7 @@ func TestBulkLookupV2(t *testing.T) {
 	identity, err := ca.NewIdentity()
 	assert.NoError(t, err)
 
-	oc, err := NewOverlayClient(identity, lis.Addr().String())
+	oc, err := NewOverlayClient(identity, lis.Addr().String(), nil)
 	assert.NoError(t, err)
 
 	assert.NotNil(t, oc)
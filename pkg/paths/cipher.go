@@ -0,0 +1,167 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package paths
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/base64"
+)
+
+// Cipher is the algorithm used to encrypt the segments of paths
+type Cipher int
+
+const (
+	// Unencrypted leaves the paths in plain text, so they can be listed by
+	// name on the server side
+	Unencrypted Cipher = iota
+	// AESGCM encrypts every path segment with AES-GCM using a nonce derived
+	// from the key of the segment
+	AESGCM
+	// SIV encrypts every path segment deterministically with AES-GCM using a
+	// synthetic nonce derived from the key and the segment itself, so
+	// different segments never share a nonce
+	SIV
+)
+
+// String returns the name of the cipher
+func (c Cipher) String() string {
+	switch c {
+	case Unencrypted:
+		return "unencrypted"
+	case AESGCM:
+		return "aesgcm"
+	case SIV:
+		return "siv"
+	}
+	return "unknown"
+}
+
+// ParseCipher returns the cipher with the given name
+func ParseCipher(name string) (Cipher, error) {
+	for _, c := range []Cipher{Unencrypted, AESGCM, SIV} {
+		if c.String() == name {
+			return c, nil
+		}
+	}
+	return 0, Error.New("unknown path cipher %q", name)
+}
+
+// EncryptWith creates new Path by encrypting the current path with the given
+// cipher and key
+func (p Path) EncryptWith(c Cipher, key []byte) (encrypted Path, err error) {
+	switch c {
+	case Unencrypted:
+		return append(Path{}, p...), nil
+	case AESGCM:
+		return p.Encrypt(key)
+	case SIV:
+		return p.encryptSIV(key)
+	}
+	return nil, Error.New("unknown path cipher %d", c)
+}
+
+// DecryptWith creates new Path by decrypting the current path with the given
+// cipher and key
+func (p Path) DecryptWith(c Cipher, key []byte) (decrypted Path, err error) {
+	switch c {
+	case Unencrypted:
+		return append(Path{}, p...), nil
+	case AESGCM:
+		return p.Decrypt(key)
+	case SIV:
+		return p.decryptSIV(key)
+	}
+	return nil, Error.New("unknown path cipher %d", c)
+}
+
+func (p Path) encryptSIV(key []byte) (encrypted Path, err error) {
+	encrypted = make([]string, len(p))
+	for i, seg := range p {
+		encrypted[i], err = encryptSegmentSIV(seg, key)
+		if err != nil {
+			return nil, err
+		}
+		key, err = deriveSecret(key, seg)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+func (p Path) decryptSIV(key []byte) (decrypted Path, err error) {
+	decrypted = make([]string, len(p))
+	for i, seg := range p {
+		decrypted[i], err = decryptSegmentSIV(seg, key)
+		if err != nil {
+			return nil, err
+		}
+		key, err = deriveSecret(key, decrypted[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decrypted, nil
+}
+
+func encryptSegmentSIV(text string, secret []byte) (cipherText string, err error) {
+	aesgcm, err := newSIVCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha512.New, secret)
+	_, err = mac.Write([]byte("siv" + text))
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	nonce := mac.Sum(nil)[:aesgcm.NonceSize()]
+	data := aesgcm.Seal(nonce, nonce, []byte(text), nil)
+	// prepend version number to the cipher text
+	return "2" + base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decryptSegmentSIV(cipherText string, secret []byte) (text string, err error) {
+	if cipherText == "" {
+		return "", Error.New("empty cipher text")
+	}
+	if cipherText[0] != '2' {
+		return "", Error.New("invalid version number")
+	}
+	data, err := base64.RawURLEncoding.DecodeString(cipherText[1:])
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	aesgcm, err := newSIVCipher(secret)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < aesgcm.NonceSize() {
+		return "", Error.New("cipher text too short")
+	}
+	nonce, data := data[:aesgcm.NonceSize()], data[aesgcm.NonceSize():]
+	decrypted, err := aesgcm.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", Error.Wrap(err)
+	}
+	return string(decrypted), nil
+}
+
+func newSIVCipher(secret []byte) (cipher.AEAD, error) {
+	key, _, err := getAESGCMKeyAndNonce(secret)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	aesgcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return aesgcm, nil
+}
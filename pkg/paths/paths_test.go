@@ -215,6 +215,53 @@ func TestEncryption(t *testing.T) {
 	}
 }
 
+func TestEncryptionWithCipher(t *testing.T) {
+	for i, tt := range []struct {
+		cipher    Cipher
+		plaintext bool
+	}{
+		{Unencrypted, true},
+		{AESGCM, false},
+		{SIV, false},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+		path := New("fold1", "fold2", "file.txt")
+		key := []byte("my secret")
+
+		encrypted, err := path.EncryptWith(tt.cipher, key)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, tt.plaintext, path.String() == encrypted.String(), errTag)
+
+		// the encryption is deterministic, so paths can be looked up
+		again, err := path.EncryptWith(tt.cipher, key)
+		assert.NoError(t, err, errTag)
+		assert.Equal(t, encrypted, again, errTag)
+
+		// and the encrypted prefix of a path is the prefix of the encrypted path
+		prefix, err := path[:2].EncryptWith(tt.cipher, key)
+		assert.NoError(t, err, errTag)
+		assert.True(t, encrypted.HasPrefix(prefix), errTag)
+
+		decrypted, err := encrypted.DecryptWith(tt.cipher, key)
+		if assert.NoError(t, err, errTag) {
+			assert.Equal(t, path, decrypted, errTag)
+		}
+	}
+
+	_, err := New("file.txt").EncryptWith(Cipher(42), []byte("my secret"))
+	assert.Error(t, err)
+
+	for _, c := range []Cipher{Unencrypted, AESGCM, SIV} {
+		parsed, err := ParseCipher(c.String())
+		assert.NoError(t, err)
+		assert.Equal(t, c, parsed)
+	}
+	_, err = ParseCipher("rot13")
+	assert.Error(t, err)
+}
+
 func TestDeriveKey(t *testing.T) {
 	for i, tt := range []struct {
 		path      Path
@@ -0,0 +1,179 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: access.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// AccessToken gives access to the objects below a path prefix of a bucket
+type AccessToken struct {
+	PointerDbAddr        string               `protobuf:"bytes,1,opt,name=pointer_db_addr,json=pointerDbAddr,proto3" json:"pointer_db_addr,omitempty"`
+	OverlayAddr          string               `protobuf:"bytes,2,opt,name=overlay_addr,json=overlayAddr,proto3" json:"overlay_addr,omitempty"`
+	ApiKey               string               `protobuf:"bytes,3,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Bucket               string               `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	BucketCreated        *timestamp.Timestamp `protobuf:"bytes,5,opt,name=bucket_created,json=bucketCreated,proto3" json:"bucket_created,omitempty"`
+	PathCipher           int32                `protobuf:"varint,6,opt,name=path_cipher,json=pathCipher,proto3" json:"path_cipher,omitempty"`
+	Versioning           bool                 `protobuf:"varint,7,opt,name=versioning,proto3" json:"versioning,omitempty"`
+	VersionRetention     int32                `protobuf:"varint,8,opt,name=version_retention,json=versionRetention,proto3" json:"version_retention,omitempty"`
+	Prefix               string               `protobuf:"bytes,9,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	EncryptedPrefix      string               `protobuf:"bytes,10,opt,name=encrypted_prefix,json=encryptedPrefix,proto3" json:"encrypted_prefix,omitempty"`
+	PathKey              []byte               `protobuf:"bytes,11,opt,name=path_key,json=pathKey,proto3" json:"path_key,omitempty"`
+	StreamKey            []byte               `protobuf:"bytes,12,opt,name=stream_key,json=streamKey,proto3" json:"stream_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AccessToken) Reset()         { *m = AccessToken{} }
+func (m *AccessToken) String() string { return proto.CompactTextString(m) }
+func (*AccessToken) ProtoMessage()    {}
+func (*AccessToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a098e900d2c3a6f2, []int{0}
+}
+func (m *AccessToken) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AccessToken.Unmarshal(m, b)
+}
+func (m *AccessToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AccessToken.Marshal(b, m, deterministic)
+}
+func (dst *AccessToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessToken.Merge(dst, src)
+}
+func (m *AccessToken) XXX_Size() int {
+	return xxx_messageInfo_AccessToken.Size(m)
+}
+func (m *AccessToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessToken proto.InternalMessageInfo
+
+func (m *AccessToken) GetPointerDbAddr() string {
+	if m != nil {
+		return m.PointerDbAddr
+	}
+	return ""
+}
+
+func (m *AccessToken) GetOverlayAddr() string {
+	if m != nil {
+		return m.OverlayAddr
+	}
+	return ""
+}
+
+func (m *AccessToken) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *AccessToken) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *AccessToken) GetBucketCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.BucketCreated
+	}
+	return nil
+}
+
+func (m *AccessToken) GetPathCipher() int32 {
+	if m != nil {
+		return m.PathCipher
+	}
+	return 0
+}
+
+func (m *AccessToken) GetVersioning() bool {
+	if m != nil {
+		return m.Versioning
+	}
+	return false
+}
+
+func (m *AccessToken) GetVersionRetention() int32 {
+	if m != nil {
+		return m.VersionRetention
+	}
+	return 0
+}
+
+func (m *AccessToken) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *AccessToken) GetEncryptedPrefix() string {
+	if m != nil {
+		return m.EncryptedPrefix
+	}
+	return ""
+}
+
+func (m *AccessToken) GetPathKey() []byte {
+	if m != nil {
+		return m.PathKey
+	}
+	return nil
+}
+
+func (m *AccessToken) GetStreamKey() []byte {
+	if m != nil {
+		return m.StreamKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AccessToken)(nil), "access.AccessToken")
+}
+
+func init() { proto.RegisterFile("access.proto", fileDescriptor_a098e900d2c3a6f2) }
+
+var fileDescriptor_a098e900d2c3a6f2 = []byte{
+	// 340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xcf, 0x6e, 0xe2, 0x30,
+	0x10, 0xc6, 0x15, 0xfe, 0x04, 0x98, 0x84, 0x85, 0xf5, 0x61, 0xd7, 0x8b, 0xb4, 0x25, 0xed, 0xa1,
+	0x4a, 0x55, 0x29, 0x48, 0xed, 0x13, 0x50, 0x7a, 0xe3, 0x52, 0x45, 0x9c, 0x7a, 0x89, 0x9c, 0x64,
+	0x00, 0x0b, 0xb0, 0x2d, 0xc7, 0xa0, 0xe6, 0xc9, 0xfa, 0x7a, 0x55, 0xec, 0x50, 0xf5, 0x96, 0xef,
+	0x37, 0xbf, 0x89, 0xfc, 0x0d, 0x84, 0xac, 0x28, 0xb0, 0xaa, 0x12, 0xa5, 0xa5, 0x91, 0xc4, 0x77,
+	0x69, 0x36, 0xdf, 0x49, 0xb9, 0x3b, 0xe2, 0xc2, 0xd2, 0xfc, 0xbc, 0x5d, 0x18, 0x7e, 0xc2, 0xca,
+	0xb0, 0x93, 0x72, 0xe2, 0xdd, 0x67, 0x17, 0x82, 0xa5, 0x75, 0x37, 0xf2, 0x80, 0x82, 0xdc, 0xc3,
+	0x44, 0x49, 0x2e, 0x0c, 0xea, 0xac, 0xcc, 0x33, 0x56, 0x96, 0x9a, 0x7a, 0x91, 0x17, 0x8f, 0xd2,
+	0x71, 0x8b, 0x5f, 0xf3, 0x65, 0x59, 0x6a, 0x72, 0x0b, 0xa1, 0xbc, 0xa0, 0x3e, 0xb2, 0xda, 0x49,
+	0x1d, 0x2b, 0x05, 0x2d, 0xb3, 0xca, 0x5f, 0x18, 0x30, 0xc5, 0xb3, 0x03, 0xd6, 0xb4, 0x6b, 0xa7,
+	0x3e, 0x53, 0x7c, 0x8d, 0x35, 0xf9, 0x03, 0x7e, 0x7e, 0x2e, 0x0e, 0x68, 0x68, 0xcf, 0x71, 0x97,
+	0xc8, 0x12, 0x7e, 0xb9, 0xaf, 0xac, 0xd0, 0xc8, 0x0c, 0x96, 0xb4, 0x1f, 0x79, 0x71, 0xf0, 0x34,
+	0x4b, 0x5c, 0x8b, 0xe4, 0xda, 0x22, 0xd9, 0x5c, 0x5b, 0xa4, 0x63, 0xb7, 0xb1, 0x72, 0x0b, 0x64,
+	0x0e, 0x81, 0x62, 0x66, 0x9f, 0x15, 0x5c, 0xed, 0x51, 0x53, 0x3f, 0xf2, 0xe2, 0x7e, 0x0a, 0x0d,
+	0x5a, 0x59, 0x42, 0x6e, 0x00, 0x2e, 0xa8, 0x2b, 0x2e, 0x05, 0x17, 0x3b, 0x3a, 0x88, 0xbc, 0x78,
+	0x98, 0xfe, 0x20, 0xe4, 0x11, 0x7e, 0xb7, 0x29, 0xd3, 0x68, 0x50, 0x18, 0x2e, 0x05, 0x1d, 0xda,
+	0xdf, 0x4c, 0xdb, 0x41, 0x7a, 0xe5, 0x4d, 0x11, 0xa5, 0x71, 0xcb, 0x3f, 0xe8, 0xc8, 0x15, 0x71,
+	0x89, 0x3c, 0xc0, 0x14, 0x45, 0xa1, 0x6b, 0x65, 0xb0, 0xcc, 0x5a, 0x03, 0xac, 0x31, 0xf9, 0xe6,
+	0x6f, 0x4e, 0xfd, 0x07, 0x43, 0xfb, 0xe0, 0xe6, 0x4a, 0x41, 0xe4, 0xc5, 0x61, 0x3a, 0x68, 0x72,
+	0x73, 0xa6, 0xff, 0x00, 0x95, 0xd1, 0xc8, 0x4e, 0x76, 0x18, 0xda, 0xe1, 0xc8, 0x91, 0x35, 0xd6,
+	0x2f, 0xbd, 0xf7, 0x8e, 0xca, 0x73, 0xdf, 0xde, 0xe4, 0xf9, 0x6b, 0x00, 0xf8, 0xe4, 0x04, 0x0b,
+	0xff, 0x01, 0x00, 0x00,
+}
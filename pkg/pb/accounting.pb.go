@@ -0,0 +1,338 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: accounting.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// UsageRequest asks for the usage of the project of the API key between start
+// and end, of a single bucket or of all buckets
+type UsageRequest struct {
+	APIKey               []byte               `protobuf:"bytes,1,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	Bucket               string               `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Start                *timestamp.Timestamp `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End                  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *UsageRequest) Reset()         { *m = UsageRequest{} }
+func (m *UsageRequest) String() string { return proto.CompactTextString(m) }
+func (*UsageRequest) ProtoMessage()    {}
+func (*UsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3d75761beb5907, []int{0}
+}
+func (m *UsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsageRequest.Unmarshal(m, b)
+}
+func (m *UsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *UsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageRequest.Merge(dst, src)
+}
+func (m *UsageRequest) XXX_Size() int {
+	return xxx_messageInfo_UsageRequest.Size(m)
+}
+func (m *UsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageRequest proto.InternalMessageInfo
+
+func (m *UsageRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *UsageRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *UsageRequest) GetStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *UsageRequest) GetEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+// BucketUsage is the data a bucket stored during the requested period in
+// byte-hours and the bytes of its last tally in the period
+type BucketUsage struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	RemoteByteHours      float64  `protobuf:"fixed64,2,opt,name=remote_byte_hours,json=remoteByteHours,proto3" json:"remote_byte_hours,omitempty"`
+	InlineByteHours      float64  `protobuf:"fixed64,3,opt,name=inline_byte_hours,json=inlineByteHours,proto3" json:"inline_byte_hours,omitempty"`
+	RemoteBytes          int64    `protobuf:"varint,4,opt,name=remote_bytes,json=remoteBytes,proto3" json:"remote_bytes,omitempty"`
+	InlineBytes          int64    `protobuf:"varint,5,opt,name=inline_bytes,json=inlineBytes,proto3" json:"inline_bytes,omitempty"`
+	RemoteSegments       int64    `protobuf:"varint,6,opt,name=remote_segments,json=remoteSegments,proto3" json:"remote_segments,omitempty"`
+	InlineSegments       int64    `protobuf:"varint,7,opt,name=inline_segments,json=inlineSegments,proto3" json:"inline_segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BucketUsage) Reset()         { *m = BucketUsage{} }
+func (m *BucketUsage) String() string { return proto.CompactTextString(m) }
+func (*BucketUsage) ProtoMessage()    {}
+func (*BucketUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3d75761beb5907, []int{1}
+}
+func (m *BucketUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketUsage.Unmarshal(m, b)
+}
+func (m *BucketUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketUsage.Marshal(b, m, deterministic)
+}
+func (dst *BucketUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketUsage.Merge(dst, src)
+}
+func (m *BucketUsage) XXX_Size() int {
+	return xxx_messageInfo_BucketUsage.Size(m)
+}
+func (m *BucketUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketUsage proto.InternalMessageInfo
+
+func (m *BucketUsage) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *BucketUsage) GetRemoteByteHours() float64 {
+	if m != nil {
+		return m.RemoteByteHours
+	}
+	return 0
+}
+
+func (m *BucketUsage) GetInlineByteHours() float64 {
+	if m != nil {
+		return m.InlineByteHours
+	}
+	return 0
+}
+
+func (m *BucketUsage) GetRemoteBytes() int64 {
+	if m != nil {
+		return m.RemoteBytes
+	}
+	return 0
+}
+
+func (m *BucketUsage) GetInlineBytes() int64 {
+	if m != nil {
+		return m.InlineBytes
+	}
+	return 0
+}
+
+func (m *BucketUsage) GetRemoteSegments() int64 {
+	if m != nil {
+		return m.RemoteSegments
+	}
+	return 0
+}
+
+func (m *BucketUsage) GetInlineSegments() int64 {
+	if m != nil {
+		return m.InlineSegments
+	}
+	return 0
+}
+
+type UsageResponse struct {
+	Buckets              []*BucketUsage `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	RemoteByteHours      float64        `protobuf:"fixed64,2,opt,name=remote_byte_hours,json=remoteByteHours,proto3" json:"remote_byte_hours,omitempty"`
+	InlineByteHours      float64        `protobuf:"fixed64,3,opt,name=inline_byte_hours,json=inlineByteHours,proto3" json:"inline_byte_hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *UsageResponse) Reset()         { *m = UsageResponse{} }
+func (m *UsageResponse) String() string { return proto.CompactTextString(m) }
+func (*UsageResponse) ProtoMessage()    {}
+func (*UsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb3d75761beb5907, []int{2}
+}
+func (m *UsageResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UsageResponse.Unmarshal(m, b)
+}
+func (m *UsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UsageResponse.Marshal(b, m, deterministic)
+}
+func (dst *UsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsageResponse.Merge(dst, src)
+}
+func (m *UsageResponse) XXX_Size() int {
+	return xxx_messageInfo_UsageResponse.Size(m)
+}
+func (m *UsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsageResponse proto.InternalMessageInfo
+
+func (m *UsageResponse) GetBuckets() []*BucketUsage {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+func (m *UsageResponse) GetRemoteByteHours() float64 {
+	if m != nil {
+		return m.RemoteByteHours
+	}
+	return 0
+}
+
+func (m *UsageResponse) GetInlineByteHours() float64 {
+	if m != nil {
+		return m.InlineByteHours
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*UsageRequest)(nil), "accounting.UsageRequest")
+	proto.RegisterType((*BucketUsage)(nil), "accounting.BucketUsage")
+	proto.RegisterType((*UsageResponse)(nil), "accounting.UsageResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AccountingClient is the client API for Accounting service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AccountingClient interface {
+	Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error)
+}
+
+type accountingClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAccountingClient(cc *grpc.ClientConn) AccountingClient {
+	return &accountingClient{cc}
+}
+
+func (c *accountingClient) Usage(ctx context.Context, in *UsageRequest, opts ...grpc.CallOption) (*UsageResponse, error) {
+	out := new(UsageResponse)
+	err := c.cc.Invoke(ctx, "/accounting.Accounting/Usage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountingServer is the server API for Accounting service.
+type AccountingServer interface {
+	Usage(context.Context, *UsageRequest) (*UsageResponse, error)
+}
+
+func RegisterAccountingServer(s *grpc.Server, srv AccountingServer) {
+	s.RegisterService(&_Accounting_serviceDesc, srv)
+}
+
+func _Accounting_Usage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountingServer).Usage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/accounting.Accounting/Usage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountingServer).Usage(ctx, req.(*UsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Accounting_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "accounting.Accounting",
+	HandlerType: (*AccountingServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Usage",
+			Handler:    _Accounting_Usage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "accounting.proto",
+}
+
+func init() { proto.RegisterFile("accounting.proto", fileDescriptor_cb3d75761beb5907) }
+
+var fileDescriptor_cb3d75761beb5907 = []byte{
+	// 361 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0x3f, 0x53, 0xab, 0x40,
+	0x14, 0xc5, 0x67, 0x43, 0x42, 0xe6, 0x5d, 0xf2, 0xfe, 0x6d, 0xf1, 0xb2, 0x2f, 0xcd, 0xe3, 0xa5,
+	0x91, 0x71, 0x1c, 0xa2, 0xb1, 0xb5, 0x49, 0x2a, 0xa3, 0x4d, 0x06, 0xb5, 0xb1, 0x61, 0x20, 0x5e,
+	0x91, 0x49, 0x60, 0x91, 0x5d, 0x0a, 0x3e, 0x88, 0x8d, 0x8d, 0x5f, 0xd5, 0x61, 0x17, 0x02, 0x99,
+	0x71, 0xc6, 0xca, 0x92, 0x73, 0x7f, 0x73, 0xce, 0xe1, 0xee, 0x85, 0x5f, 0xc1, 0x66, 0xc3, 0x8b,
+	0x54, 0xc6, 0x69, 0xe4, 0x66, 0x39, 0x97, 0x9c, 0x42, 0xab, 0x4c, 0xfe, 0x45, 0x9c, 0x47, 0x3b,
+	0x9c, 0xa9, 0x49, 0x58, 0x3c, 0xce, 0x64, 0x9c, 0xa0, 0x90, 0x41, 0x92, 0x69, 0x78, 0xfa, 0x46,
+	0x60, 0x74, 0x27, 0x82, 0x08, 0x3d, 0x7c, 0x2e, 0x50, 0x48, 0x3a, 0x86, 0xe1, 0x62, 0xbd, 0xf2,
+	0xb7, 0x58, 0x32, 0x62, 0x13, 0x67, 0xe4, 0x99, 0x8b, 0xf5, 0xea, 0x1a, 0x4b, 0xfa, 0x07, 0xcc,
+	0xb0, 0xd8, 0x6c, 0x51, 0xb2, 0x9e, 0x4d, 0x9c, 0x6f, 0x5e, 0xfd, 0x45, 0x4f, 0x61, 0x20, 0x64,
+	0x90, 0x4b, 0x66, 0xd8, 0xc4, 0xb1, 0xe6, 0x13, 0x57, 0x47, 0xba, 0x4d, 0xa4, 0x7b, 0xdb, 0x44,
+	0x7a, 0x1a, 0xa4, 0x27, 0x60, 0x60, 0xfa, 0xc0, 0xfa, 0x9f, 0xf2, 0x15, 0x36, 0x7d, 0xe9, 0x81,
+	0xb5, 0x54, 0x51, 0xaa, 0x67, 0xa7, 0x07, 0x39, 0xe8, 0x71, 0x0c, 0xbf, 0x73, 0x4c, 0xb8, 0x44,
+	0x3f, 0x2c, 0x25, 0xfa, 0x4f, 0xbc, 0xc8, 0x85, 0xaa, 0x4a, 0xbc, 0x9f, 0x7a, 0xb0, 0x2c, 0x25,
+	0x5e, 0x56, 0x72, 0xc5, 0xc6, 0xe9, 0x2e, 0x4e, 0x0f, 0x58, 0x43, 0xb3, 0x7a, 0xd0, 0xb2, 0xff,
+	0x61, 0xd4, 0xf1, 0x15, 0xaa, 0xb6, 0xe1, 0x59, 0xad, 0xa5, 0x42, 0x3a, 0x76, 0x82, 0x0d, 0x34,
+	0xd2, 0x3a, 0x09, 0x7a, 0x04, 0x75, 0x09, 0x5f, 0x60, 0x94, 0x60, 0x2a, 0x05, 0x33, 0x15, 0xf5,
+	0x43, 0xcb, 0x37, 0xb5, 0x5a, 0x81, 0xb5, 0xd7, 0x1e, 0x1c, 0x6a, 0x50, 0xcb, 0x0d, 0x38, 0x7d,
+	0x25, 0xf0, 0xbd, 0x7e, 0x39, 0x91, 0xf1, 0x54, 0x20, 0x3d, 0x83, 0xa1, 0xde, 0x85, 0x60, 0xc4,
+	0x36, 0x1c, 0x6b, 0x3e, 0x76, 0x3b, 0xc7, 0xd1, 0xd9, 0xa1, 0xd7, 0x70, 0x5f, 0xb5, 0xb4, 0xf9,
+	0x15, 0xc0, 0x62, 0x1f, 0x4d, 0x2f, 0x60, 0xa0, 0xdf, 0x8e, 0x75, 0x0b, 0x75, 0xcf, 0x6e, 0xf2,
+	0xf7, 0x83, 0x89, 0xfe, 0xad, 0x65, 0xff, 0xbe, 0x97, 0x85, 0xa1, 0xa9, 0xee, 0xe3, 0xfc, 0x7d,
+	0x00, 0x9c, 0xbd, 0x1e, 0x0c, 0xf0, 0x02, 0x00, 0x00,
+}
@@ -3,7 +3,11 @@
 
 package pb
 
+//go:generate protoc --go_out=plugins=grpc:. access.proto
+//go:generate protoc --go_out=plugins=grpc:. accounting.proto
+//go:generate protoc --go_out=plugins=grpc:. macaroon.proto
 //go:generate protoc --go_out=plugins=grpc:. meta.proto
 //go:generate protoc --go_out=plugins=grpc:. overlay.proto
 //go:generate protoc --go_out=plugins=grpc:. pointerdb.proto
 //go:generate protoc --go_out=plugins=grpc:. piecestore.proto
+//go:generate protoc --go_out=plugins=grpc:. projects.proto
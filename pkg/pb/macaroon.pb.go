@@ -0,0 +1,249 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: macaroon.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Macaroon is an API key whose permissions are restricted by caveats.
+// Every caveat is chained into the tail with HMAC-SHA256, starting with the
+// root secret of the key and the head, which identifies the root secret.
+type Macaroon struct {
+	Head                 []byte   `protobuf:"bytes,1,opt,name=head,proto3" json:"head,omitempty"`
+	Caveats              [][]byte `protobuf:"bytes,2,rep,name=caveats,proto3" json:"caveats,omitempty"`
+	Tail                 []byte   `protobuf:"bytes,3,opt,name=tail,proto3" json:"tail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Macaroon) Reset()         { *m = Macaroon{} }
+func (m *Macaroon) String() string { return proto.CompactTextString(m) }
+func (*Macaroon) ProtoMessage()    {}
+func (*Macaroon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{0}
+}
+func (m *Macaroon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Macaroon.Unmarshal(m, b)
+}
+func (m *Macaroon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Macaroon.Marshal(b, m, deterministic)
+}
+func (dst *Macaroon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Macaroon.Merge(dst, src)
+}
+func (m *Macaroon) XXX_Size() int {
+	return xxx_messageInfo_Macaroon.Size(m)
+}
+func (m *Macaroon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Macaroon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Macaroon proto.InternalMessageInfo
+
+func (m *Macaroon) GetHead() []byte {
+	if m != nil {
+		return m.Head
+	}
+	return nil
+}
+
+func (m *Macaroon) GetCaveats() [][]byte {
+	if m != nil {
+		return m.Caveats
+	}
+	return nil
+}
+
+func (m *Macaroon) GetTail() []byte {
+	if m != nil {
+		return m.Tail
+	}
+	return nil
+}
+
+// Caveat restricts the permissions of a macaroon. A request must be allowed
+// by all caveats of the macaroon.
+type Caveat struct {
+	DisallowReads   bool `protobuf:"varint,1,opt,name=disallow_reads,json=disallowReads,proto3" json:"disallow_reads,omitempty"`
+	DisallowWrites  bool `protobuf:"varint,2,opt,name=disallow_writes,json=disallowWrites,proto3" json:"disallow_writes,omitempty"`
+	DisallowLists   bool `protobuf:"varint,3,opt,name=disallow_lists,json=disallowLists,proto3" json:"disallow_lists,omitempty"`
+	DisallowDeletes bool `protobuf:"varint,4,opt,name=disallow_deletes,json=disallowDeletes,proto3" json:"disallow_deletes,omitempty"`
+	// if not empty, only the listed paths are allowed
+	AllowedPaths         []*Caveat_Path       `protobuf:"bytes,5,rep,name=allowed_paths,json=allowedPaths,proto3" json:"allowed_paths,omitempty"`
+	NotBefore            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Caveat) Reset()         { *m = Caveat{} }
+func (m *Caveat) String() string { return proto.CompactTextString(m) }
+func (*Caveat) ProtoMessage()    {}
+func (*Caveat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{1}
+}
+func (m *Caveat) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Caveat.Unmarshal(m, b)
+}
+func (m *Caveat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Caveat.Marshal(b, m, deterministic)
+}
+func (dst *Caveat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Caveat.Merge(dst, src)
+}
+func (m *Caveat) XXX_Size() int {
+	return xxx_messageInfo_Caveat.Size(m)
+}
+func (m *Caveat) XXX_DiscardUnknown() {
+	xxx_messageInfo_Caveat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Caveat proto.InternalMessageInfo
+
+func (m *Caveat) GetDisallowReads() bool {
+	if m != nil {
+		return m.DisallowReads
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowWrites() bool {
+	if m != nil {
+		return m.DisallowWrites
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowLists() bool {
+	if m != nil {
+		return m.DisallowLists
+	}
+	return false
+}
+
+func (m *Caveat) GetDisallowDeletes() bool {
+	if m != nil {
+		return m.DisallowDeletes
+	}
+	return false
+}
+
+func (m *Caveat) GetAllowedPaths() []*Caveat_Path {
+	if m != nil {
+		return m.AllowedPaths
+	}
+	return nil
+}
+
+func (m *Caveat) GetNotBefore() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotBefore
+	}
+	return nil
+}
+
+func (m *Caveat) GetNotAfter() *timestamp.Timestamp {
+	if m != nil {
+		return m.NotAfter
+	}
+	return nil
+}
+
+// Path allows the encrypted paths below a prefix in a bucket, or the
+// whole bucket if the prefix is empty
+type Caveat_Path struct {
+	Bucket               string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	EncryptedPathPrefix  string   `protobuf:"bytes,2,opt,name=encrypted_path_prefix,json=encryptedPathPrefix,proto3" json:"encrypted_path_prefix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Caveat_Path) Reset()         { *m = Caveat_Path{} }
+func (m *Caveat_Path) String() string { return proto.CompactTextString(m) }
+func (*Caveat_Path) ProtoMessage()    {}
+func (*Caveat_Path) Descriptor() ([]byte, []int) {
+	return fileDescriptor_546010ed3a9cf83d, []int{1, 0}
+}
+func (m *Caveat_Path) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Caveat_Path.Unmarshal(m, b)
+}
+func (m *Caveat_Path) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Caveat_Path.Marshal(b, m, deterministic)
+}
+func (dst *Caveat_Path) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Caveat_Path.Merge(dst, src)
+}
+func (m *Caveat_Path) XXX_Size() int {
+	return xxx_messageInfo_Caveat_Path.Size(m)
+}
+func (m *Caveat_Path) XXX_DiscardUnknown() {
+	xxx_messageInfo_Caveat_Path.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Caveat_Path proto.InternalMessageInfo
+
+func (m *Caveat_Path) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *Caveat_Path) GetEncryptedPathPrefix() string {
+	if m != nil {
+		return m.EncryptedPathPrefix
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Macaroon)(nil), "macaroon.Macaroon")
+	proto.RegisterType((*Caveat)(nil), "macaroon.Caveat")
+	proto.RegisterType((*Caveat_Path)(nil), "macaroon.Caveat.Path")
+}
+
+func init() { proto.RegisterFile("macaroon.proto", fileDescriptor_546010ed3a9cf83d) }
+
+var fileDescriptor_546010ed3a9cf83d = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x4f, 0x6b, 0xdb, 0x40,
+	0x10, 0xc5, 0xb1, 0xa5, 0xca, 0xf2, 0xfa, 0x4f, 0xcb, 0x16, 0x97, 0xc5, 0x97, 0x0a, 0x43, 0xa9,
+	0x7a, 0x91, 0xc1, 0x3d, 0x94, 0xf6, 0x56, 0x27, 0x47, 0x07, 0xcc, 0x12, 0x08, 0xe4, 0x22, 0x56,
+	0xd2, 0xc8, 0x16, 0x91, 0xb5, 0x62, 0x77, 0x1c, 0x27, 0x5f, 0x2a, 0x9f, 0x31, 0xec, 0xea, 0x0f,
+	0xf8, 0x94, 0xdb, 0xbe, 0x37, 0xbf, 0x7d, 0xc3, 0x3c, 0x32, 0x3f, 0x89, 0x54, 0x28, 0x29, 0xab,
+	0xa8, 0x56, 0x12, 0x25, 0xf5, 0x3b, 0xbd, 0xfc, 0x7e, 0x90, 0xf2, 0x50, 0xc2, 0xda, 0xfa, 0xc9,
+	0x39, 0x5f, 0x63, 0x71, 0x02, 0x8d, 0xe2, 0x54, 0x37, 0xe8, 0x6a, 0x47, 0xfc, 0xbb, 0x16, 0xa6,
+	0x94, 0xb8, 0x47, 0x10, 0x19, 0x1b, 0x04, 0x83, 0x70, 0xca, 0xed, 0x9b, 0x32, 0x32, 0x4a, 0xc5,
+	0x33, 0x08, 0xd4, 0x6c, 0x18, 0x38, 0xe1, 0x94, 0x77, 0xd2, 0xd0, 0x28, 0x8a, 0x92, 0x39, 0x0d,
+	0x6d, 0xde, 0xab, 0x37, 0x87, 0x78, 0x37, 0x76, 0x4e, 0x7f, 0x90, 0x79, 0x56, 0x68, 0x51, 0x96,
+	0xf2, 0x12, 0x2b, 0x10, 0x99, 0xb6, 0xb1, 0x3e, 0x9f, 0x75, 0x2e, 0x37, 0x26, 0xfd, 0x49, 0x3e,
+	0xf7, 0xd8, 0x45, 0x15, 0x08, 0x66, 0x8f, 0xe1, 0xfa, 0xdf, 0x0f, 0xd6, 0xbd, 0xca, 0x2b, 0x0b,
+	0x8d, 0x9a, 0x39, 0xd7, 0x79, 0x3b, 0x63, 0xd2, 0x5f, 0xe4, 0x4b, 0x8f, 0x65, 0x50, 0x82, 0x09,
+	0x74, 0x2d, 0xd8, 0xef, 0xb9, 0x6d, 0x6c, 0xfa, 0x8f, 0xcc, 0xac, 0x86, 0x2c, 0xae, 0x05, 0x1e,
+	0x35, 0xfb, 0x14, 0x38, 0xe1, 0x64, 0xb3, 0x88, 0xfa, 0x36, 0x9b, 0x53, 0xa2, 0xbd, 0xc0, 0x23,
+	0x9f, 0xb6, 0xac, 0x11, 0x9a, 0xfe, 0x25, 0xa4, 0x92, 0x18, 0x27, 0x90, 0x4b, 0x05, 0xcc, 0x0b,
+	0x06, 0xe1, 0x64, 0xb3, 0x8c, 0x9a, 0xb2, 0xa3, 0xae, 0xec, 0xe8, 0xbe, 0x2b, 0x9b, 0x8f, 0x2b,
+	0x89, 0x5b, 0x0b, 0xd3, 0x3f, 0xc4, 0x88, 0x58, 0xe4, 0x08, 0x8a, 0x8d, 0x3e, 0xfc, 0xe9, 0x57,
+	0x12, 0xff, 0x1b, 0x76, 0xc9, 0x89, 0x6b, 0x96, 0xd3, 0x6f, 0xc4, 0x4b, 0xce, 0xe9, 0x13, 0xa0,
+	0x6d, 0x74, 0xcc, 0x5b, 0x45, 0x37, 0x64, 0x01, 0x55, 0xaa, 0x5e, 0x6b, 0x6c, 0x2f, 0x8a, 0x6b,
+	0x05, 0x79, 0xf1, 0x62, 0x0b, 0x1d, 0xf3, 0xaf, 0xfd, 0xd0, 0xa4, 0xec, 0xed, 0x68, 0xeb, 0x3e,
+	0x0e, 0xeb, 0x24, 0xf1, 0xec, 0xde, 0xdf, 0xef, 0x03, 0x00, 0x60, 0x97, 0x7e, 0xa0, 0x48, 0x02,
+	0x00, 0x00,
+}
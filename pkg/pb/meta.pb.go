@@ -26,6 +26,11 @@ type MetaStreamInfo struct {
 	EncryptionType           int32    `protobuf:"varint,5,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
 	EncryptionBlockSize      int32    `protobuf:"varint,6,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
 	LastSegmentEncryptionKey []byte   `protobuf:"bytes,7,opt,name=last_segment_encryption_key,json=lastSegmentEncryptionKey,proto3" json:"last_segment_encryption_key,omitempty"`
+	EncryptedChecksum        []byte   `protobuf:"bytes,8,opt,name=encrypted_checksum,json=encryptedChecksum,proto3" json:"encrypted_checksum,omitempty"`
+	EncryptedMetadata        []byte   `protobuf:"bytes,9,opt,name=encrypted_metadata,json=encryptedMetadata,proto3" json:"encrypted_metadata,omitempty"`
+	VersionId                string   `protobuf:"bytes,10,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	KeyId                    []byte   `protobuf:"bytes,11,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	RandomNonces             bool     `protobuf:"varint,12,opt,name=random_nonces,json=randomNonces,proto3" json:"random_nonces,omitempty"`
 	XXX_NoUnkeyedLiteral     struct{} `json:"-"`
 	XXX_unrecognized         []byte   `json:"-"`
 	XXX_sizecache            int32    `json:"-"`
@@ -104,28 +109,153 @@ func (m *MetaStreamInfo) GetLastSegmentEncryptionKey() []byte {
 	return nil
 }
 
+func (m *MetaStreamInfo) GetEncryptedChecksum() []byte {
+	if m != nil {
+		return m.EncryptedChecksum
+	}
+	return nil
+}
+
+func (m *MetaStreamInfo) GetEncryptedMetadata() []byte {
+	if m != nil {
+		return m.EncryptedMetadata
+	}
+	return nil
+}
+
+func (m *MetaStreamInfo) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
+func (m *MetaStreamInfo) GetKeyId() []byte {
+	if m != nil {
+		return m.KeyId
+	}
+	return nil
+}
+
+func (m *MetaStreamInfo) GetRandomNonces() bool {
+	if m != nil {
+		return m.RandomNonces
+	}
+	return false
+}
+
+type PendingUpload struct {
+	SegmentsSize           int64    `protobuf:"varint,1,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	EncryptionType         int32    `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
+	EncryptionBlockSize    int32    `protobuf:"varint,3,opt,name=encryption_block_size,json=encryptionBlockSize,proto3" json:"encryption_block_size,omitempty"`
+	ParallelSegments       int32    `protobuf:"varint,4,opt,name=parallel_segments,json=parallelSegments,proto3" json:"parallel_segments,omitempty"`
+	CommittedSegments      int64    `protobuf:"varint,5,opt,name=committed_segments,json=committedSegments,proto3" json:"committed_segments,omitempty"`
+	EncryptedChecksumState []byte   `protobuf:"bytes,6,opt,name=encrypted_checksum_state,json=encryptedChecksumState,proto3" json:"encrypted_checksum_state,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *PendingUpload) Reset()         { *m = PendingUpload{} }
+func (m *PendingUpload) String() string { return proto.CompactTextString(m) }
+func (*PendingUpload) ProtoMessage()    {}
+func (*PendingUpload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{1}
+}
+func (m *PendingUpload) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingUpload.Unmarshal(m, b)
+}
+func (m *PendingUpload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingUpload.Marshal(b, m, deterministic)
+}
+func (dst *PendingUpload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingUpload.Merge(dst, src)
+}
+func (m *PendingUpload) XXX_Size() int {
+	return xxx_messageInfo_PendingUpload.Size(m)
+}
+func (m *PendingUpload) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingUpload.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingUpload proto.InternalMessageInfo
+
+func (m *PendingUpload) GetSegmentsSize() int64 {
+	if m != nil {
+		return m.SegmentsSize
+	}
+	return 0
+}
+
+func (m *PendingUpload) GetEncryptionType() int32 {
+	if m != nil {
+		return m.EncryptionType
+	}
+	return 0
+}
+
+func (m *PendingUpload) GetEncryptionBlockSize() int32 {
+	if m != nil {
+		return m.EncryptionBlockSize
+	}
+	return 0
+}
+
+func (m *PendingUpload) GetParallelSegments() int32 {
+	if m != nil {
+		return m.ParallelSegments
+	}
+	return 0
+}
+
+func (m *PendingUpload) GetCommittedSegments() int64 {
+	if m != nil {
+		return m.CommittedSegments
+	}
+	return 0
+}
+
+func (m *PendingUpload) GetEncryptedChecksumState() []byte {
+	if m != nil {
+		return m.EncryptedChecksumState
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MetaStreamInfo)(nil), "streams.MetaStreamInfo")
+	proto.RegisterType((*PendingUpload)(nil), "streams.PendingUpload")
 }
 
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0x49, 0xfa, 0x4f, 0x86, 0xda, 0xea, 0x8a, 0xb0, 0xe8, 0x25, 0xe8, 0xc1, 0x20, 0xe2,
-	0x41, 0xcf, 0x5e, 0x0a, 0x1e, 0x44, 0x44, 0x48, 0x3c, 0x79, 0x09, 0x9b, 0x3a, 0x91, 0xd0, 0x66,
-	0x77, 0xc9, 0x8e, 0x87, 0xed, 0x17, 0xf4, 0x6b, 0x49, 0x26, 0x4d, 0x13, 0x8f, 0xf3, 0xde, 0x8f,
-	0xf7, 0x1e, 0x03, 0x50, 0x21, 0xa9, 0x7b, 0x5b, 0x1b, 0x32, 0x62, 0xe6, 0xa8, 0x46, 0x55, 0xb9,
-	0xab, 0xdf, 0x10, 0x16, 0x6f, 0x48, 0x2a, 0xe5, 0xfb, 0x45, 0x17, 0x46, 0xdc, 0x81, 0xd0, 0x3f,
-	0x55, 0x8e, 0x75, 0x66, 0x8a, 0xcc, 0xe1, 0x77, 0x85, 0x9a, 0x9c, 0x0c, 0xa2, 0x20, 0x1e, 0x25,
-	0x27, 0xad, 0xf3, 0x5e, 0xa4, 0x7b, 0x5d, 0x5c, 0xc3, 0x71, 0xc7, 0x64, 0xae, 0xdc, 0xa1, 0x0c,
-	0x19, 0x9c, 0x77, 0x62, 0x5a, 0xee, 0x50, 0xdc, 0xc2, 0xe9, 0x56, 0x39, 0xea, 0xd2, 0x5a, 0x70,
-	0xc4, 0xe0, 0xb2, 0x31, 0xf6, 0x69, 0xcc, 0x5e, 0xc0, 0x51, 0x33, 0xf4, 0x4b, 0x91, 0x92, 0xe3,
-	0x28, 0x88, 0xe7, 0xc9, 0xe1, 0x16, 0x37, 0xb0, 0x44, 0xbd, 0xae, 0xbd, 0xa5, 0xd2, 0xe8, 0x8c,
-	0xbc, 0x45, 0x39, 0x89, 0x82, 0x78, 0x92, 0x2c, 0x7a, 0xf9, 0xc3, 0x5b, 0x14, 0x0f, 0x70, 0x3e,
-	0x00, 0xf3, 0xad, 0x59, 0x6f, 0xda, 0xd2, 0x29, 0xe3, 0x67, 0xbd, 0xb9, 0x6a, 0x3c, 0x2e, 0x7e,
-	0x82, 0xcb, 0x7f, 0x23, 0x07, 0x01, 0x1b, 0xf4, 0x72, 0xc6, 0x5b, 0xe4, 0x60, 0xee, 0xf3, 0x01,
-	0x78, 0x45, 0xbf, 0x1a, 0x7f, 0x86, 0x36, 0xcf, 0xa7, 0xfc, 0xdf, 0xc7, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x9e, 0xf7, 0xce, 0x8a, 0x6d, 0x01, 0x00, 0x00,
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xcd, 0x6e, 0xd4, 0x30,
+	0x14, 0x85, 0x95, 0xf9, 0xeb, 0xcc, 0x25, 0xfd, 0x19, 0xa3, 0x22, 0x0b, 0x84, 0x14, 0x95, 0x05,
+	0x11, 0x50, 0x16, 0xb0, 0x61, 0xc3, 0xa6, 0x88, 0xc5, 0x08, 0x15, 0x50, 0x06, 0x36, 0x6c, 0x22,
+	0x27, 0xbe, 0x53, 0xa2, 0xc4, 0x76, 0x14, 0xbb, 0x48, 0xe9, 0x8a, 0xb7, 0xe1, 0x35, 0x91, 0x9d,
+	0xc4, 0x33, 0x65, 0xca, 0xa2, 0x4b, 0x9f, 0xf3, 0xf9, 0xfa, 0x38, 0xc7, 0x01, 0x10, 0x68, 0xd8,
+	0xeb, 0xba, 0x51, 0x46, 0x91, 0x03, 0x6d, 0x1a, 0x64, 0x42, 0x9f, 0xfd, 0x9e, 0xc0, 0xd1, 0x25,
+	0x1a, 0xb6, 0x76, 0xeb, 0x95, 0xdc, 0x28, 0xf2, 0x0a, 0x88, 0xbc, 0x16, 0x19, 0x36, 0xa9, 0xda,
+	0xa4, 0x1a, 0xaf, 0x04, 0x4a, 0xa3, 0x69, 0x10, 0x05, 0xf1, 0x38, 0x39, 0xe9, 0x9c, 0x2f, 0x9b,
+	0x75, 0xaf, 0x93, 0x67, 0x70, 0x38, 0x30, 0xa9, 0x2e, 0x6e, 0x90, 0x8e, 0x1c, 0x18, 0x0e, 0xe2,
+	0xba, 0xb8, 0x41, 0xf2, 0x02, 0x96, 0x15, 0xd3, 0x66, 0x98, 0xd6, 0x81, 0x63, 0x07, 0x1e, 0x5b,
+	0xa3, 0x9f, 0xe6, 0xd8, 0xc7, 0x30, 0xb7, 0x41, 0x39, 0x33, 0x8c, 0x4e, 0xa2, 0x20, 0x0e, 0x13,
+	0xbf, 0x26, 0xcf, 0xe1, 0x18, 0x65, 0xde, 0xb4, 0xb5, 0x29, 0x94, 0x4c, 0x4d, 0x5b, 0x23, 0x9d,
+	0x46, 0x41, 0x3c, 0x4d, 0x8e, 0xb6, 0xf2, 0xb7, 0xb6, 0x46, 0xf2, 0x06, 0x4e, 0x77, 0xc0, 0xac,
+	0x52, 0x79, 0xd9, 0x1d, 0x3a, 0x73, 0xf8, 0xc3, 0xad, 0x79, 0x61, 0x3d, 0x77, 0xf0, 0x7b, 0x78,
+	0x72, 0x2b, 0xe4, 0xce, 0x80, 0x12, 0x5b, 0x7a, 0xe0, 0xb2, 0xd0, 0x9d, 0xb8, 0x1f, 0x3d, 0xf0,
+	0x09, 0x5b, 0x72, 0x0e, 0xa4, 0xdf, 0x81, 0x3c, 0xcd, 0x7f, 0x62, 0x5e, 0xea, 0x6b, 0x41, 0xe7,
+	0x6e, 0xd7, 0xd2, 0x3b, 0x1f, 0x7a, 0xe3, 0x36, 0xee, 0x2f, 0xbc, 0xf8, 0x07, 0xbf, 0x1c, 0x6e,
+	0xfe, 0x14, 0xe0, 0x17, 0x36, 0xda, 0x86, 0x29, 0x38, 0x85, 0x28, 0x88, 0x17, 0xc9, 0xa2, 0x57,
+	0x56, 0x9c, 0x9c, 0xc2, 0xac, 0xc4, 0xd6, 0x5a, 0x0f, 0xdc, 0x84, 0x69, 0x89, 0xed, 0x8a, 0xdb,
+	0x72, 0x1a, 0x26, 0xb9, 0x12, 0xa9, 0x54, 0x32, 0x47, 0x4d, 0xc3, 0x28, 0x88, 0xe7, 0x49, 0xd8,
+	0x89, 0x9f, 0x9d, 0x76, 0xf6, 0x67, 0x04, 0x87, 0x5f, 0x51, 0xf2, 0x42, 0x5e, 0x7d, 0xaf, 0x2b,
+	0xc5, 0xf8, 0x7e, 0xa7, 0xc1, 0x1d, 0x9d, 0xde, 0xd1, 0xc5, 0xe8, 0x7e, 0x5d, 0x8c, 0xff, 0xdf,
+	0xc5, 0x4b, 0x58, 0xd6, 0xac, 0x61, 0x55, 0x85, 0xd5, 0xf6, 0x09, 0x4e, 0x1c, 0x7f, 0x32, 0x18,
+	0xfe, 0x09, 0x9e, 0x03, 0xc9, 0x95, 0x10, 0x85, 0xb1, 0x9f, 0xd2, 0xd3, 0x53, 0x97, 0x79, 0xe9,
+	0x1d, 0x8f, 0xbf, 0x03, 0xba, 0x5f, 0x54, 0xaa, 0x0d, 0x33, 0xdd, 0xf3, 0x08, 0x93, 0x47, 0x7b,
+	0x75, 0xad, 0xad, 0x7b, 0x31, 0xf9, 0x31, 0xaa, 0xb3, 0x6c, 0xe6, 0x7e, 0xa1, 0xb7, 0x7f, 0x07,
+	0x00, 0xd8, 0x80, 0xbe, 0xac, 0x50, 0x03, 0x00, 0x00,
 }
@@ -34,7 +34,6 @@ const (
 var NodeTransport_name = map[int32]string{
 	0: "TCP",
 }
-
 var NodeTransport_value = map[string]int32{
 	"TCP": 0,
 }
@@ -42,7 +41,6 @@ var NodeTransport_value = map[string]int32{
 func (x NodeTransport) String() string {
 	return proto.EnumName(NodeTransport_name, int32(x))
 }
-
 func (NodeTransport) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61fc82527fbe24ad, []int{0}
 }
@@ -59,7 +57,6 @@ var NodeType_name = map[int32]string{
 	0: "ADMIN",
 	1: "STORAGE",
 }
-
 var NodeType_value = map[string]int32{
 	"ADMIN":   0,
 	"STORAGE": 1,
@@ -68,7 +65,6 @@ var NodeType_value = map[string]int32{
 func (x NodeType) String() string {
 	return proto.EnumName(NodeType_name, int32(x))
 }
-
 func (NodeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61fc82527fbe24ad, []int{1}
 }
@@ -90,7 +86,6 @@ var Restriction_Operator_name = map[int32]string{
 	3: "LTE",
 	4: "GTE",
 }
-
 var Restriction_Operator_value = map[string]int32{
 	"LT":  0,
 	"EQ":  1,
@@ -102,7 +97,6 @@ var Restriction_Operator_value = map[string]int32{
 func (x Restriction_Operator) String() string {
 	return proto.EnumName(Restriction_Operator_name, int32(x))
 }
-
 func (Restriction_Operator) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61fc82527fbe24ad, []int{13, 0}
 }
@@ -118,7 +112,6 @@ var Restriction_Operand_name = map[int32]string{
 	0: "freeBandwidth",
 	1: "freeDisk",
 }
-
 var Restriction_Operand_value = map[string]int32{
 	"freeBandwidth": 0,
 	"freeDisk":      1,
@@ -127,7 +120,6 @@ var Restriction_Operand_value = map[string]int32{
 func (x Restriction_Operand) String() string {
 	return proto.EnumName(Restriction_Operand_name, int32(x))
 }
-
 func (Restriction_Operand) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_61fc82527fbe24ad, []int{13, 1}
 }
@@ -135,6 +127,7 @@ func (Restriction_Operand) EnumDescriptor() ([]byte, []int) {
 // LookupRequest is is request message for the lookup rpc call
 type LookupRequest struct {
 	NodeID               string   `protobuf:"bytes,1,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	APIKey               []byte   `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -171,6 +164,13 @@ func (m *LookupRequest) GetNodeID() string {
 	return ""
 }
 
+func (m *LookupRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
 // LookupResponse is is response message for the lookup rpc call
 type LookupResponse struct {
 	Node                 *Node    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
@@ -213,6 +213,7 @@ func (m *LookupResponse) GetNode() *Node {
 // LookupRequests is a list of LookupRequest
 type LookupRequests struct {
 	Lookuprequest        []*LookupRequest `protobuf:"bytes,1,rep,name=lookuprequest,proto3" json:"lookuprequest,omitempty"`
+	APIKey               []byte           `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -249,6 +250,13 @@ func (m *LookupRequests) GetLookuprequest() []*LookupRequest {
 	return nil
 }
 
+func (m *LookupRequests) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
 // LookupResponse is a list of LookupResponse
 type LookupResponses struct {
 	Lookupresponse       []*LookupResponse `protobuf:"bytes,1,rep,name=lookupresponse,proto3" json:"lookupresponse,omitempty"`
@@ -332,6 +340,7 @@ type FindStorageNodesRequest struct {
 	ObjectSize           int64              `protobuf:"varint,1,opt,name=objectSize,proto3" json:"objectSize,omitempty"`
 	ContractLength       *duration.Duration `protobuf:"bytes,2,opt,name=contractLength,proto3" json:"contractLength,omitempty"`
 	Opts                 *OverlayOptions    `protobuf:"bytes,3,opt,name=opts,proto3" json:"opts,omitempty"`
+	APIKey               []byte             `protobuf:"bytes,4,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -382,6 +391,13 @@ func (m *FindStorageNodesRequest) GetOpts() *OverlayOptions {
 	return nil
 }
 
+func (m *FindStorageNodesRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=overlay.NodeTransport" json:"transport,omitempty"`
@@ -531,7 +547,7 @@ func (m *NodeRep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NodeRep proto.InternalMessageInfo
 
-//  NodeRestrictions contains all relevant data about a nodes ability to store data
+// NodeRestrictions contains all relevant data about a nodes ability to store data
 type NodeRestrictions struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=freeBandwidth,proto3" json:"freeBandwidth,omitempty"`
 	FreeDisk             int64    `protobuf:"varint,2,opt,name=freeDisk,proto3" json:"freeDisk,omitempty"`
@@ -1035,59 +1051,61 @@ var _Nodes_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("overlay.proto", fileDescriptor_61fc82527fbe24ad) }
 
 var fileDescriptor_61fc82527fbe24ad = []byte{
-	// 860 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x6d, 0x6f, 0x1b, 0x45,
-	0x10, 0xce, 0xf9, 0xdd, 0xe3, 0xd8, 0x5c, 0x47, 0x25, 0x39, 0x2c, 0xa8, 0xd2, 0x85, 0x8a, 0x12,
-	0x24, 0x57, 0x72, 0xab, 0x48, 0x91, 0x40, 0x51, 0x42, 0x42, 0x54, 0x61, 0x12, 0xba, 0xb1, 0x84,
-	0x84, 0xc4, 0x87, 0xb3, 0x6f, 0xeb, 0x1e, 0xb1, 0x6f, 0x8f, 0xdd, 0xbd, 0x82, 0xf9, 0x11, 0xfc,
-	0x0f, 0x24, 0x7e, 0x13, 0xbf, 0x83, 0x4f, 0x08, 0xed, 0xcb, 0x9d, 0x7d, 0x4e, 0x5c, 0xc1, 0xa7,
-	0xf3, 0xcc, 0x3c, 0x33, 0xfb, 0xec, 0x33, 0xb3, 0x63, 0xe8, 0xf2, 0xb7, 0x4c, 0xcc, 0xc3, 0xe5,
-	0x20, 0x15, 0x5c, 0x71, 0x6c, 0x3a, 0xb3, 0xff, 0x68, 0xc6, 0xf9, 0x6c, 0xce, 0x9e, 0x19, 0xf7,
-	0x24, 0x7b, 0xfd, 0x2c, 0xca, 0x44, 0xa8, 0x62, 0x9e, 0x58, 0x20, 0xf9, 0x14, 0xba, 0x23, 0xce,
-	0x6f, 0xb3, 0x94, 0xb2, 0x9f, 0x33, 0x26, 0x15, 0xee, 0x41, 0x23, 0xe1, 0x11, 0x7b, 0x79, 0x1e,
-	0x78, 0x07, 0xde, 0xd3, 0x36, 0x75, 0x16, 0x79, 0x0e, 0xbd, 0x1c, 0x28, 0x53, 0x9e, 0x48, 0x86,
-	0x8f, 0xa1, 0xa6, 0x63, 0x06, 0xd7, 0x19, 0x76, 0x07, 0x39, 0x83, 0x2b, 0x1e, 0x31, 0x6a, 0x42,
-	0xe4, 0x6a, 0x95, 0x64, 0xaa, 0x4b, 0xfc, 0x02, 0xba, 0x73, 0xe3, 0x11, 0xd6, 0x13, 0x78, 0x07,
-	0xd5, 0xa7, 0x9d, 0xe1, 0x5e, 0x91, 0x5d, 0xc2, 0xd3, 0x32, 0x98, 0x50, 0x78, 0xaf, 0x4c, 0x42,
-	0xe2, 0x09, 0xf4, 0x72, 0x8c, 0x75, 0xb9, 0x8a, 0xfb, 0x77, 0x2a, 0xda, 0x30, 0xdd, 0x80, 0x93,
-	0x13, 0x08, 0xbe, 0x8e, 0x93, 0xe8, 0x46, 0x71, 0x11, 0xce, 0x98, 0x26, 0x2f, 0x8b, 0x2b, 0x7e,
-	0x0c, 0x75, 0x7d, 0x0f, 0xe9, 0x6a, 0x6e, 0xdc, 0xd1, 0xc6, 0xc8, 0x1f, 0x1e, 0xec, 0xdf, 0xad,
-	0x60, 0xd5, 0x7c, 0x04, 0xc0, 0x27, 0x3f, 0xb1, 0xa9, 0xba, 0x89, 0x7f, 0xb3, 0x4a, 0x55, 0xe9,
-	0x9a, 0x07, 0x4f, 0xa1, 0x37, 0xe5, 0x89, 0x12, 0xe1, 0x54, 0x8d, 0x58, 0x32, 0x53, 0x6f, 0x82,
-	0x8a, 0x51, 0xf3, 0x83, 0x81, 0xed, 0xdb, 0x20, 0xef, 0xdb, 0xe0, 0xdc, 0xf5, 0x8d, 0x6e, 0x24,
-	0xe0, 0xe7, 0x50, 0xe3, 0xa9, 0x92, 0x41, 0xd5, 0x24, 0xae, 0xae, 0x7d, 0x6d, 0xbf, 0xd7, 0xa9,
-	0xce, 0x92, 0xd4, 0x80, 0xc8, 0x8f, 0xd0, 0xd1, 0xfc, 0x4e, 0xa3, 0x48, 0x30, 0x29, 0xf1, 0x05,
-	0xb4, 0x95, 0x08, 0x13, 0x99, 0x72, 0xa1, 0x0c, 0xbb, 0xde, 0x5a, 0x27, 0x34, 0x70, 0x9c, 0x47,
-	0xe9, 0x0a, 0x88, 0x01, 0x34, 0x43, 0x5b, 0xc0, 0xb0, 0x6d, 0xd3, 0xdc, 0x24, 0xff, 0x78, 0xd0,
-	0x2b, 0x9f, 0x8b, 0xc7, 0x00, 0x8b, 0xf0, 0xd7, 0x51, 0xa8, 0x58, 0x32, 0x5d, 0xba, 0x59, 0x79,
-	0xc7, 0xed, 0xd6, 0xc0, 0x78, 0x04, 0xdd, 0x45, 0x9c, 0x50, 0x96, 0x66, 0xca, 0x04, 0x9d, 0x36,
-	0x7e, 0xb9, 0x0b, 0x2c, 0xa5, 0x65, 0x18, 0x12, 0xd8, 0x5d, 0xc4, 0xc9, 0x4d, 0xca, 0x58, 0xf4,
-	0xcd, 0x24, 0xb5, 0xca, 0x54, 0x69, 0xc9, 0xa7, 0xc7, 0x3c, 0x5c, 0xf0, 0x2c, 0x51, 0x41, 0xcd,
-	0x44, 0x9d, 0x85, 0x5f, 0xc2, 0xae, 0x60, 0x52, 0x89, 0x78, 0x6a, 0xe8, 0x07, 0x75, 0x47, 0xb8,
-	0x7c, 0xe4, 0x0a, 0x40, 0x4b, 0x70, 0xd2, 0x86, 0xa6, 0x23, 0x45, 0xc6, 0xe0, 0x6f, 0x82, 0xf1,
-	0x13, 0xe8, 0xbe, 0x16, 0x8c, 0x9d, 0x85, 0x49, 0xf4, 0x4b, 0x1c, 0xa9, 0x37, 0x6e, 0x22, 0xca,
-	0x4e, 0xec, 0x43, 0x4b, 0x3b, 0xce, 0x63, 0x79, 0x6b, 0xae, 0x5c, 0xa5, 0x85, 0x4d, 0xfe, 0xf4,
-	0xa0, 0xa6, 0xcb, 0x62, 0x0f, 0x2a, 0x71, 0xe4, 0xde, 0x68, 0x25, 0x8e, 0x70, 0x50, 0x6e, 0x4a,
-	0x67, 0xf8, 0xb0, 0xc4, 0xd9, 0x75, 0xbc, 0x68, 0x15, 0x3e, 0x81, 0x9a, 0x5a, 0xa6, 0xcc, 0x88,
-	0xd3, 0x1b, 0x3e, 0x28, 0x77, 0x7d, 0x99, 0x32, 0x6a, 0xc2, 0x77, 0xf4, 0xa8, 0xfd, 0x3f, 0x3d,
-	0x7e, 0xf7, 0x60, 0xf7, 0x55, 0xc6, 0xc4, 0x32, 0x7f, 0x10, 0x4f, 0xa0, 0x21, 0x59, 0x12, 0x31,
-	0x71, 0xff, 0xda, 0x70, 0x41, 0x0d, 0x53, 0xa1, 0x98, 0x31, 0xe5, 0x2e, 0xb3, 0x09, 0xb3, 0x41,
-	0x7c, 0x08, 0xf5, 0x79, 0xbc, 0x88, 0x95, 0x6b, 0xb1, 0x35, 0xb4, 0x7e, 0x69, 0x9c, 0xcc, 0x26,
-	0xe1, 0xf4, 0xd6, 0xf0, 0x6d, 0xd1, 0xc2, 0x26, 0x21, 0x74, 0x1d, 0x1f, 0xf7, 0xc4, 0xff, 0x23,
-	0xa1, 0xcf, 0xa0, 0x55, 0x2c, 0x98, 0xca, 0x7d, 0xcb, 0xa0, 0x08, 0x93, 0xbf, 0x3d, 0xe8, 0xac,
-	0x49, 0x82, 0xc7, 0xd0, 0xe2, 0x29, 0x13, 0xa1, 0xe2, 0xc2, 0xbd, 0xb1, 0x8f, 0x8a, 0xd4, 0x35,
-	0xdc, 0xe0, 0xda, 0x81, 0x68, 0x01, 0xc7, 0x23, 0x68, 0x9a, 0xdf, 0x49, 0x64, 0x74, 0xe8, 0x0d,
-	0x3f, 0xdc, 0x9e, 0x99, 0x44, 0x34, 0x07, 0x6b, 0x5d, 0xde, 0x86, 0xf3, 0x8c, 0xe5, 0xba, 0x18,
-	0x83, 0xbc, 0x80, 0x56, 0x7e, 0x06, 0x36, 0xa0, 0x32, 0x1a, 0xfb, 0x3b, 0xfa, 0x7b, 0xf1, 0xca,
-	0xf7, 0xf4, 0xf7, 0x72, 0xec, 0x57, 0xb0, 0x09, 0xd5, 0xd1, 0xf8, 0xc2, 0xaf, 0xea, 0x1f, 0x97,
-	0xe3, 0x0b, 0xbf, 0x46, 0x0e, 0xa1, 0xe9, 0xea, 0xe3, 0x83, 0x8d, 0xf1, 0xf5, 0x77, 0x70, 0x77,
-	0x35, 0xab, 0xbe, 0x77, 0x18, 0x40, 0xb7, 0xb4, 0x35, 0x74, 0x95, 0xf1, 0x57, 0xdf, 0xf9, 0x3b,
-	0x87, 0x04, 0x5a, 0xf9, 0x64, 0x61, 0x1b, 0xea, 0xa7, 0xe7, 0xdf, 0xbe, 0xbc, 0xf2, 0x77, 0xb0,
-	0x03, 0xcd, 0x9b, 0xf1, 0x35, 0x3d, 0xbd, 0xbc, 0xf0, 0xbd, 0xe1, 0x5f, 0x1e, 0x34, 0xdd, 0xf6,
-	0xc0, 0x63, 0x68, 0xd8, 0xbd, 0x8d, 0x5b, 0xfe, 0x1a, 0xfa, 0xdb, 0x16, 0x3c, 0x9e, 0x00, 0x9c,
-	0x65, 0xf3, 0x5b, 0x97, 0xbe, 0x7f, 0x7f, 0xba, 0xec, 0x07, 0x5b, 0xf2, 0x25, 0x7e, 0x0f, 0xfe,
-	0xe6, 0x3e, 0xc7, 0x83, 0x02, 0xbd, 0x65, 0xd5, 0xf7, 0x1f, 0xbf, 0x03, 0x61, 0x2b, 0x0f, 0x4f,
-	0xa0, 0x6e, 0xab, 0x1d, 0x41, 0xdd, 0x4c, 0x21, 0xbe, 0x5f, 0x24, 0xad, 0xbf, 0x92, 0xfe, 0xde,
-	0xa6, 0xdb, 0x16, 0x38, 0xab, 0xfd, 0x50, 0x49, 0x27, 0x93, 0x86, 0x59, 0x9b, 0xcf, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x34, 0x43, 0x8e, 0x71, 0xf4, 0x07, 0x00, 0x00,
+	// 888 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xff, 0x6e, 0xe3, 0x44,
+	0x10, 0xae, 0xf3, 0xcb, 0xe9, 0x24, 0x31, 0xbe, 0xd1, 0xd1, 0x9a, 0x08, 0x4e, 0x39, 0xc3, 0x49,
+	0xa5, 0x48, 0x39, 0x29, 0x77, 0xaa, 0x54, 0x09, 0x54, 0x52, 0x5a, 0xaa, 0xea, 0xc2, 0xb5, 0xb7,
+	0x8d, 0x84, 0x84, 0x84, 0x90, 0x13, 0xef, 0xe5, 0x4c, 0x12, 0xaf, 0xf1, 0xae, 0x0f, 0xc2, 0x43,
+	0xf0, 0x24, 0x3c, 0x05, 0x0f, 0xc2, 0x73, 0xf0, 0x17, 0x42, 0xde, 0x5d, 0xbb, 0xd9, 0xb4, 0x01,
+	0xee, 0x2f, 0x67, 0x66, 0xbe, 0x99, 0xfd, 0xe6, 0x9b, 0xdd, 0x09, 0x74, 0xd8, 0x5b, 0x9a, 0x2e,
+	0x82, 0x55, 0x3f, 0x49, 0x99, 0x60, 0x68, 0x6b, 0xb3, 0xfb, 0x68, 0xc6, 0xd8, 0x6c, 0x41, 0x9f,
+	0x4a, 0xf7, 0x24, 0x7b, 0xfd, 0x34, 0xcc, 0xd2, 0x40, 0x44, 0x2c, 0x56, 0x40, 0xff, 0x4b, 0xe8,
+	0x8c, 0x18, 0x9b, 0x67, 0x09, 0xa1, 0x3f, 0x65, 0x94, 0x0b, 0xdc, 0x83, 0x46, 0xcc, 0x42, 0x7a,
+	0x79, 0xe6, 0x59, 0x3d, 0xeb, 0x60, 0x97, 0x68, 0x0b, 0xf7, 0xc1, 0x1e, 0x5e, 0x5f, 0xfe, 0x30,
+	0xa7, 0x2b, 0xaf, 0xd2, 0xb3, 0x0e, 0xda, 0xa4, 0x31, 0xbc, 0xbe, 0x7c, 0x41, 0x57, 0xfe, 0x33,
+	0x70, 0x8a, 0x0a, 0x3c, 0x61, 0x31, 0xa7, 0xf8, 0x18, 0x6a, 0x79, 0x92, 0x2c, 0xd0, 0x1a, 0x74,
+	0xfa, 0x05, 0xb5, 0x97, 0x2c, 0xa4, 0x44, 0x86, 0xfc, 0x19, 0x38, 0xc6, 0xb1, 0x1c, 0x3f, 0x87,
+	0xce, 0x42, 0x7a, 0x52, 0xe5, 0xf1, 0xac, 0x5e, 0xf5, 0xa0, 0x35, 0xd8, 0x2b, 0xb3, 0x0d, 0x3c,
+	0x31, 0xc1, 0xdb, 0xd9, 0x11, 0x78, 0xcf, 0x64, 0xc7, 0xf1, 0x04, 0x9c, 0x22, 0x59, 0xb9, 0xf4,
+	0x51, 0xfb, 0x77, 0x8e, 0x52, 0x61, 0xb2, 0x01, 0xf7, 0x4f, 0xc0, 0xfb, 0x3a, 0x8a, 0xc3, 0x1b,
+	0xc1, 0xd2, 0x60, 0x46, 0xf3, 0xae, 0x78, 0xd9, 0xfb, 0xc7, 0x50, 0xcf, 0x1b, 0xe4, 0xba, 0xe6,
+	0x46, 0xf3, 0x2a, 0xe6, 0xff, 0x61, 0xc1, 0xfe, 0xdd, 0x0a, 0xaa, 0x93, 0x47, 0x00, 0x6c, 0xf2,
+	0x23, 0x9d, 0x8a, 0x9b, 0xe8, 0x57, 0x25, 0x61, 0x95, 0xac, 0x79, 0x70, 0x08, 0xce, 0x94, 0xc5,
+	0x22, 0x0d, 0xa6, 0x62, 0x44, 0xe3, 0x99, 0x78, 0x23, 0x1b, 0x6e, 0x0d, 0x3e, 0xe8, 0xab, 0x49,
+	0xf7, 0x8b, 0x49, 0xf7, 0xcf, 0xf4, 0xa4, 0xc9, 0x46, 0x02, 0x7e, 0x06, 0x35, 0x96, 0x08, 0xee,
+	0x55, 0x7b, 0x96, 0xd1, 0xf6, 0x95, 0xfa, 0x5e, 0x25, 0x79, 0x16, 0x27, 0x12, 0xb4, 0xae, 0x6c,
+	0xcd, 0x50, 0xf6, 0x7b, 0x68, 0xe5, 0xc4, 0x87, 0x61, 0x98, 0x52, 0xce, 0xf1, 0x39, 0xec, 0x8a,
+	0x34, 0x88, 0x79, 0xc2, 0x52, 0x21, 0x69, 0x3b, 0x6b, 0xb3, 0xcb, 0x81, 0xe3, 0x22, 0x4a, 0x6e,
+	0x81, 0xe8, 0x81, 0x1d, 0xa8, 0x02, 0xb2, 0x8d, 0x5d, 0x52, 0x98, 0xfe, 0xdf, 0x16, 0x38, 0x26,
+	0x21, 0x3c, 0x06, 0x58, 0x06, 0xbf, 0x8c, 0x02, 0x41, 0xe3, 0xe9, 0xca, 0xb3, 0xfe, 0xab, 0xed,
+	0x35, 0x30, 0x1e, 0x41, 0x67, 0x19, 0xc5, 0x84, 0x26, 0x99, 0x90, 0x41, 0x2d, 0x9a, 0x6b, 0x8e,
+	0x87, 0x26, 0xc4, 0x84, 0xa1, 0x0f, 0xed, 0x65, 0x14, 0xdf, 0x24, 0x94, 0x86, 0x2f, 0x26, 0x89,
+	0x92, 0xac, 0x4a, 0x0c, 0x5f, 0xfe, 0x62, 0x82, 0x25, 0xcb, 0x62, 0x21, 0x05, 0xaa, 0x12, 0x6d,
+	0xe1, 0x17, 0xd0, 0x4e, 0x29, 0x17, 0x69, 0x34, 0x95, 0xf4, 0xbd, 0xba, 0x26, 0x6c, 0x1e, 0x79,
+	0x0b, 0x20, 0x06, 0xdc, 0xdf, 0x05, 0x5b, 0x93, 0xf2, 0xc7, 0xe0, 0x6e, 0x82, 0xf1, 0x13, 0xe8,
+	0xbc, 0x4e, 0x29, 0x3d, 0x0d, 0xe2, 0xf0, 0xe7, 0x28, 0x14, 0x6f, 0xf4, 0x55, 0x31, 0x9d, 0xd8,
+	0x85, 0x66, 0xee, 0x38, 0x8b, 0xf8, 0x5c, 0xb6, 0x5c, 0x25, 0xa5, 0xed, 0xff, 0x6e, 0x41, 0x2d,
+	0x2f, 0x8b, 0x0e, 0x54, 0xa2, 0x50, 0x3f, 0xf7, 0x4a, 0x14, 0x62, 0xdf, 0x1c, 0x4a, 0x6b, 0xf0,
+	0xd0, 0xe0, 0xac, 0x27, 0x5e, 0x8e, 0x0a, 0x9f, 0x40, 0x4d, 0xac, 0x12, 0x2a, 0xc5, 0x71, 0x06,
+	0x0f, 0xcc, 0xa9, 0xaf, 0x12, 0x4a, 0x64, 0xf8, 0x8e, 0x1e, 0xb5, 0x77, 0xd3, 0xe3, 0x37, 0x0b,
+	0xda, 0xaf, 0x32, 0x9a, 0xae, 0x8a, 0x97, 0xf2, 0x04, 0x1a, 0x9c, 0xc6, 0x21, 0x4d, 0xef, 0x5f,
+	0x34, 0x3a, 0x98, 0xc3, 0x44, 0x90, 0xce, 0xa8, 0xf0, 0x2a, 0xf7, 0xc2, 0x54, 0x10, 0x1f, 0x42,
+	0x7d, 0x11, 0x2d, 0x23, 0xa1, 0x47, 0xac, 0x8c, 0x5c, 0xbf, 0x24, 0x8a, 0x67, 0x93, 0x60, 0x3a,
+	0x97, 0x7c, 0x9b, 0xa4, 0xb4, 0xfd, 0x00, 0x3a, 0x9a, 0x8f, 0x7e, 0xfb, 0xff, 0x93, 0xd0, 0xa7,
+	0xd0, 0x2c, 0x37, 0x4f, 0xe5, 0xbe, 0x2d, 0x51, 0x86, 0xfd, 0xbf, 0x2c, 0x68, 0xad, 0x49, 0x82,
+	0xc7, 0xd0, 0x64, 0x09, 0x4d, 0x03, 0xc1, 0x52, 0xfd, 0xc6, 0x3e, 0x2a, 0x53, 0xd7, 0x70, 0xfd,
+	0x2b, 0x0d, 0x22, 0x25, 0x1c, 0x8f, 0xc0, 0x96, 0xbf, 0xe3, 0x50, 0xea, 0xe0, 0x0c, 0x3e, 0xdc,
+	0x9e, 0x19, 0x87, 0xa4, 0x00, 0xe7, 0xba, 0xbc, 0x0d, 0x16, 0x19, 0x2d, 0x74, 0x91, 0x86, 0xff,
+	0x1c, 0x9a, 0xc5, 0x19, 0xd8, 0x80, 0xca, 0x68, 0xec, 0xee, 0xe4, 0xdf, 0xf3, 0x57, 0xae, 0x95,
+	0x7f, 0x2f, 0xc6, 0x6e, 0x05, 0x6d, 0xa8, 0x8e, 0xc6, 0xe7, 0x6e, 0x35, 0xff, 0x71, 0x31, 0x3e,
+	0x77, 0x6b, 0xfe, 0x21, 0xd8, 0xba, 0x3e, 0x3e, 0xd8, 0xb8, 0xbe, 0xee, 0x0e, 0xb6, 0x6f, 0xef,
+	0xaa, 0x6b, 0x1d, 0x7a, 0xd0, 0x31, 0xb6, 0x46, 0x5e, 0x65, 0xfc, 0xd5, 0xb5, 0xbb, 0x73, 0xe8,
+	0x43, 0xb3, 0xb8, 0x59, 0xb8, 0x0b, 0xf5, 0xe1, 0xd9, 0x37, 0x97, 0x2f, 0xdd, 0x1d, 0x6c, 0x81,
+	0x7d, 0x33, 0xbe, 0x22, 0xc3, 0x8b, 0x73, 0xd7, 0x1a, 0xfc, 0x69, 0x81, 0xad, 0xb7, 0x07, 0x1e,
+	0x43, 0x43, 0x2d, 0x74, 0xdc, 0xf2, 0x67, 0xd2, 0xdd, 0xb6, 0xf9, 0xf1, 0x04, 0xe0, 0x34, 0x5b,
+	0xcc, 0x75, 0xfa, 0xfe, 0xfd, 0xe9, 0xbc, 0xeb, 0x6d, 0xc9, 0xe7, 0xf8, 0x2d, 0xb8, 0x9b, 0x8b,
+	0x1e, 0x7b, 0x25, 0x7a, 0xcb, 0x7f, 0x40, 0xf7, 0xf1, 0xbf, 0x20, 0x54, 0xe5, 0xc1, 0x09, 0xd4,
+	0x55, 0xb5, 0x23, 0xa8, 0xcb, 0x5b, 0x88, 0xef, 0x97, 0x49, 0xeb, 0xaf, 0xa4, 0xbb, 0xb7, 0xe9,
+	0x56, 0x05, 0x4e, 0x6b, 0xdf, 0x55, 0x92, 0xc9, 0xa4, 0x21, 0xd7, 0xe6, 0xb3, 0x7f, 0x06, 0x00,
+	0xc7, 0x9f, 0x91, 0xc0, 0x3f, 0x08, 0x00, 0x00,
 }
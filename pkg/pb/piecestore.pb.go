@@ -645,6 +645,90 @@ func (m *PieceDeleteSummary) GetMessage() string {
 	return ""
 }
 
+type PieceBatchDelete struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceBatchDelete) Reset()         { *m = PieceBatchDelete{} }
+func (m *PieceBatchDelete) String() string { return proto.CompactTextString(m) }
+func (*PieceBatchDelete) ProtoMessage()    {}
+func (*PieceBatchDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569d535d76469daf, []int{9}
+}
+func (m *PieceBatchDelete) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceBatchDelete.Unmarshal(m, b)
+}
+func (m *PieceBatchDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceBatchDelete.Marshal(b, m, deterministic)
+}
+func (dst *PieceBatchDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceBatchDelete.Merge(dst, src)
+}
+func (m *PieceBatchDelete) XXX_Size() int {
+	return xxx_messageInfo_PieceBatchDelete.Size(m)
+}
+func (m *PieceBatchDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceBatchDelete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceBatchDelete proto.InternalMessageInfo
+
+func (m *PieceBatchDelete) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type PieceBatchDeleteSummary struct {
+	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	FailedIds            []string `protobuf:"bytes,2,rep,name=failed_ids,json=failedIds,proto3" json:"failed_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceBatchDeleteSummary) Reset()         { *m = PieceBatchDeleteSummary{} }
+func (m *PieceBatchDeleteSummary) String() string { return proto.CompactTextString(m) }
+func (*PieceBatchDeleteSummary) ProtoMessage()    {}
+func (*PieceBatchDeleteSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_569d535d76469daf, []int{10}
+}
+func (m *PieceBatchDeleteSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceBatchDeleteSummary.Unmarshal(m, b)
+}
+func (m *PieceBatchDeleteSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceBatchDeleteSummary.Marshal(b, m, deterministic)
+}
+func (dst *PieceBatchDeleteSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceBatchDeleteSummary.Merge(dst, src)
+}
+func (m *PieceBatchDeleteSummary) XXX_Size() int {
+	return xxx_messageInfo_PieceBatchDeleteSummary.Size(m)
+}
+func (m *PieceBatchDeleteSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceBatchDeleteSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceBatchDeleteSummary proto.InternalMessageInfo
+
+func (m *PieceBatchDeleteSummary) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PieceBatchDeleteSummary) GetFailedIds() []string {
+	if m != nil {
+		return m.FailedIds
+	}
+	return nil
+}
+
 type PieceStoreSummary struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	TotalReceived        int64    `protobuf:"varint,2,opt,name=totalReceived,proto3" json:"totalReceived,omitempty"`
@@ -657,7 +741,7 @@ func (m *PieceStoreSummary) Reset()         { *m = PieceStoreSummary{} }
 func (m *PieceStoreSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceStoreSummary) ProtoMessage()    {}
 func (*PieceStoreSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_569d535d76469daf, []int{9}
+	return fileDescriptor_569d535d76469daf, []int{11}
 }
 func (m *PieceStoreSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStoreSummary.Unmarshal(m, b)
@@ -701,7 +785,7 @@ func (m *StatsReq) Reset()         { *m = StatsReq{} }
 func (m *StatsReq) String() string { return proto.CompactTextString(m) }
 func (*StatsReq) ProtoMessage()    {}
 func (*StatsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_569d535d76469daf, []int{10}
+	return fileDescriptor_569d535d76469daf, []int{12}
 }
 func (m *StatsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsReq.Unmarshal(m, b)
@@ -733,7 +817,7 @@ func (m *StatSummary) Reset()         { *m = StatSummary{} }
 func (m *StatSummary) String() string { return proto.CompactTextString(m) }
 func (*StatSummary) ProtoMessage()    {}
 func (*StatSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_569d535d76469daf, []int{11}
+	return fileDescriptor_569d535d76469daf, []int{13}
 }
 func (m *StatSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummary.Unmarshal(m, b)
@@ -781,6 +865,8 @@ func init() {
 	proto.RegisterType((*PieceRetrievalStream)(nil), "piecestoreroutes.PieceRetrievalStream")
 	proto.RegisterType((*PieceDelete)(nil), "piecestoreroutes.PieceDelete")
 	proto.RegisterType((*PieceDeleteSummary)(nil), "piecestoreroutes.PieceDeleteSummary")
+	proto.RegisterType((*PieceBatchDelete)(nil), "piecestoreroutes.PieceBatchDelete")
+	proto.RegisterType((*PieceBatchDeleteSummary)(nil), "piecestoreroutes.PieceBatchDeleteSummary")
 	proto.RegisterType((*PieceStoreSummary)(nil), "piecestoreroutes.PieceStoreSummary")
 	proto.RegisterType((*StatsReq)(nil), "piecestoreroutes.StatsReq")
 	proto.RegisterType((*StatSummary)(nil), "piecestoreroutes.StatSummary")
@@ -802,6 +888,7 @@ type PieceStoreRoutesClient interface {
 	Retrieve(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_RetrieveClient, error)
 	Store(ctx context.Context, opts ...grpc.CallOption) (PieceStoreRoutes_StoreClient, error)
 	Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error)
+	BatchDelete(ctx context.Context, in *PieceBatchDelete, opts ...grpc.CallOption) (*PieceBatchDeleteSummary, error)
 	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error)
 }
 
@@ -896,6 +983,15 @@ func (c *pieceStoreRoutesClient) Delete(ctx context.Context, in *PieceDelete, op
 	return out, nil
 }
 
+func (c *pieceStoreRoutesClient) BatchDelete(ctx context.Context, in *PieceBatchDelete, opts ...grpc.CallOption) (*PieceBatchDeleteSummary, error) {
+	out := new(PieceBatchDeleteSummary)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pieceStoreRoutesClient) Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error) {
 	out := new(StatSummary)
 	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Stats", in, out, opts...)
@@ -911,6 +1007,7 @@ type PieceStoreRoutesServer interface {
 	Retrieve(PieceStoreRoutes_RetrieveServer) error
 	Store(PieceStoreRoutes_StoreServer) error
 	Delete(context.Context, *PieceDelete) (*PieceDeleteSummary, error)
+	BatchDelete(context.Context, *PieceBatchDelete) (*PieceBatchDeleteSummary, error)
 	Stats(context.Context, *StatsReq) (*StatSummary, error)
 }
 
@@ -1006,6 +1103,24 @@ func _PieceStoreRoutes_Delete_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PieceStoreRoutes_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PieceBatchDelete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).BatchDelete(ctx, req.(*PieceBatchDelete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PieceStoreRoutes_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StatsReq)
 	if err := dec(in); err != nil {
@@ -1036,6 +1151,10 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PieceStoreRoutes_Delete_Handler,
 		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _PieceStoreRoutes_BatchDelete_Handler,
+		},
 		{
 			MethodName: "Stats",
 			Handler:    _PieceStoreRoutes_Stats_Handler,
@@ -1060,48 +1179,52 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_569d535d76469daf) }
 
 var fileDescriptor_569d535d76469daf = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xdb, 0x4e, 0xdb, 0x4c,
-	0x10, 0xc6, 0xce, 0x89, 0x4c, 0x02, 0x3f, 0x2c, 0x08, 0x39, 0x16, 0xfc, 0x8a, 0x0c, 0x42, 0x11,
-	0xbf, 0x14, 0xfd, 0xa2, 0x4f, 0x50, 0x14, 0xa9, 0xe5, 0x86, 0xa2, 0xb5, 0xb8, 0x41, 0xaa, 0xa2,
-	0x8d, 0x3d, 0xd0, 0x95, 0x7c, 0x48, 0xbd, 0x1b, 0x1a, 0xb8, 0xec, 0x53, 0x54, 0xea, 0x6d, 0x9f,
-	0xa4, 0xaf, 0xd4, 0x17, 0xa8, 0xbc, 0xeb, 0x43, 0x20, 0x71, 0xb8, 0x69, 0xef, 0x76, 0x4e, 0xdf,
-	0x7c, 0x33, 0xdf, 0xc4, 0x81, 0x9d, 0x29, 0x47, 0x0f, 0x85, 0x8c, 0x13, 0x1c, 0x4e, 0x93, 0x58,
-	0xc6, 0x64, 0xc1, 0x93, 0xc4, 0x33, 0x89, 0xc2, 0xf9, 0x65, 0x80, 0x75, 0xcd, 0x1e, 0x31, 0xb9,
-	0x60, 0x91, 0xff, 0x85, 0xfb, 0xf2, 0xd3, 0xdb, 0x20, 0x88, 0x3d, 0x26, 0x79, 0x1c, 0x91, 0x43,
-	0x68, 0x0b, 0x7e, 0x1f, 0x31, 0x39, 0x4b, 0xd0, 0x32, 0xfa, 0xc6, 0xa0, 0x4b, 0x4b, 0x07, 0x21,
-	0x50, 0xf7, 0x99, 0x64, 0x96, 0xa9, 0x02, 0xea, 0x6d, 0xff, 0x30, 0xa0, 0x3e, 0x62, 0x92, 0x91,
-	0x7d, 0x68, 0x4c, 0x53, 0xd8, 0xac, 0x4c, 0x1b, 0xe4, 0x00, 0x9a, 0x09, 0x46, 0x12, 0x93, 0xac,
-	0x28, 0xb3, 0x48, 0x0f, 0x36, 0x43, 0x36, 0x1f, 0x0b, 0xfe, 0x84, 0x56, 0xad, 0x6f, 0x0c, 0x6a,
-	0xb4, 0x15, 0xb2, 0xb9, 0xcb, 0x9f, 0x90, 0x0c, 0x61, 0x0f, 0xe7, 0x53, 0x9e, 0x28, 0x46, 0xe3,
-	0x59, 0xc4, 0xe7, 0x63, 0x81, 0x9e, 0x55, 0x57, 0x59, 0xbb, 0x65, 0xe8, 0x26, 0xe2, 0x73, 0x17,
-	0x3d, 0x72, 0x0c, 0x5b, 0x02, 0x13, 0xce, 0x82, 0x71, 0x34, 0x0b, 0x27, 0x98, 0x58, 0x8d, 0xbe,
-	0x31, 0x68, 0xd3, 0xae, 0x76, 0x5e, 0x29, 0x9f, 0xf3, 0xd3, 0x80, 0x1e, 0x55, 0xad, 0xff, 0xcc,
-	0xd8, 0x22, 0x9b, 0xfa, 0x06, 0x76, 0xd4, 0xa0, 0x63, 0x56, 0xa0, 0x29, 0x80, 0xce, 0xf9, 0xd9,
-	0xf0, 0xe5, 0xea, 0x87, 0x55, 0x6b, 0xa7, 0xff, 0x28, 0x8c, 0x05, 0x42, 0xfb, 0xd0, 0x90, 0xb1,
-	0x64, 0x81, 0xea, 0x59, 0xa3, 0xda, 0x70, 0xbe, 0x99, 0x00, 0xd7, 0x29, 0xa8, 0x9b, 0x82, 0x92,
-	0x8f, 0xb0, 0x37, 0xc9, 0xc1, 0x96, 0xda, 0xff, 0xb7, 0xdc, 0xbe, 0x72, 0x7e, 0xba, 0x0a, 0x87,
-	0x8c, 0xa0, 0xad, 0x20, 0x8a, 0xd9, 0x3b, 0xe7, 0xa7, 0x2b, 0x66, 0x2a, 0xf8, 0xe8, 0x67, 0xba,
-	0x15, 0x5a, 0x16, 0xda, 0x08, 0xed, 0xc2, 0x4f, 0xb6, 0xc1, 0xe4, 0xbe, 0x22, 0xd8, 0xa6, 0x26,
-	0xf7, 0xab, 0xa4, 0x36, 0xab, 0xa4, 0xb6, 0xa0, 0xe5, 0xc5, 0x91, 0xc4, 0x48, 0xaa, 0xa3, 0xe9,
-	0xd2, 0xdc, 0x74, 0x7a, 0xd0, 0x52, 0x6d, 0x2e, 0xfd, 0x97, 0x4d, 0x9c, 0x09, 0x74, 0x35, 0xc9,
-	0x59, 0x18, 0xb2, 0xe4, 0x71, 0x89, 0x04, 0x81, 0xba, 0x3a, 0x43, 0xdd, 0x55, 0xbd, 0xab, 0x88,
-	0xd5, 0x2a, 0x88, 0x39, 0x5f, 0x4d, 0xd8, 0x56, 0x4d, 0x28, 0xca, 0x84, 0xe3, 0x03, 0x0b, 0xfe,
-	0xb6, 0x3a, 0xef, 0x33, 0x75, 0x46, 0xa5, 0x3a, 0x67, 0x15, 0xea, 0x14, 0x9c, 0x96, 0x14, 0x4a,
-	0x9f, 0xf6, 0xbb, 0x75, 0x0a, 0xad, 0x5a, 0xce, 0x01, 0x34, 0xe3, 0xbb, 0x3b, 0x81, 0x32, 0xdb,
-	0x47, 0x66, 0x39, 0x23, 0xd8, 0x7f, 0xde, 0xcf, 0x95, 0x09, 0xb2, 0xb0, 0xc0, 0x30, 0x16, 0x30,
-	0x16, 0x94, 0x34, 0x9f, 0x2b, 0x79, 0x04, 0x1d, 0x4d, 0x07, 0x03, 0x94, 0xb8, 0xa4, 0xe6, 0x10,
-	0xc8, 0x42, 0x38, 0xd7, 0xd4, 0x82, 0x56, 0x88, 0x42, 0xb0, 0x7b, 0xcc, 0x52, 0x73, 0xd3, 0x71,
-	0x61, 0xb7, 0x3c, 0xd1, 0x57, 0xd3, 0xc9, 0x09, 0x6c, 0xa9, 0xdf, 0x1a, 0x45, 0x0f, 0xf9, 0x03,
-	0xfa, 0xd9, 0xe0, 0xcf, 0x9d, 0x0e, 0xc0, 0xa6, 0x2b, 0x99, 0x14, 0x14, 0x3f, 0x3b, 0x2e, 0x74,
-	0xd2, 0x77, 0x0e, 0x7d, 0x08, 0xed, 0x99, 0x40, 0xdf, 0x9d, 0x32, 0x2f, 0x9f, 0xb8, 0x74, 0x90,
-	0x53, 0xd8, 0x66, 0x0f, 0x8c, 0x07, 0x6c, 0x12, 0xa0, 0x4e, 0xd1, 0xf8, 0x2f, 0xbc, 0xe7, 0xdf,
-	0x6b, 0xb0, 0x53, 0xd2, 0xa6, 0x4a, 0x4c, 0x32, 0x82, 0x86, 0xf2, 0x91, 0x5e, 0x85, 0xd0, 0x97,
-	0xbe, 0xfd, 0x6f, 0xd5, 0x2f, 0x54, 0xd3, 0x73, 0x36, 0xc8, 0x2d, 0x6c, 0x66, 0x02, 0x21, 0xe9,
-	0xbf, 0x76, 0x31, 0xf6, 0xe9, 0x6b, 0x19, 0x5a, 0x63, 0x67, 0x63, 0x60, 0xfc, 0x6f, 0x90, 0x2b,
-	0x68, 0xe8, 0x4f, 0xd3, 0xe1, 0xba, 0x0f, 0x85, 0x7d, 0xbc, 0x2e, 0x5a, 0x30, 0x1d, 0x18, 0xe4,
-	0x03, 0x34, 0xb3, 0x33, 0x38, 0xaa, 0x28, 0xd1, 0x61, 0xfb, 0x64, 0x6d, 0xb8, 0x1c, 0x7e, 0x94,
-	0x12, 0x64, 0x52, 0x10, 0x7b, 0xb9, 0x20, 0x57, 0xd4, 0x3e, 0x5a, 0x1d, 0x2b, 0x50, 0x2e, 0xea,
-	0xb7, 0xe6, 0x74, 0x32, 0x69, 0xaa, 0x7f, 0xd8, 0x37, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xa4,
-	0x5e, 0x53, 0x96, 0x75, 0x07, 0x00, 0x00,
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0xce, 0x1f, 0x3e, 0x09, 0xdc, 0x30, 0x20, 0xae, 0x63, 0x91, 0xab, 0xc8, 0x20, 0x94,
+	0x4b, 0xa5, 0xa8, 0xa2, 0x4f, 0x50, 0x14, 0xa9, 0x65, 0x43, 0xd1, 0x58, 0x6c, 0x90, 0xda, 0x68,
+	0x62, 0x1f, 0x60, 0x24, 0xc7, 0x4e, 0x3d, 0x13, 0x1a, 0x58, 0xf6, 0x29, 0xfa, 0x00, 0x7d, 0x92,
+	0xbe, 0x52, 0x97, 0xdd, 0x54, 0x1e, 0x3b, 0x76, 0x48, 0xe2, 0x64, 0xd3, 0xee, 0xe6, 0xfc, 0x7d,
+	0xe7, 0x3b, 0xe7, 0x3b, 0xb1, 0x02, 0xcd, 0x31, 0x47, 0x17, 0x85, 0x0c, 0x23, 0xec, 0x8d, 0xa3,
+	0x50, 0x86, 0x64, 0xce, 0x13, 0x85, 0x13, 0x89, 0xc2, 0xfe, 0xa9, 0x81, 0x79, 0xcd, 0x9e, 0x30,
+	0xba, 0x60, 0x81, 0xf7, 0x85, 0x7b, 0xf2, 0xe1, 0xad, 0xef, 0x87, 0x2e, 0x93, 0x3c, 0x0c, 0xc8,
+	0x11, 0x18, 0x82, 0xdf, 0x07, 0x4c, 0x4e, 0x22, 0x34, 0xb5, 0x8e, 0xd6, 0x6d, 0xd0, 0xdc, 0x41,
+	0x08, 0x94, 0x3d, 0x26, 0x99, 0xa9, 0xab, 0x80, 0x7a, 0x5b, 0xdf, 0x35, 0x28, 0xf7, 0x99, 0x64,
+	0xe4, 0x00, 0x2a, 0xe3, 0x18, 0x36, 0x2d, 0x4b, 0x0c, 0x72, 0x08, 0xd5, 0x08, 0x03, 0x89, 0x51,
+	0x5a, 0x94, 0x5a, 0xa4, 0x05, 0xdb, 0x23, 0x36, 0x1d, 0x08, 0xfe, 0x8c, 0x66, 0xa9, 0xa3, 0x75,
+	0x4b, 0xb4, 0x36, 0x62, 0x53, 0x87, 0x3f, 0x23, 0xe9, 0xc1, 0x3e, 0x4e, 0xc7, 0x3c, 0x52, 0x8c,
+	0x06, 0x93, 0x80, 0x4f, 0x07, 0x02, 0x5d, 0xb3, 0xac, 0xb2, 0xf6, 0xf2, 0xd0, 0x4d, 0xc0, 0xa7,
+	0x0e, 0xba, 0xe4, 0x18, 0x76, 0x04, 0x46, 0x9c, 0xf9, 0x83, 0x60, 0x32, 0x1a, 0x62, 0x64, 0x56,
+	0x3a, 0x5a, 0xd7, 0xa0, 0x8d, 0xc4, 0x79, 0xa5, 0x7c, 0xf6, 0x0f, 0x0d, 0x5a, 0x54, 0xb5, 0xfe,
+	0x33, 0x63, 0x8b, 0x74, 0xea, 0x1b, 0x68, 0xaa, 0x41, 0x07, 0x2c, 0x43, 0x53, 0x00, 0xf5, 0xf3,
+	0xb3, 0xde, 0xe2, 0xea, 0x7b, 0x45, 0x6b, 0xa7, 0xff, 0x28, 0x8c, 0x39, 0x42, 0x07, 0x50, 0x91,
+	0xa1, 0x64, 0xbe, 0xea, 0x59, 0xa2, 0x89, 0x61, 0x7f, 0xd3, 0x01, 0xae, 0x63, 0x50, 0x27, 0x06,
+	0x25, 0x1f, 0x61, 0x7f, 0x38, 0x03, 0x5b, 0x6a, 0xff, 0x6a, 0xb9, 0x7d, 0xe1, 0xfc, 0x74, 0x15,
+	0x0e, 0xe9, 0x83, 0xa1, 0x20, 0xb2, 0xd9, 0xeb, 0xe7, 0xa7, 0x2b, 0x66, 0xca, 0xf8, 0x24, 0xcf,
+	0x78, 0x2b, 0x34, 0x2f, 0xb4, 0x10, 0x8c, 0xcc, 0x4f, 0x76, 0x41, 0xe7, 0x9e, 0x22, 0x68, 0x50,
+	0x9d, 0x7b, 0x45, 0x52, 0xeb, 0x45, 0x52, 0x9b, 0x50, 0x73, 0xc3, 0x40, 0x62, 0x20, 0xd5, 0xd1,
+	0x34, 0xe8, 0xcc, 0xb4, 0x5b, 0x50, 0x53, 0x6d, 0x2e, 0xbd, 0xc5, 0x26, 0xf6, 0x10, 0x1a, 0x09,
+	0xc9, 0xc9, 0x68, 0xc4, 0xa2, 0xa7, 0x25, 0x12, 0x04, 0xca, 0xea, 0x0c, 0x93, 0xae, 0xea, 0x5d,
+	0x44, 0xac, 0x54, 0x40, 0xcc, 0xfe, 0xaa, 0xc3, 0xae, 0x6a, 0x42, 0x51, 0x46, 0x1c, 0x1f, 0x99,
+	0xff, 0xb7, 0xd5, 0x79, 0x9f, 0xaa, 0xd3, 0xcf, 0xd5, 0x39, 0x2b, 0x50, 0x27, 0xe3, 0xb4, 0xa4,
+	0x50, 0xfc, 0xb4, 0xde, 0xad, 0x53, 0x68, 0xd5, 0x72, 0x0e, 0xa1, 0x1a, 0xde, 0xdd, 0x09, 0x94,
+	0xe9, 0x3e, 0x52, 0xcb, 0xee, 0xc3, 0xc1, 0xcb, 0x7e, 0x8e, 0x8c, 0x90, 0x8d, 0x32, 0x0c, 0x6d,
+	0x0e, 0x63, 0x4e, 0x49, 0xfd, 0xa5, 0x92, 0x6d, 0xa8, 0x27, 0x74, 0xd0, 0x47, 0x89, 0x4b, 0x6a,
+	0xf6, 0x80, 0xcc, 0x85, 0x67, 0x9a, 0x9a, 0x50, 0x1b, 0xa1, 0x10, 0xec, 0x1e, 0xd3, 0xd4, 0x99,
+	0x69, 0x9f, 0x40, 0x53, 0xe5, 0x5f, 0x30, 0xe9, 0x3e, 0xa4, 0x98, 0x4d, 0x28, 0x71, 0x4f, 0x98,
+	0x5a, 0xa7, 0xd4, 0x35, 0x68, 0xfc, 0xb4, 0x29, 0xfc, 0xbb, 0x98, 0xb5, 0x11, 0x9a, 0xb4, 0x01,
+	0xee, 0x18, 0xf7, 0xd1, 0x1b, 0xc4, 0x68, 0xba, 0x42, 0x33, 0x12, 0xcf, 0xa5, 0x27, 0x6c, 0x07,
+	0xf6, 0xf2, 0x1f, 0xc7, 0x66, 0xb4, 0x13, 0xd8, 0x51, 0xbf, 0x72, 0x8a, 0x2e, 0xf2, 0x47, 0xf4,
+	0xd2, 0x95, 0xbf, 0x74, 0xda, 0x00, 0xdb, 0x8e, 0x64, 0x52, 0x50, 0xfc, 0x6c, 0x3b, 0x50, 0x8f,
+	0xdf, 0x33, 0xe8, 0x23, 0x30, 0x26, 0x02, 0x3d, 0x67, 0xcc, 0xdc, 0xd9, 0xae, 0x73, 0x07, 0x39,
+	0x85, 0x5d, 0xf6, 0xc8, 0xb8, 0xcf, 0x86, 0x3e, 0x26, 0x29, 0x09, 0xfe, 0x82, 0xf7, 0xfc, 0x57,
+	0x09, 0x9a, 0x39, 0x6d, 0xaa, 0xce, 0x88, 0xf4, 0xa1, 0xa2, 0x7c, 0xa4, 0x55, 0x70, 0x62, 0x97,
+	0x9e, 0xf5, 0x5f, 0x41, 0x28, 0xa5, 0x67, 0x6f, 0x91, 0x5b, 0xd8, 0x4e, 0x4f, 0x03, 0x49, 0x67,
+	0xd3, 0xad, 0x5a, 0xa7, 0x9b, 0x32, 0x92, 0xeb, 0xb2, 0xb7, 0xba, 0xda, 0x6b, 0x8d, 0x5c, 0x41,
+	0x25, 0xf9, 0x28, 0x1e, 0xad, 0xfb, 0x44, 0x59, 0xc7, 0xeb, 0xa2, 0x19, 0xd3, 0xae, 0x46, 0x3e,
+	0x40, 0x35, 0x3d, 0x96, 0x76, 0x41, 0x49, 0x12, 0xb6, 0x4e, 0xd6, 0x86, 0xf3, 0xe1, 0x3f, 0x41,
+	0x7d, 0xfe, 0x04, 0xed, 0x82, 0xb2, 0xb9, 0x1c, 0xeb, 0xff, 0xcd, 0x39, 0x39, 0x7e, 0x3f, 0x5e,
+	0x00, 0x93, 0x82, 0x58, 0xcb, 0x55, 0xb3, 0x8b, 0xb1, 0xda, 0xab, 0x63, 0x19, 0xca, 0x45, 0xf9,
+	0x56, 0x1f, 0x0f, 0x87, 0x55, 0xf5, 0xdf, 0xe1, 0xcd, 0xef, 0x01, 0x00, 0x53, 0x3f, 0x16, 0x30,
+	0x4f, 0x08, 0x00, 0x00,
 }
@@ -39,6 +39,24 @@ func (m *MockPieceStoreRoutesClient) EXPECT() *MockPieceStoreRoutesClientMockRec
 	return m.recorder
 }
 
+// BatchDelete mocks base method
+func (m *MockPieceStoreRoutesClient) BatchDelete(arg0 context.Context, arg1 *PieceBatchDelete, arg2 ...grpc.CallOption) (*PieceBatchDeleteSummary, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchDelete", varargs...)
+	ret0, _ := ret[0].(*PieceBatchDeleteSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDelete indicates an expected call of BatchDelete
+func (mr *MockPieceStoreRoutesClientMockRecorder) BatchDelete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockPieceStoreRoutesClient)(nil).BatchDelete), varargs...)
+}
+
 // Delete mocks base method
 func (m *MockPieceStoreRoutesClient) Delete(arg0 context.Context, arg1 *PieceDelete, arg2 ...grpc.CallOption) (*PieceDeleteSummary, error) {
 	varargs := []interface{}{arg0, arg1}
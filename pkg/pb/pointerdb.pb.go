@@ -33,7 +33,6 @@ const (
 var RedundancyScheme_SchemeType_name = map[int32]string{
 	0: "RS",
 }
-
 var RedundancyScheme_SchemeType_value = map[string]int32{
 	"RS": 0,
 }
@@ -41,7 +40,6 @@ var RedundancyScheme_SchemeType_value = map[string]int32{
 func (x RedundancyScheme_SchemeType) String() string {
 	return proto.EnumName(RedundancyScheme_SchemeType_name, int32(x))
 }
-
 func (RedundancyScheme_SchemeType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_75fef806d28fc810, []int{0, 0}
 }
@@ -57,7 +55,6 @@ var EncryptionScheme_EncryptionType_name = map[int32]string{
 	0: "AESGCM",
 	1: "SECRETBOX",
 }
-
 var EncryptionScheme_EncryptionType_value = map[string]int32{
 	"AESGCM":    0,
 	"SECRETBOX": 1,
@@ -66,7 +63,6 @@ var EncryptionScheme_EncryptionType_value = map[string]int32{
 func (x EncryptionScheme_EncryptionType) String() string {
 	return proto.EnumName(EncryptionScheme_EncryptionType_name, int32(x))
 }
-
 func (EncryptionScheme_EncryptionType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_75fef806d28fc810, []int{1, 0}
 }
@@ -82,7 +78,6 @@ var Pointer_DataType_name = map[int32]string{
 	0: "INLINE",
 	1: "REMOTE",
 }
-
 var Pointer_DataType_value = map[string]int32{
 	"INLINE": 0,
 	"REMOTE": 1,
@@ -91,7 +86,6 @@ var Pointer_DataType_value = map[string]int32{
 func (x Pointer_DataType) String() string {
 	return proto.EnumName(Pointer_DataType_name, int32(x))
 }
-
 func (Pointer_DataType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_75fef806d28fc810, []int{4, 0}
 }
@@ -338,16 +332,19 @@ func (m *RemoteSegment) GetMerkleRoot() []byte {
 }
 
 type Pointer struct {
-	Type                 Pointer_DataType     `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
-	InlineSegment        []byte               `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
-	Remote               *RemoteSegment       `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
-	Size                 int64                `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
-	CreationDate         *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
-	ExpirationDate       *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
-	Metadata             []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Type           Pointer_DataType     `protobuf:"varint,1,opt,name=type,proto3,enum=pointerdb.Pointer_DataType" json:"type,omitempty"`
+	InlineSegment  []byte               `protobuf:"bytes,3,opt,name=inline_segment,json=inlineSegment,proto3" json:"inline_segment,omitempty"`
+	Remote         *RemoteSegment       `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
+	Size           int64                `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	CreationDate   *timestamp.Timestamp `protobuf:"bytes,6,opt,name=creation_date,json=creationDate,proto3" json:"creation_date,omitempty"`
+	ExpirationDate *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	Metadata       []byte               `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// version is 1 for a new pointer and incremented every time the pointer
+	// at the same path is overwritten
+	Version              int64    `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Pointer) Reset()         { *m = Pointer{} }
@@ -423,11 +420,26 @@ func (m *Pointer) GetMetadata() []byte {
 	return nil
 }
 
+func (m *Pointer) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // PutRequest is a request message for the Put rpc call
 type PutRequest struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Pointer              *Pointer `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
-	APIKey               []byte   `protobuf:"bytes,3,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	Path    string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Pointer *Pointer `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	APIKey  []byte   `protobuf:"bytes,3,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if not zero, the pointer is stored only if the current pointer at the
+	// path has this version
+	ExpectedVersion int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3" json:"expected_version,omitempty"`
+	// if true, the pointer is stored only if there is no pointer at the path
+	IfNotExists bool `protobuf:"varint,5,opt,name=if_not_exists,json=ifNotExists,proto3" json:"if_not_exists,omitempty"`
+	// if set, later segments with the same content ID and API key can
+	// reference the pieces of this one
+	ContentId            string   `protobuf:"bytes,6,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -478,10 +490,34 @@ func (m *PutRequest) GetAPIKey() []byte {
 	return nil
 }
 
+func (m *PutRequest) GetExpectedVersion() int64 {
+	if m != nil {
+		return m.ExpectedVersion
+	}
+	return 0
+}
+
+func (m *PutRequest) GetIfNotExists() bool {
+	if m != nil {
+		return m.IfNotExists
+	}
+	return false
+}
+
+func (m *PutRequest) GetContentId() string {
+	if m != nil {
+		return m.ContentId
+	}
+	return ""
+}
+
 // GetRequest is a request message for the Get rpc call
 type GetRequest struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	APIKey               []byte   `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	APIKey []byte `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if true, the pointer is returned without the locations of its pieces
+	// and without its inline data, so it does not count as a download
+	MetadataOnly         bool     `protobuf:"varint,3,opt,name=metadata_only,json=metadataOnly,proto3" json:"metadata_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -525,6 +561,13 @@ func (m *GetRequest) GetAPIKey() []byte {
 	return nil
 }
 
+func (m *GetRequest) GetMetadataOnly() bool {
+	if m != nil {
+		return m.MetadataOnly
+	}
+	return false
+}
+
 // ListRequest is a request message for the List rpc call
 type ListRequest struct {
 	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -839,6 +882,7 @@ func (m *DeleteRequest) GetAPIKey() []byte {
 
 // DeleteResponse is a response message for the Delete rpc call
 type DeleteResponse struct {
+	PiecesInUse          bool     `protobuf:"varint,1,opt,name=pieces_in_use,json=piecesInUse,proto3" json:"pieces_in_use,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -868,178 +912,1572 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
-	proto.RegisterType((*EncryptionScheme)(nil), "pointerdb.EncryptionScheme")
-	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
-	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
-	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
-	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
-	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
-	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
-	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
-	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
-	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
-	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
-	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
-	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
-	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
-	proto.RegisterEnum("pointerdb.EncryptionScheme_EncryptionType", EncryptionScheme_EncryptionType_name, EncryptionScheme_EncryptionType_value)
-	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+func (m *DeleteResponse) GetPiecesInUse() bool {
+	if m != nil {
+		return m.PiecesInUse
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// PointerDBClient is the client API for PointerDB service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type PointerDBClient interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+// CopyRequest is a request message for the Copy rpc call
+type CopyRequest struct {
+	SourcePath           string   `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	DestinationPath      string   `protobuf:"bytes,2,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	APIKey               []byte   `protobuf:"bytes,4,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type pointerDBClient struct {
-	cc *grpc.ClientConn
+func (m *CopyRequest) Reset()         { *m = CopyRequest{} }
+func (m *CopyRequest) String() string { return proto.CompactTextString(m) }
+func (*CopyRequest) ProtoMessage()    {}
+func (*CopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{13}
 }
-
-func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
-	return &pointerDBClient{cc}
+func (m *CopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyRequest.Unmarshal(m, b)
+}
+func (m *CopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyRequest.Merge(dst, src)
+}
+func (m *CopyRequest) XXX_Size() int {
+	return xxx_messageInfo_CopyRequest.Size(m)
+}
+func (m *CopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyRequest.DiscardUnknown(m)
 }
 
-func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
-	out := new(PutResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CopyRequest proto.InternalMessageInfo
+
+func (m *CopyRequest) GetSourcePath() string {
+	if m != nil {
+		return m.SourcePath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
-	out := new(GetResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CopyRequest) GetDestinationPath() string {
+	if m != nil {
+		return m.DestinationPath
 	}
-	return out, nil
+	return ""
 }
 
-func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
-	out := new(ListResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CopyRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
 	}
-	return out, nil
+	return nil
 }
 
-func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
-	out := new(DeleteResponse)
-	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CopyRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
 	}
-	return out, nil
+	return nil
 }
 
-// PointerDBServer is the server API for PointerDB service.
-type PointerDBServer interface {
-	// Put formats and hands off a file path to be saved to boltdb
-	Put(context.Context, *PutRequest) (*PutResponse, error)
-	// Get formats and hands off a file path to get a small value from boltdb
-	Get(context.Context, *GetRequest) (*GetResponse, error)
-	// List calls the bolt client's List function and returns all file paths
-	List(context.Context, *ListRequest) (*ListResponse, error)
-	// Delete formats and hands off a file path to delete from boltdb
-	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+// CopyResponse is a response message for the Copy rpc call
+type CopyResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
-	s.RegisterService(&_PointerDB_serviceDesc, srv)
+func (m *CopyResponse) Reset()         { *m = CopyResponse{} }
+func (m *CopyResponse) String() string { return proto.CompactTextString(m) }
+func (*CopyResponse) ProtoMessage()    {}
+func (*CopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{14}
+}
+func (m *CopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyResponse.Unmarshal(m, b)
+}
+func (m *CopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyResponse.Marshal(b, m, deterministic)
+}
+func (dst *CopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyResponse.Merge(dst, src)
+}
+func (m *CopyResponse) XXX_Size() int {
+	return xxx_messageInfo_CopyResponse.Size(m)
+}
+func (m *CopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyResponse.DiscardUnknown(m)
 }
 
-func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Put(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Put",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Put(ctx, req.(*PutRequest))
+var xxx_messageInfo_CopyResponse proto.InternalMessageInfo
+
+func (m *CopyResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _PointerDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Get(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Get",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Get(ctx, req.(*GetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// MoveRequest is a request message for the Move rpc call
+type MoveRequest struct {
+	SourcePath           string   `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	DestinationPath      string   `protobuf:"bytes,2,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	APIKey               []byte   `protobuf:"bytes,4,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func _PointerDB_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).List(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/List",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).List(ctx, req.(*ListRequest))
+func (m *MoveRequest) Reset()         { *m = MoveRequest{} }
+func (m *MoveRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveRequest) ProtoMessage()    {}
+func (*MoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{15}
+}
+func (m *MoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveRequest.Unmarshal(m, b)
+}
+func (m *MoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveRequest.Marshal(b, m, deterministic)
+}
+func (dst *MoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveRequest.Merge(dst, src)
+}
+func (m *MoveRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveRequest.Size(m)
+}
+func (m *MoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveRequest proto.InternalMessageInfo
+
+func (m *MoveRequest) GetSourcePath() string {
+	if m != nil {
+		return m.SourcePath
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MoveRequest) GetDestinationPath() string {
+	if m != nil {
+		return m.DestinationPath
 	}
-	if interceptor == nil {
-		return srv.(PointerDBServer).Delete(ctx, in)
+	return ""
+}
+
+func (m *MoveRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pointerdb.PointerDB/Delete",
+	return nil
+}
+
+func (m *MoveRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(PointerDBServer).Delete(ctx, req.(*DeleteRequest))
+	return nil
+}
+
+// MoveResponse is a response message for the Move rpc call
+type MoveResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveResponse) Reset()         { *m = MoveResponse{} }
+func (m *MoveResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveResponse) ProtoMessage()    {}
+func (*MoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{16}
+}
+func (m *MoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveResponse.Unmarshal(m, b)
+}
+func (m *MoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveResponse.Marshal(b, m, deterministic)
+}
+func (dst *MoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveResponse.Merge(dst, src)
+}
+func (m *MoveResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveResponse.Size(m)
+}
+func (m *MoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveResponse proto.InternalMessageInfo
+
+func (m *MoveResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
+}
+
+// ReferenceRequest is a request message for the Reference rpc call
+type ReferenceRequest struct {
+	ContentId            string               `protobuf:"bytes,1,opt,name=content_id,json=contentId,proto3" json:"content_id,omitempty"`
+	Path                 string               `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Metadata             []byte               `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	ExpirationDate       *timestamp.Timestamp `protobuf:"bytes,4,opt,name=expiration_date,json=expirationDate,proto3" json:"expiration_date,omitempty"`
+	APIKey               []byte               `protobuf:"bytes,5,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReferenceRequest) Reset()         { *m = ReferenceRequest{} }
+func (m *ReferenceRequest) String() string { return proto.CompactTextString(m) }
+func (*ReferenceRequest) ProtoMessage()    {}
+func (*ReferenceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{17}
+}
+func (m *ReferenceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferenceRequest.Unmarshal(m, b)
+}
+func (m *ReferenceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferenceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReferenceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceRequest.Merge(dst, src)
+}
+func (m *ReferenceRequest) XXX_Size() int {
+	return xxx_messageInfo_ReferenceRequest.Size(m)
+}
+func (m *ReferenceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceRequest proto.InternalMessageInfo
+
+func (m *ReferenceRequest) GetContentId() string {
+	if m != nil {
+		return m.ContentId
+	}
+	return ""
+}
+
+func (m *ReferenceRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ReferenceRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *ReferenceRequest) GetExpirationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return nil
+}
+
+func (m *ReferenceRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+// ReferenceResponse is a response message for the Reference rpc call
+type ReferenceResponse struct {
+	Pointer              *Pointer `protobuf:"bytes,1,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReferenceResponse) Reset()         { *m = ReferenceResponse{} }
+func (m *ReferenceResponse) String() string { return proto.CompactTextString(m) }
+func (*ReferenceResponse) ProtoMessage()    {}
+func (*ReferenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{18}
+}
+func (m *ReferenceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReferenceResponse.Unmarshal(m, b)
+}
+func (m *ReferenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReferenceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReferenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceResponse.Merge(dst, src)
+}
+func (m *ReferenceResponse) XXX_Size() int {
+	return xxx_messageInfo_ReferenceResponse.Size(m)
+}
+func (m *ReferenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceResponse proto.InternalMessageInfo
+
+func (m *ReferenceResponse) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+// BatchResult is the result of a single item of a batch request
+type BatchResult struct {
+	Code                 int32    `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchResult) Reset()         { *m = BatchResult{} }
+func (m *BatchResult) String() string { return proto.CompactTextString(m) }
+func (*BatchResult) ProtoMessage()    {}
+func (*BatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{19}
+}
+func (m *BatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchResult.Unmarshal(m, b)
+}
+func (m *BatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchResult.Marshal(b, m, deterministic)
+}
+func (dst *BatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchResult.Merge(dst, src)
+}
+func (m *BatchResult) XXX_Size() int {
+	return xxx_messageInfo_BatchResult.Size(m)
+}
+func (m *BatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchResult proto.InternalMessageInfo
+
+func (m *BatchResult) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *BatchResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+// BatchGetRequest is a request message for the BatchGet rpc call
+type BatchGetRequest struct {
+	Paths  []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	APIKey []byte   `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if true, the request fails as a whole if any of the items fails
+	Atomic bool `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	// if true, the pointers are returned like with GetRequest.metadata_only
+	MetadataOnly         bool     `protobuf:"varint,4,opt,name=metadata_only,json=metadataOnly,proto3" json:"metadata_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchGetRequest) Reset()         { *m = BatchGetRequest{} }
+func (m *BatchGetRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchGetRequest) ProtoMessage()    {}
+func (*BatchGetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{20}
+}
+func (m *BatchGetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetRequest.Unmarshal(m, b)
+}
+func (m *BatchGetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchGetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetRequest.Merge(dst, src)
+}
+func (m *BatchGetRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchGetRequest.Size(m)
+}
+func (m *BatchGetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetRequest proto.InternalMessageInfo
+
+func (m *BatchGetRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *BatchGetRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *BatchGetRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+func (m *BatchGetRequest) GetMetadataOnly() bool {
+	if m != nil {
+		return m.MetadataOnly
+	}
+	return false
+}
+
+// BatchGetResponse is a response message for the BatchGet rpc call
+type BatchGetResponse struct {
+	Items                []*BatchGetResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *BatchGetResponse) Reset()         { *m = BatchGetResponse{} }
+func (m *BatchGetResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchGetResponse) ProtoMessage()    {}
+func (*BatchGetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{21}
+}
+func (m *BatchGetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetResponse.Unmarshal(m, b)
+}
+func (m *BatchGetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchGetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetResponse.Merge(dst, src)
+}
+func (m *BatchGetResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchGetResponse.Size(m)
+}
+func (m *BatchGetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetResponse proto.InternalMessageInfo
+
+func (m *BatchGetResponse) GetItems() []*BatchGetResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BatchGetResponse_Item struct {
+	Result               *BatchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	Pointer              *Pointer     `protobuf:"bytes,2,opt,name=pointer,proto3" json:"pointer,omitempty"`
+	Nodes                []*Node      `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchGetResponse_Item) Reset()         { *m = BatchGetResponse_Item{} }
+func (m *BatchGetResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*BatchGetResponse_Item) ProtoMessage()    {}
+func (*BatchGetResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{21, 0}
+}
+func (m *BatchGetResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchGetResponse_Item.Unmarshal(m, b)
+}
+func (m *BatchGetResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchGetResponse_Item.Marshal(b, m, deterministic)
+}
+func (dst *BatchGetResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchGetResponse_Item.Merge(dst, src)
+}
+func (m *BatchGetResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_BatchGetResponse_Item.Size(m)
+}
+func (m *BatchGetResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchGetResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchGetResponse_Item proto.InternalMessageInfo
+
+func (m *BatchGetResponse_Item) GetResult() *BatchResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BatchGetResponse_Item) GetPointer() *Pointer {
+	if m != nil {
+		return m.Pointer
+	}
+	return nil
+}
+
+func (m *BatchGetResponse_Item) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// BatchPutRequest is a request message for the BatchPut rpc call
+type BatchPutRequest struct {
+	// the API keys of the requests are replaced by the API key of the batch
+	Requests []*PutRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	APIKey   []byte        `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if true, either all or none of the pointers are stored
+	Atomic               bool     `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchPutRequest) Reset()         { *m = BatchPutRequest{} }
+func (m *BatchPutRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchPutRequest) ProtoMessage()    {}
+func (*BatchPutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{22}
+}
+func (m *BatchPutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchPutRequest.Unmarshal(m, b)
+}
+func (m *BatchPutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchPutRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchPutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPutRequest.Merge(dst, src)
+}
+func (m *BatchPutRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchPutRequest.Size(m)
+}
+func (m *BatchPutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPutRequest proto.InternalMessageInfo
+
+func (m *BatchPutRequest) GetRequests() []*PutRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *BatchPutRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *BatchPutRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+// BatchPutResponse is a response message for the BatchPut rpc call
+type BatchPutResponse struct {
+	Results              []*BatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *BatchPutResponse) Reset()         { *m = BatchPutResponse{} }
+func (m *BatchPutResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchPutResponse) ProtoMessage()    {}
+func (*BatchPutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{23}
+}
+func (m *BatchPutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchPutResponse.Unmarshal(m, b)
+}
+func (m *BatchPutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchPutResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchPutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchPutResponse.Merge(dst, src)
+}
+func (m *BatchPutResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchPutResponse.Size(m)
+}
+func (m *BatchPutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchPutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchPutResponse proto.InternalMessageInfo
+
+func (m *BatchPutResponse) GetResults() []*BatchResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// BatchDeleteRequest is a request message for the BatchDelete rpc call
+type BatchDeleteRequest struct {
+	Paths  []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	APIKey []byte   `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if true, either all or none of the pointers are deleted
+	Atomic               bool     `protobuf:"varint,3,opt,name=atomic,proto3" json:"atomic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchDeleteRequest) Reset()         { *m = BatchDeleteRequest{} }
+func (m *BatchDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteRequest) ProtoMessage()    {}
+func (*BatchDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{24}
+}
+func (m *BatchDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteRequest.Unmarshal(m, b)
+}
+func (m *BatchDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteRequest.Merge(dst, src)
+}
+func (m *BatchDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteRequest.Size(m)
+}
+func (m *BatchDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteRequest proto.InternalMessageInfo
+
+func (m *BatchDeleteRequest) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+func (m *BatchDeleteRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *BatchDeleteRequest) GetAtomic() bool {
+	if m != nil {
+		return m.Atomic
+	}
+	return false
+}
+
+// BatchDeleteResponse is a response message for the BatchDelete rpc call
+type BatchDeleteResponse struct {
+	Items                []*BatchDeleteResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *BatchDeleteResponse) Reset()         { *m = BatchDeleteResponse{} }
+func (m *BatchDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteResponse) ProtoMessage()    {}
+func (*BatchDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{25}
+}
+func (m *BatchDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteResponse.Unmarshal(m, b)
+}
+func (m *BatchDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteResponse.Merge(dst, src)
+}
+func (m *BatchDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteResponse.Size(m)
+}
+func (m *BatchDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteResponse proto.InternalMessageInfo
+
+func (m *BatchDeleteResponse) GetItems() []*BatchDeleteResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type BatchDeleteResponse_Item struct {
+	Result               *BatchResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	PiecesInUse          bool         `protobuf:"varint,2,opt,name=pieces_in_use,json=piecesInUse,proto3" json:"pieces_in_use,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BatchDeleteResponse_Item) Reset()         { *m = BatchDeleteResponse_Item{} }
+func (m *BatchDeleteResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*BatchDeleteResponse_Item) ProtoMessage()    {}
+func (*BatchDeleteResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{25, 0}
+}
+func (m *BatchDeleteResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchDeleteResponse_Item.Unmarshal(m, b)
+}
+func (m *BatchDeleteResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchDeleteResponse_Item.Marshal(b, m, deterministic)
+}
+func (dst *BatchDeleteResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchDeleteResponse_Item.Merge(dst, src)
+}
+func (m *BatchDeleteResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_BatchDeleteResponse_Item.Size(m)
+}
+func (m *BatchDeleteResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchDeleteResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchDeleteResponse_Item proto.InternalMessageInfo
+
+func (m *BatchDeleteResponse_Item) GetResult() *BatchResult {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (m *BatchDeleteResponse_Item) GetPiecesInUse() bool {
+	if m != nil {
+		return m.PiecesInUse
+	}
+	return false
+}
+
+// BatchMoveRequest is a request message for the BatchMove rpc call. Either
+// all or none of the pointers are moved.
+type BatchMoveRequest struct {
+	Requests []*MoveRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+	APIKey   []byte         `protobuf:"bytes,2,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	// if true, the pointers are copied like by Copy instead of moved
+	Copy bool `protobuf:"varint,3,opt,name=copy,proto3" json:"copy,omitempty"`
+	// deleted before the pointers are moved, skipping paths without a pointer
+	DeletePaths          []string `protobuf:"bytes,4,rep,name=delete_paths,json=deletePaths,proto3" json:"delete_paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BatchMoveRequest) Reset()         { *m = BatchMoveRequest{} }
+func (m *BatchMoveRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchMoveRequest) ProtoMessage()    {}
+func (*BatchMoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{26}
+}
+func (m *BatchMoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchMoveRequest.Unmarshal(m, b)
+}
+func (m *BatchMoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchMoveRequest.Marshal(b, m, deterministic)
+}
+func (dst *BatchMoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchMoveRequest.Merge(dst, src)
+}
+func (m *BatchMoveRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchMoveRequest.Size(m)
+}
+func (m *BatchMoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchMoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchMoveRequest proto.InternalMessageInfo
+
+func (m *BatchMoveRequest) GetRequests() []*MoveRequest {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+func (m *BatchMoveRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+func (m *BatchMoveRequest) GetCopy() bool {
+	if m != nil {
+		return m.Copy
+	}
+	return false
+}
+
+func (m *BatchMoveRequest) GetDeletePaths() []string {
+	if m != nil {
+		return m.DeletePaths
+	}
+	return nil
+}
+
+// BatchMoveResponse is a response message for the BatchMove rpc call
+type BatchMoveResponse struct {
+	Pointers             []*Pointer `protobuf:"bytes,1,rep,name=pointers,proto3" json:"pointers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *BatchMoveResponse) Reset()         { *m = BatchMoveResponse{} }
+func (m *BatchMoveResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchMoveResponse) ProtoMessage()    {}
+func (*BatchMoveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{27}
+}
+func (m *BatchMoveResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchMoveResponse.Unmarshal(m, b)
+}
+func (m *BatchMoveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchMoveResponse.Marshal(b, m, deterministic)
+}
+func (dst *BatchMoveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchMoveResponse.Merge(dst, src)
+}
+func (m *BatchMoveResponse) XXX_Size() int {
+	return xxx_messageInfo_BatchMoveResponse.Size(m)
+}
+func (m *BatchMoveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchMoveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchMoveResponse proto.InternalMessageInfo
+
+func (m *BatchMoveResponse) GetPointers() []*Pointer {
+	if m != nil {
+		return m.Pointers
+	}
+	return nil
+}
+
+// ListStreamResponse is a response message of the ListStream rpc call,
+// carrying the next items of the listing
+type ListStreamResponse struct {
+	Items                []*ListResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListStreamResponse) Reset()         { *m = ListStreamResponse{} }
+func (m *ListStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStreamResponse) ProtoMessage()    {}
+func (*ListStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{28}
+}
+func (m *ListStreamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStreamResponse.Unmarshal(m, b)
+}
+func (m *ListStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStreamResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStreamResponse.Merge(dst, src)
+}
+func (m *ListStreamResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStreamResponse.Size(m)
+}
+func (m *ListStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStreamResponse proto.InternalMessageInfo
+
+func (m *ListStreamResponse) GetItems() []*ListResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// NodeSegmentsRequest is a request message for the NodeSegments rpc call.
+// The shared API key lists the segments of all projects, whose paths start
+// with projects/<project id>/.
+type NodeSegmentsRequest struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	StartAfter           string   `protobuf:"bytes,2,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	APIKey               []byte   `protobuf:"bytes,4,opt,name=API_key,json=APIKey,proto3" json:"API_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeSegmentsRequest) Reset()         { *m = NodeSegmentsRequest{} }
+func (m *NodeSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeSegmentsRequest) ProtoMessage()    {}
+func (*NodeSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{29}
+}
+func (m *NodeSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSegmentsRequest.Unmarshal(m, b)
+}
+func (m *NodeSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *NodeSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSegmentsRequest.Merge(dst, src)
+}
+func (m *NodeSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeSegmentsRequest.Size(m)
+}
+func (m *NodeSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSegmentsRequest proto.InternalMessageInfo
+
+func (m *NodeSegmentsRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *NodeSegmentsRequest) GetStartAfter() string {
+	if m != nil {
+		return m.StartAfter
+	}
+	return ""
+}
+
+func (m *NodeSegmentsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *NodeSegmentsRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+// NodeSegmentsResponse is a response message for the NodeSegments rpc call
+type NodeSegmentsResponse struct {
+	Items                []*NodeSegmentsResponse_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	More                 bool                         `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *NodeSegmentsResponse) Reset()         { *m = NodeSegmentsResponse{} }
+func (m *NodeSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeSegmentsResponse) ProtoMessage()    {}
+func (*NodeSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{30}
+}
+func (m *NodeSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSegmentsResponse.Unmarshal(m, b)
+}
+func (m *NodeSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *NodeSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSegmentsResponse.Merge(dst, src)
+}
+func (m *NodeSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_NodeSegmentsResponse.Size(m)
+}
+func (m *NodeSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSegmentsResponse proto.InternalMessageInfo
+
+func (m *NodeSegmentsResponse) GetItems() []*NodeSegmentsResponse_Item {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *NodeSegmentsResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+type NodeSegmentsResponse_Item struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PieceNums            []int32  `protobuf:"varint,2,rep,packed,name=piece_nums,json=pieceNums,proto3" json:"piece_nums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeSegmentsResponse_Item) Reset()         { *m = NodeSegmentsResponse_Item{} }
+func (m *NodeSegmentsResponse_Item) String() string { return proto.CompactTextString(m) }
+func (*NodeSegmentsResponse_Item) ProtoMessage()    {}
+func (*NodeSegmentsResponse_Item) Descriptor() ([]byte, []int) {
+	return fileDescriptor_75fef806d28fc810, []int{30, 0}
+}
+func (m *NodeSegmentsResponse_Item) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeSegmentsResponse_Item.Unmarshal(m, b)
+}
+func (m *NodeSegmentsResponse_Item) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeSegmentsResponse_Item.Marshal(b, m, deterministic)
+}
+func (dst *NodeSegmentsResponse_Item) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSegmentsResponse_Item.Merge(dst, src)
+}
+func (m *NodeSegmentsResponse_Item) XXX_Size() int {
+	return xxx_messageInfo_NodeSegmentsResponse_Item.Size(m)
+}
+func (m *NodeSegmentsResponse_Item) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSegmentsResponse_Item.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSegmentsResponse_Item proto.InternalMessageInfo
+
+func (m *NodeSegmentsResponse_Item) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *NodeSegmentsResponse_Item) GetPieceNums() []int32 {
+	if m != nil {
+		return m.PieceNums
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RedundancyScheme)(nil), "pointerdb.RedundancyScheme")
+	proto.RegisterType((*EncryptionScheme)(nil), "pointerdb.EncryptionScheme")
+	proto.RegisterType((*RemotePiece)(nil), "pointerdb.RemotePiece")
+	proto.RegisterType((*RemoteSegment)(nil), "pointerdb.RemoteSegment")
+	proto.RegisterType((*Pointer)(nil), "pointerdb.Pointer")
+	proto.RegisterType((*PutRequest)(nil), "pointerdb.PutRequest")
+	proto.RegisterType((*GetRequest)(nil), "pointerdb.GetRequest")
+	proto.RegisterType((*ListRequest)(nil), "pointerdb.ListRequest")
+	proto.RegisterType((*PutResponse)(nil), "pointerdb.PutResponse")
+	proto.RegisterType((*GetResponse)(nil), "pointerdb.GetResponse")
+	proto.RegisterType((*ListResponse)(nil), "pointerdb.ListResponse")
+	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
+	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
+	proto.RegisterType((*CopyRequest)(nil), "pointerdb.CopyRequest")
+	proto.RegisterType((*CopyResponse)(nil), "pointerdb.CopyResponse")
+	proto.RegisterType((*MoveRequest)(nil), "pointerdb.MoveRequest")
+	proto.RegisterType((*MoveResponse)(nil), "pointerdb.MoveResponse")
+	proto.RegisterType((*ReferenceRequest)(nil), "pointerdb.ReferenceRequest")
+	proto.RegisterType((*ReferenceResponse)(nil), "pointerdb.ReferenceResponse")
+	proto.RegisterType((*BatchResult)(nil), "pointerdb.BatchResult")
+	proto.RegisterType((*BatchGetRequest)(nil), "pointerdb.BatchGetRequest")
+	proto.RegisterType((*BatchGetResponse)(nil), "pointerdb.BatchGetResponse")
+	proto.RegisterType((*BatchGetResponse_Item)(nil), "pointerdb.BatchGetResponse.Item")
+	proto.RegisterType((*BatchPutRequest)(nil), "pointerdb.BatchPutRequest")
+	proto.RegisterType((*BatchPutResponse)(nil), "pointerdb.BatchPutResponse")
+	proto.RegisterType((*BatchDeleteRequest)(nil), "pointerdb.BatchDeleteRequest")
+	proto.RegisterType((*BatchDeleteResponse)(nil), "pointerdb.BatchDeleteResponse")
+	proto.RegisterType((*BatchDeleteResponse_Item)(nil), "pointerdb.BatchDeleteResponse.Item")
+	proto.RegisterType((*BatchMoveRequest)(nil), "pointerdb.BatchMoveRequest")
+	proto.RegisterType((*BatchMoveResponse)(nil), "pointerdb.BatchMoveResponse")
+	proto.RegisterType((*ListStreamResponse)(nil), "pointerdb.ListStreamResponse")
+	proto.RegisterType((*NodeSegmentsRequest)(nil), "pointerdb.NodeSegmentsRequest")
+	proto.RegisterType((*NodeSegmentsResponse)(nil), "pointerdb.NodeSegmentsResponse")
+	proto.RegisterType((*NodeSegmentsResponse_Item)(nil), "pointerdb.NodeSegmentsResponse.Item")
+	proto.RegisterEnum("pointerdb.RedundancyScheme_SchemeType", RedundancyScheme_SchemeType_name, RedundancyScheme_SchemeType_value)
+	proto.RegisterEnum("pointerdb.EncryptionScheme_EncryptionType", EncryptionScheme_EncryptionType_name, EncryptionScheme_EncryptionType_value)
+	proto.RegisterEnum("pointerdb.Pointer_DataType", Pointer_DataType_name, Pointer_DataType_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PointerDBClient is the client API for PointerDB service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PointerDBClient interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Copy duplicates the pointer at a path to a new path, sharing its pieces
+	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error)
+	// Move replaces the pointer at a path to a new path
+	Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error)
+	// Reference stores a pointer sharing the pieces of an earlier segment with
+	// the same content
+	Reference(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceResponse, error)
+	// BatchGet gets the pointers at several paths at once
+	BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error)
+	// BatchPut stores several pointers at once
+	BatchPut(ctx context.Context, in *BatchPutRequest, opts ...grpc.CallOption) (*BatchPutResponse, error)
+	// BatchDelete deletes the pointers at several paths at once
+	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error)
+	// BatchMove moves or copies several pointers at once, atomically
+	BatchMove(ctx context.Context, in *BatchMoveRequest, opts ...grpc.CallOption) (*BatchMoveResponse, error)
+	// ListStream streams all items of a listing in order, without paging
+	ListStream(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (PointerDB_ListStreamClient, error)
+	// NodeSegments lists the segments with pieces on a storage node
+	NodeSegments(ctx context.Context, in *NodeSegmentsRequest, opts ...grpc.CallOption) (*NodeSegmentsResponse, error)
+}
+
+type pointerDBClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPointerDBClient(cc *grpc.ClientConn) PointerDBClient {
+	return &pointerDBClient{cc}
+}
+
+func (c *pointerDBClient) Put(ctx context.Context, in *PutRequest, opts ...grpc.CallOption) (*PutResponse, error) {
+	out := new(PutResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Put", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Get(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*GetResponse, error) {
+	out := new(GetResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error) {
+	out := new(ListResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error) {
+	out := new(DeleteResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error) {
+	out := new(CopyResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Move(ctx context.Context, in *MoveRequest, opts ...grpc.CallOption) (*MoveResponse, error) {
+	out := new(MoveResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Move", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) Reference(ctx context.Context, in *ReferenceRequest, opts ...grpc.CallOption) (*ReferenceResponse, error) {
+	out := new(ReferenceResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Reference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BatchGet(ctx context.Context, in *BatchGetRequest, opts ...grpc.CallOption) (*BatchGetResponse, error) {
+	out := new(BatchGetResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BatchGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BatchPut(ctx context.Context, in *BatchPutRequest, opts ...grpc.CallOption) (*BatchPutResponse, error) {
+	out := new(BatchPutResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BatchPut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteResponse, error) {
+	out := new(BatchDeleteResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BatchDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) BatchMove(ctx context.Context, in *BatchMoveRequest, opts ...grpc.CallOption) (*BatchMoveResponse, error) {
+	out := new(BatchMoveResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/BatchMove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pointerDBClient) ListStream(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (PointerDB_ListStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PointerDB_serviceDesc.Streams[0], "/pointerdb.PointerDB/ListStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &pointerDBListStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PointerDB_ListStreamClient interface {
+	Recv() (*ListStreamResponse, error)
+	grpc.ClientStream
+}
+
+type pointerDBListStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *pointerDBListStreamClient) Recv() (*ListStreamResponse, error) {
+	m := new(ListStreamResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *pointerDBClient) NodeSegments(ctx context.Context, in *NodeSegmentsRequest, opts ...grpc.CallOption) (*NodeSegmentsResponse, error) {
+	out := new(NodeSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/NodeSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PointerDBServer is the server API for PointerDB service.
+type PointerDBServer interface {
+	// Put formats and hands off a file path to be saved to boltdb
+	Put(context.Context, *PutRequest) (*PutResponse, error)
+	// Get formats and hands off a file path to get a small value from boltdb
+	Get(context.Context, *GetRequest) (*GetResponse, error)
+	// List calls the bolt client's List function and returns all file paths
+	List(context.Context, *ListRequest) (*ListResponse, error)
+	// Delete formats and hands off a file path to delete from boltdb
+	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Copy duplicates the pointer at a path to a new path, sharing its pieces
+	Copy(context.Context, *CopyRequest) (*CopyResponse, error)
+	// Move replaces the pointer at a path to a new path
+	Move(context.Context, *MoveRequest) (*MoveResponse, error)
+	// Reference stores a pointer sharing the pieces of an earlier segment with
+	// the same content
+	Reference(context.Context, *ReferenceRequest) (*ReferenceResponse, error)
+	// BatchGet gets the pointers at several paths at once
+	BatchGet(context.Context, *BatchGetRequest) (*BatchGetResponse, error)
+	// BatchPut stores several pointers at once
+	BatchPut(context.Context, *BatchPutRequest) (*BatchPutResponse, error)
+	// BatchDelete deletes the pointers at several paths at once
+	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteResponse, error)
+	// BatchMove moves or copies several pointers at once, atomically
+	BatchMove(context.Context, *BatchMoveRequest) (*BatchMoveResponse, error)
+	// ListStream streams all items of a listing in order, without paging
+	ListStream(*ListRequest, PointerDB_ListStreamServer) error
+	// NodeSegments lists the segments with pieces on a storage node
+	NodeSegments(context.Context, *NodeSegmentsRequest) (*NodeSegmentsResponse, error)
+}
+
+func RegisterPointerDBServer(s *grpc.Server, srv PointerDBServer) {
+	s.RegisterService(&_PointerDB_serviceDesc, srv)
+}
+
+func _PointerDB_Put_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Put(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Put",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Put(ctx, req.(*PutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Get(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Delete(ctx, req.(*DeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Copy(ctx, req.(*CopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Move_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Move(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Move",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Move(ctx, req.(*MoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_Reference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Reference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Reference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Reference(ctx, req.(*ReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BatchGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BatchGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BatchGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BatchGet(ctx, req.(*BatchGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BatchPut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchPutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BatchPut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BatchPut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BatchPut(ctx, req.(*BatchPutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BatchDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BatchDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BatchDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BatchDelete(ctx, req.(*BatchDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_BatchMove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).BatchMove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/BatchMove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).BatchMove(ctx, req.(*BatchMoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PointerDB_ListStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PointerDBServer).ListStream(m, &pointerDBListStreamServer{stream})
+}
+
+type PointerDB_ListStreamServer interface {
+	Send(*ListStreamResponse) error
+	grpc.ServerStream
+}
+
+type pointerDBListStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *pointerDBListStreamServer) Send(m *ListStreamResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PointerDB_NodeSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).NodeSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/NodeSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).NodeSegments(ctx, req.(*NodeSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
 var _PointerDB_serviceDesc = grpc.ServiceDesc{
@@ -1062,77 +2500,159 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PointerDB_Delete_Handler,
 		},
+		{
+			MethodName: "Copy",
+			Handler:    _PointerDB_Copy_Handler,
+		},
+		{
+			MethodName: "Move",
+			Handler:    _PointerDB_Move_Handler,
+		},
+		{
+			MethodName: "Reference",
+			Handler:    _PointerDB_Reference_Handler,
+		},
+		{
+			MethodName: "BatchGet",
+			Handler:    _PointerDB_BatchGet_Handler,
+		},
+		{
+			MethodName: "BatchPut",
+			Handler:    _PointerDB_BatchPut_Handler,
+		},
+		{
+			MethodName: "BatchDelete",
+			Handler:    _PointerDB_BatchDelete_Handler,
+		},
+		{
+			MethodName: "BatchMove",
+			Handler:    _PointerDB_BatchMove_Handler,
+		},
+		{
+			MethodName: "NodeSegments",
+			Handler:    _PointerDB_NodeSegments_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ListStream",
+			Handler:       _PointerDB_ListStream_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pointerdb.proto",
 }
 
 func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_75fef806d28fc810) }
 
 var fileDescriptor_75fef806d28fc810 = []byte{
-	// 1013 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdb, 0x6e, 0xdb, 0x46,
-	0x10, 0x35, 0x75, 0xe7, 0xc8, 0xb2, 0xd9, 0x45, 0xea, 0x30, 0x72, 0x8a, 0x18, 0x2c, 0x5a, 0xb8,
-	0x4d, 0x20, 0x17, 0x6a, 0x80, 0x5e, 0xd2, 0x0b, 0x7c, 0x51, 0x0d, 0x21, 0x89, 0x23, 0xac, 0xfc,
-	0x50, 0xf4, 0x85, 0xa5, 0xc5, 0xb1, 0xbc, 0x88, 0x78, 0xf1, 0xee, 0x32, 0x88, 0xf2, 0x27, 0xfd,
-	0x98, 0x3e, 0xf6, 0x03, 0xfa, 0x21, 0x45, 0x1f, 0xfa, 0x03, 0xc5, 0xee, 0x92, 0x12, 0x69, 0xb7,
-	0x09, 0x50, 0xf4, 0xc5, 0xe6, 0x9c, 0x39, 0x33, 0xb3, 0x73, 0x66, 0x76, 0x05, 0xdb, 0x69, 0xc2,
-	0x62, 0x89, 0x3c, 0xbc, 0x18, 0xa4, 0x3c, 0x91, 0x09, 0xb1, 0x57, 0x40, 0xff, 0xc1, 0x3c, 0x49,
-	0xe6, 0x0b, 0x3c, 0xd0, 0x8e, 0x8b, 0xec, 0xf2, 0x40, 0xb2, 0x08, 0x85, 0x0c, 0xa2, 0xd4, 0x70,
-	0xfb, 0xbd, 0xe4, 0x15, 0xf2, 0x45, 0xb0, 0x34, 0xa6, 0xf7, 0x4b, 0x0d, 0x1c, 0x8a, 0x61, 0x16,
-	0x87, 0x41, 0x3c, 0x5b, 0x4e, 0x67, 0x57, 0x18, 0x21, 0xf9, 0x1a, 0x1a, 0x72, 0x99, 0xa2, 0x6b,
-	0xed, 0x59, 0xfb, 0x5b, 0xc3, 0x8f, 0x07, 0xeb, 0x7a, 0x37, 0xa9, 0x03, 0xf3, 0xef, 0x7c, 0x99,
-	0x22, 0xd5, 0x31, 0xe4, 0x2e, 0xb4, 0x23, 0x16, 0xfb, 0x1c, 0xaf, 0xdd, 0xda, 0x9e, 0xb5, 0xdf,
-	0xa4, 0xad, 0x88, 0xc5, 0x14, 0xaf, 0xc9, 0x1d, 0x68, 0xca, 0x44, 0x06, 0x0b, 0xb7, 0xae, 0x61,
-	0x63, 0x90, 0x4f, 0xc0, 0xe1, 0x98, 0x06, 0x8c, 0xfb, 0xf2, 0x8a, 0xa3, 0xb8, 0x4a, 0x16, 0xa1,
-	0xdb, 0xd0, 0x84, 0x6d, 0x83, 0x9f, 0x17, 0x30, 0x79, 0x08, 0xef, 0x89, 0x6c, 0x36, 0x43, 0x21,
-	0x4a, 0xdc, 0xa6, 0xe6, 0x3a, 0xb9, 0x63, 0x4d, 0x7e, 0x04, 0x04, 0x79, 0x20, 0x32, 0x8e, 0xbe,
-	0xb8, 0x0a, 0xd4, 0x5f, 0xf6, 0x06, 0xdd, 0x96, 0x61, 0xe7, 0x9e, 0xa9, 0x72, 0x4c, 0xd9, 0x1b,
-	0xf4, 0xee, 0x00, 0xac, 0x1b, 0x21, 0x2d, 0xa8, 0xd1, 0xa9, 0xb3, 0xe1, 0xfd, 0x65, 0x81, 0x33,
-	0x8a, 0x67, 0x7c, 0x99, 0x4a, 0x96, 0xc4, 0xb9, 0x36, 0xdf, 0x55, 0xb4, 0xf9, 0xb4, 0xa4, 0xcd,
-	0x4d, 0x6a, 0x09, 0x28, 0xe9, 0xf3, 0x25, 0xb8, 0x68, 0x70, 0x0c, 0x7d, 0x5c, 0x31, 0xfc, 0x97,
-	0xb8, 0xd4, 0x82, 0x6d, 0xd2, 0x9d, 0x95, 0x7f, 0x9d, 0xe0, 0x29, 0x2e, 0xab, 0x91, 0x42, 0x06,
-	0x5c, 0xb2, 0x78, 0xee, 0xc7, 0x49, 0x3c, 0x43, 0xad, 0x69, 0x39, 0x72, 0x9a, 0xbb, 0xcf, 0x94,
-	0xd7, 0x7b, 0x08, 0x5b, 0xd5, 0xb3, 0x10, 0x80, 0xd6, 0xe1, 0x68, 0x7a, 0x7a, 0xfc, 0xdc, 0xd9,
-	0x20, 0x3d, 0xb0, 0xa7, 0xa3, 0x63, 0x3a, 0x3a, 0x3f, 0x7a, 0xf1, 0xa3, 0x63, 0x79, 0xc7, 0xd0,
-	0xa5, 0x18, 0x25, 0x12, 0x27, 0x0c, 0x67, 0x48, 0x76, 0xc1, 0x4e, 0xd5, 0x87, 0x1f, 0x67, 0x91,
-	0x6e, 0xba, 0x49, 0x3b, 0x1a, 0x38, 0xcb, 0x22, 0x35, 0xec, 0x38, 0x09, 0xd1, 0x67, 0xa1, 0x3e,
-	0xbb, 0x4d, 0x5b, 0xca, 0x1c, 0x87, 0xde, 0x6f, 0x16, 0xf4, 0x4c, 0x96, 0x29, 0xce, 0x23, 0x8c,
-	0x25, 0x79, 0x02, 0xc0, 0x57, 0xcb, 0xa3, 0x13, 0x75, 0x87, 0xbb, 0x6f, 0xd9, 0x2c, 0x5a, 0xa2,
-	0x93, 0x7b, 0x60, 0x6a, 0xae, 0x0b, 0xb5, 0xb5, 0x3d, 0x0e, 0xc9, 0x13, 0xe8, 0x71, 0x5d, 0xc8,
-	0xd7, 0x88, 0x70, 0xeb, 0x7b, 0xf5, 0xfd, 0xee, 0x70, 0xa7, 0x92, 0x7a, 0xd5, 0x0e, 0xdd, 0xe4,
-	0x6b, 0x43, 0x90, 0x07, 0xd0, 0x8d, 0x90, 0xbf, 0x5c, 0xa0, 0xcf, 0x93, 0x44, 0xea, 0xc5, 0xdb,
-	0xa4, 0x60, 0x20, 0x9a, 0x24, 0xd2, 0xfb, 0xa3, 0x06, 0xed, 0x89, 0x49, 0x44, 0x0e, 0x2a, 0x93,
-	0x2f, 0x9f, 0x3d, 0x67, 0x0c, 0x4e, 0x02, 0x19, 0x94, 0x46, 0xfd, 0x11, 0x6c, 0xb1, 0x78, 0xc1,
-	0x62, 0xf4, 0x85, 0x11, 0x21, 0x1f, 0x53, 0xcf, 0xa0, 0x85, 0x32, 0x9f, 0x41, 0xcb, 0x1c, 0x4a,
-	0xd7, 0xef, 0x0e, 0xdd, 0x5b, 0x47, 0xcf, 0x99, 0x34, 0xe7, 0x11, 0x02, 0x0d, 0xbd, 0xce, 0x6a,
-	0xf9, 0xeb, 0x54, 0x7f, 0x93, 0xef, 0xa1, 0x37, 0xe3, 0x18, 0xe8, 0x5d, 0x0a, 0x03, 0x69, 0x76,
-	0xbd, 0x3b, 0xec, 0x0f, 0xcc, 0x83, 0x30, 0x28, 0x1e, 0x84, 0xc1, 0x79, 0xf1, 0x20, 0xd0, 0xcd,
-	0x22, 0xe0, 0x24, 0x90, 0x48, 0x8e, 0x61, 0x1b, 0x5f, 0xa7, 0x8c, 0x97, 0x52, 0xb4, 0xdf, 0x99,
-	0x62, 0x6b, 0x1d, 0xa2, 0x93, 0xf4, 0xa1, 0x13, 0xa1, 0x0c, 0xc2, 0x40, 0x06, 0x6e, 0x47, 0x37,
-	0xbb, 0xb2, 0x3d, 0x0f, 0x3a, 0x85, 0x40, 0x6a, 0xff, 0xc6, 0x67, 0xcf, 0xc6, 0x67, 0x23, 0x67,
-	0x43, 0x7d, 0xd3, 0xd1, 0xf3, 0x17, 0xe7, 0x23, 0xc7, 0xf2, 0xe6, 0x00, 0x93, 0x4c, 0x52, 0xbc,
-	0xce, 0x50, 0x48, 0xd5, 0x67, 0x1a, 0xc8, 0x2b, 0xad, 0xb8, 0x4d, 0xf5, 0x37, 0x79, 0x04, 0xed,
-	0x5c, 0x1e, 0xbd, 0x09, 0xdd, 0x21, 0xb9, 0x3d, 0x08, 0x5a, 0x50, 0xd4, 0x82, 0x1e, 0x4e, 0xc6,
-	0xfa, 0x72, 0x19, 0xed, 0x5b, 0x87, 0x93, 0xf1, 0x53, 0x5c, 0x7a, 0x5f, 0x01, 0x9c, 0xe2, 0x5b,
-	0x0b, 0x95, 0x42, 0x6b, 0x95, 0xd0, 0xdf, 0x2d, 0xe8, 0x3e, 0x63, 0x62, 0x15, 0xbc, 0x03, 0xad,
-	0x94, 0xe3, 0x25, 0x7b, 0x9d, 0x87, 0xe7, 0x96, 0x5a, 0x2e, 0x7d, 0x4b, 0xfd, 0xe0, 0xb2, 0x38,
-	0xad, 0x4d, 0x41, 0x43, 0x87, 0x0a, 0x21, 0x1f, 0x00, 0x60, 0x1c, 0xfa, 0x17, 0x78, 0x99, 0x70,
-	0x73, 0x85, 0x6d, 0x6a, 0x63, 0x1c, 0x1e, 0x69, 0x80, 0xdc, 0x07, 0x9b, 0xe3, 0x2c, 0xe3, 0x82,
-	0xbd, 0x32, 0xab, 0xd1, 0xa1, 0x6b, 0x40, 0x3d, 0xa7, 0x0b, 0x16, 0x31, 0x99, 0xbf, 0x80, 0xc6,
-	0x50, 0x29, 0x95, 0xde, 0xfe, 0xe5, 0x22, 0x98, 0x0b, 0xbd, 0x02, 0x6d, 0x6a, 0x2b, 0xe4, 0x07,
-	0x05, 0x94, 0x7b, 0x6a, 0x57, 0x7a, 0xea, 0x41, 0x57, 0xeb, 0x2e, 0xd2, 0x24, 0x16, 0xe8, 0xfd,
-	0x0c, 0x5d, 0xad, 0x8e, 0x31, 0xcb, 0x9a, 0x5b, 0xef, 0xd6, 0xfc, 0x43, 0x68, 0xaa, 0x57, 0x40,
-	0xb8, 0x35, 0x7d, 0x13, 0x7b, 0x83, 0xe2, 0x17, 0xe7, 0x2c, 0x09, 0x91, 0x1a, 0x9f, 0xf7, 0xab,
-	0x05, 0x9b, 0x46, 0xc4, 0xbc, 0xc6, 0x10, 0x9a, 0x4c, 0x62, 0x24, 0x5c, 0x4b, 0x47, 0xdd, 0x2f,
-	0x55, 0x28, 0xf3, 0x06, 0x63, 0x89, 0x11, 0x35, 0x54, 0x35, 0xb6, 0x48, 0x49, 0x57, 0xd3, 0xe2,
-	0xe8, 0xef, 0x3e, 0x42, 0x43, 0x51, 0xfe, 0x87, 0xdd, 0xd9, 0x05, 0x9b, 0x09, 0x3f, 0x1f, 0x6d,
-	0x5d, 0x97, 0xe8, 0x30, 0x31, 0xd1, 0xb6, 0xf7, 0x0d, 0xf4, 0x4e, 0x70, 0x81, 0x12, 0xff, 0xd3,
-	0x0a, 0x39, 0xb0, 0x55, 0x44, 0x9b, 0xb6, 0x86, 0x7f, 0x5a, 0x60, 0xe7, 0x27, 0x38, 0x39, 0x22,
-	0x8f, 0xa1, 0x3e, 0xc9, 0x24, 0x79, 0xbf, 0x7c, 0xbc, 0xd5, 0xb5, 0xe8, 0xef, 0xdc, 0x84, 0x73,
-	0x09, 0x1f, 0x43, 0xfd, 0x14, 0xab, 0x51, 0xeb, 0x1d, 0xaf, 0x44, 0x95, 0x87, 0xfb, 0x05, 0x34,
-	0x94, 0xc0, 0x64, 0xe7, 0x96, 0xe2, 0x26, 0xee, 0xee, 0xbf, 0x4c, 0x82, 0x7c, 0x0b, 0x2d, 0xd3,
-	0x04, 0x29, 0xbf, 0x58, 0x15, 0x55, 0xfa, 0xf7, 0xfe, 0xc1, 0x63, 0xc2, 0x8f, 0x1a, 0x3f, 0xd5,
-	0xd2, 0x8b, 0x8b, 0x96, 0x7e, 0x54, 0x3e, 0xff, 0x3b, 0x00, 0x00, 0xff, 0xff, 0xa7, 0x69, 0xc5,
-	0xee, 0xd4, 0x08, 0x00, 0x00,
+	// 1719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x5b, 0x8f, 0x1b, 0x49,
+	0x15, 0x4e, 0xfb, 0xde, 0xc7, 0xf6, 0x8c, 0x53, 0x1b, 0x26, 0x5e, 0x27, 0xd9, 0x84, 0x0e, 0xa0,
+	0x84, 0x5d, 0x39, 0xc1, 0x44, 0xc0, 0x6e, 0x16, 0xd0, 0x5c, 0xbc, 0x83, 0x45, 0x32, 0xb1, 0xca,
+	0x03, 0x42, 0x8b, 0x44, 0xd3, 0xe3, 0x3e, 0x33, 0xd3, 0x5a, 0x77, 0xb7, 0xd3, 0x55, 0x8e, 0xc6,
+	0x2b, 0xc1, 0x2b, 0xe2, 0x85, 0x47, 0x24, 0x1e, 0x79, 0xe7, 0x2f, 0x20, 0x9e, 0xf8, 0x01, 0x3c,
+	0xc0, 0x3b, 0xbf, 0x81, 0x3f, 0x80, 0xea, 0xd2, 0xed, 0x6a, 0x5f, 0x66, 0xc8, 0x90, 0x87, 0x7d,
+	0x99, 0xe9, 0x3a, 0x75, 0xea, 0x5c, 0xbe, 0x73, 0xea, 0xab, 0x63, 0xd8, 0x9e, 0xc6, 0x41, 0xc4,
+	0x31, 0xf1, 0x4f, 0xba, 0xd3, 0x24, 0xe6, 0x31, 0xb1, 0x33, 0x41, 0xe7, 0xfe, 0x59, 0x1c, 0x9f,
+	0x4d, 0xf0, 0x89, 0xdc, 0x38, 0x99, 0x9d, 0x3e, 0xe1, 0x41, 0x88, 0x8c, 0x7b, 0xe1, 0x54, 0xe9,
+	0x76, 0x9a, 0xf1, 0x1b, 0x4c, 0x26, 0xde, 0x5c, 0x2d, 0x9d, 0x3f, 0x15, 0xa0, 0x45, 0xd1, 0x9f,
+	0x45, 0xbe, 0x17, 0x8d, 0xe7, 0xa3, 0xf1, 0x39, 0x86, 0x48, 0x3e, 0x81, 0x12, 0x9f, 0x4f, 0xb1,
+	0x6d, 0x3d, 0xb0, 0x1e, 0x6d, 0xf5, 0xbe, 0xd5, 0x5d, 0xf8, 0x5b, 0x56, 0xed, 0xaa, 0x7f, 0xc7,
+	0xf3, 0x29, 0x52, 0x79, 0x86, 0xdc, 0x86, 0x6a, 0x18, 0x44, 0x6e, 0x82, 0xaf, 0xdb, 0x85, 0x07,
+	0xd6, 0xa3, 0x32, 0xad, 0x84, 0x41, 0x44, 0xf1, 0x35, 0xb9, 0x05, 0x65, 0x1e, 0x73, 0x6f, 0xd2,
+	0x2e, 0x4a, 0xb1, 0x5a, 0x90, 0xc7, 0xd0, 0x4a, 0x70, 0xea, 0x05, 0x89, 0xcb, 0xcf, 0x13, 0x64,
+	0xe7, 0xf1, 0xc4, 0x6f, 0x97, 0xa4, 0xc2, 0xb6, 0x92, 0x1f, 0xa7, 0x62, 0xf2, 0x21, 0xdc, 0x64,
+	0xb3, 0xf1, 0x18, 0x19, 0x33, 0x74, 0xcb, 0x52, 0xb7, 0xa5, 0x37, 0x16, 0xca, 0x1f, 0x01, 0xc1,
+	0xc4, 0x63, 0xb3, 0x04, 0x5d, 0x76, 0xee, 0x89, 0xbf, 0xc1, 0x97, 0xd8, 0xae, 0x28, 0x6d, 0xbd,
+	0x33, 0x12, 0x1b, 0xa3, 0xe0, 0x4b, 0x74, 0x6e, 0x01, 0x2c, 0x12, 0x21, 0x15, 0x28, 0xd0, 0x51,
+	0xeb, 0x86, 0xf3, 0x1f, 0x0b, 0x5a, 0xfd, 0x68, 0x9c, 0xcc, 0xa7, 0x3c, 0x88, 0x23, 0x8d, 0xcd,
+	0x8f, 0x72, 0xd8, 0x7c, 0xdb, 0xc0, 0x66, 0x59, 0xd5, 0x10, 0x18, 0xf8, 0xfc, 0x00, 0xda, 0xa8,
+	0xe4, 0xe8, 0xbb, 0x98, 0x69, 0xb8, 0x5f, 0xe0, 0x5c, 0x02, 0xd6, 0xa0, 0x3b, 0xd9, 0xfe, 0xc2,
+	0xc0, 0x4f, 0x71, 0x9e, 0x3f, 0xc9, 0xb8, 0x97, 0xf0, 0x20, 0x3a, 0x73, 0xa3, 0x38, 0x1a, 0x63,
+	0xbb, 0xb8, 0x74, 0x72, 0xa4, 0xb7, 0x8f, 0xc4, 0xae, 0xf3, 0x21, 0x6c, 0xe5, 0x63, 0x21, 0x00,
+	0x95, 0xdd, 0xfe, 0xe8, 0x70, 0xff, 0x65, 0xeb, 0x06, 0x69, 0x82, 0x3d, 0xea, 0xef, 0xd3, 0xfe,
+	0xf1, 0xde, 0xab, 0x5f, 0xb4, 0x2c, 0x67, 0x1f, 0xea, 0x14, 0xc3, 0x98, 0xe3, 0x30, 0xc0, 0x31,
+	0x92, 0x3b, 0x60, 0x4f, 0xc5, 0x87, 0x1b, 0xcd, 0x42, 0x99, 0x74, 0x99, 0xd6, 0xa4, 0xe0, 0x68,
+	0x16, 0x8a, 0x62, 0x47, 0xb1, 0x8f, 0x6e, 0xe0, 0xcb, 0xd8, 0x6d, 0x5a, 0x11, 0xcb, 0x81, 0xef,
+	0xfc, 0xdd, 0x82, 0xa6, 0xb2, 0x32, 0xc2, 0xb3, 0x10, 0x23, 0x4e, 0x9e, 0x03, 0x24, 0x59, 0xf3,
+	0x48, 0x43, 0xf5, 0xde, 0x9d, 0x4b, 0x3a, 0x8b, 0x1a, 0xea, 0xe4, 0x7d, 0x50, 0x3e, 0x17, 0x8e,
+	0xaa, 0x72, 0x3d, 0xf0, 0xc9, 0x73, 0x68, 0x26, 0xd2, 0x91, 0x2b, 0x25, 0xac, 0x5d, 0x7c, 0x50,
+	0x7c, 0x54, 0xef, 0xed, 0xe4, 0x4c, 0x67, 0xe9, 0xd0, 0x46, 0xb2, 0x58, 0x30, 0x72, 0x1f, 0xea,
+	0x21, 0x26, 0x5f, 0x4c, 0xd0, 0x4d, 0xe2, 0x98, 0xcb, 0xc6, 0x6b, 0x50, 0x50, 0x22, 0x1a, 0xc7,
+	0xdc, 0xf9, 0x5d, 0x11, 0xaa, 0x43, 0x65, 0x88, 0x3c, 0xc9, 0x55, 0xde, 0x8c, 0x5d, 0x6b, 0x74,
+	0x0f, 0x3c, 0xee, 0x19, 0xa5, 0xfe, 0x26, 0x6c, 0x05, 0xd1, 0x24, 0x88, 0xd0, 0x65, 0x0a, 0x04,
+	0x5d, 0xa6, 0xa6, 0x92, 0xa6, 0xc8, 0x3c, 0x85, 0x8a, 0x0a, 0x4a, 0xfa, 0xaf, 0xf7, 0xda, 0x2b,
+	0xa1, 0x6b, 0x4d, 0xaa, 0xf5, 0x08, 0x81, 0x92, 0x6c, 0x67, 0xd1, 0xfc, 0x45, 0x2a, 0xbf, 0xc9,
+	0x8f, 0xa1, 0x39, 0x4e, 0xd0, 0x93, 0xbd, 0xe4, 0x7b, 0x5c, 0xf5, 0x7a, 0xbd, 0xd7, 0xe9, 0x2a,
+	0x42, 0xe8, 0xa6, 0x84, 0xd0, 0x3d, 0x4e, 0x09, 0x81, 0x36, 0xd2, 0x03, 0x07, 0x1e, 0x47, 0xb2,
+	0x0f, 0xdb, 0x78, 0x31, 0x0d, 0x12, 0xc3, 0x44, 0xf5, 0x4a, 0x13, 0x5b, 0x8b, 0x23, 0xd2, 0x48,
+	0x07, 0x6a, 0x21, 0x72, 0xcf, 0xf7, 0xb8, 0xd7, 0xae, 0xc9, 0x64, 0xb3, 0x35, 0x69, 0x43, 0xf5,
+	0x0d, 0x26, 0x2c, 0x88, 0xa3, 0xb6, 0x2d, 0x03, 0x4f, 0x97, 0x8e, 0x03, 0xb5, 0x14, 0x3a, 0xd1,
+	0x99, 0x83, 0xa3, 0x17, 0x83, 0xa3, 0x7e, 0xeb, 0x86, 0xf8, 0xa6, 0xfd, 0x97, 0xaf, 0x8e, 0xfb,
+	0x2d, 0xcb, 0xf9, 0xa7, 0x05, 0x30, 0x9c, 0x71, 0x8a, 0xaf, 0x67, 0xc8, 0xb8, 0x80, 0x60, 0xea,
+	0xf1, 0x73, 0x59, 0x0c, 0x9b, 0xca, 0x6f, 0xf2, 0x11, 0x54, 0x35, 0x72, 0xb2, 0x49, 0xea, 0x3d,
+	0xb2, 0x5a, 0x23, 0x9a, 0xaa, 0x88, 0xde, 0xdd, 0x1d, 0x0e, 0xe4, 0xbd, 0x53, 0x65, 0xa9, 0xec,
+	0x0e, 0x07, 0xe2, 0x9e, 0x3d, 0x86, 0x16, 0x5e, 0x4c, 0x71, 0x2c, 0xae, 0x59, 0x1a, 0x70, 0x49,
+	0x06, 0xbc, 0x9d, 0xca, 0x7f, 0xae, 0xc4, 0xc4, 0x81, 0x66, 0x70, 0xea, 0x46, 0x31, 0x77, 0xf1,
+	0x22, 0x60, 0x9c, 0xc9, 0x8a, 0xd4, 0x68, 0x3d, 0x38, 0x3d, 0x8a, 0x79, 0x5f, 0x8a, 0xc8, 0x3d,
+	0x80, 0x71, 0x1c, 0x71, 0x8c, 0xb8, 0xe8, 0xde, 0x8a, 0x8c, 0xd7, 0xd6, 0x92, 0x81, 0xef, 0xfc,
+	0x0a, 0xe0, 0x10, 0x2f, 0x4d, 0xcb, 0x08, 0xb4, 0x90, 0x0b, 0xf4, 0x21, 0x34, 0x53, 0x70, 0xdd,
+	0x38, 0x9a, 0xa8, 0x3c, 0x6a, 0xb4, 0x91, 0x0a, 0x5f, 0x45, 0x93, 0xb9, 0xf3, 0x0f, 0x0b, 0xea,
+	0x2f, 0x02, 0x96, 0x79, 0xd8, 0x81, 0xca, 0x34, 0xc1, 0xd3, 0xe0, 0x42, 0xfb, 0xd0, 0x2b, 0x71,
+	0x15, 0x24, 0xa7, 0xb8, 0xde, 0x69, 0x0a, 0xa0, 0x4d, 0x41, 0x8a, 0x76, 0x85, 0x44, 0xe4, 0x81,
+	0x91, 0xef, 0x9e, 0xe0, 0x69, 0x9c, 0x28, 0xc2, 0xb1, 0xa9, 0x8d, 0x91, 0xbf, 0x27, 0x05, 0xe4,
+	0x2e, 0xd8, 0x09, 0x8e, 0x67, 0x09, 0x0b, 0xde, 0xa8, 0x46, 0xae, 0xd1, 0x85, 0x40, 0x90, 0xff,
+	0x24, 0x08, 0x03, 0xae, 0xf9, 0x5a, 0x2d, 0x84, 0x49, 0x11, 0xab, 0x7b, 0x3a, 0xf1, 0xce, 0x98,
+	0x84, 0xa6, 0x4a, 0x6d, 0x21, 0xf9, 0x4c, 0x08, 0xcc, 0xc4, 0xab, 0x66, 0xe2, 0x4e, 0x13, 0xea,
+	0xb2, 0x15, 0xd8, 0x34, 0x8e, 0x18, 0x3a, 0xbf, 0x86, 0xfa, 0x21, 0x66, 0x4b, 0xb3, 0x0d, 0xac,
+	0xab, 0xdb, 0xe0, 0x21, 0x94, 0x05, 0x67, 0xb1, 0x76, 0x41, 0xf2, 0x46, 0xb3, 0x9b, 0xbe, 0x8f,
+	0x47, 0xb1, 0x8f, 0x54, 0xed, 0x39, 0x7f, 0xb5, 0xa0, 0xa1, 0x40, 0xd4, 0x3e, 0x7a, 0x50, 0x0e,
+	0x38, 0x86, 0xac, 0x6d, 0xc9, 0x53, 0x77, 0x0d, 0x0f, 0xa6, 0x5e, 0x77, 0xc0, 0x31, 0xa4, 0x4a,
+	0x55, 0xd4, 0x36, 0x14, 0xd0, 0x15, 0x24, 0x38, 0xf2, 0xbb, 0x83, 0x50, 0x12, 0x2a, 0xef, 0xa0,
+	0x9d, 0xef, 0x80, 0x1d, 0x30, 0x57, 0x97, 0x56, 0x35, 0x42, 0x2d, 0x60, 0x43, 0xb9, 0x76, 0x3e,
+	0x85, 0xe6, 0x01, 0x4e, 0x90, 0xe3, 0x75, 0xfa, 0xcc, 0x79, 0x06, 0x5b, 0xe9, 0x69, 0x9d, 0xbe,
+	0x03, 0x4d, 0xc5, 0xb6, 0x6e, 0x10, 0xb9, 0x33, 0xa6, 0x38, 0xb1, 0x46, 0xeb, 0x4a, 0x38, 0x88,
+	0x7e, 0xc6, 0xd0, 0xf9, 0x83, 0x05, 0xf5, 0xfd, 0x78, 0x3a, 0x4f, 0x5d, 0x8a, 0x06, 0x8b, 0x67,
+	0xc9, 0x18, 0x5d, 0xc3, 0x33, 0x28, 0xd1, 0x50, 0xf8, 0x7f, 0x0c, 0x2d, 0x1f, 0x19, 0x0f, 0x22,
+	0xc5, 0x40, 0x52, 0x4b, 0xb5, 0xe1, 0xb6, 0x21, 0x97, 0xaa, 0x26, 0xcd, 0x14, 0x97, 0x68, 0xc6,
+	0x48, 0xa3, 0x94, 0x4b, 0xe3, 0x53, 0x68, 0xa8, 0x78, 0xae, 0xd3, 0x27, 0x32, 0x9d, 0x97, 0xf1,
+	0x1b, 0xfc, 0x2a, 0xa5, 0xa3, 0xe2, 0xb9, 0x56, 0x3a, 0x7f, 0xb3, 0xc4, 0xdc, 0x77, 0x8a, 0x09,
+	0x46, 0xe3, 0x2c, 0xa7, 0x3c, 0x55, 0x59, 0x4b, 0x54, 0x95, 0x35, 0x4d, 0xc1, 0x68, 0x9a, 0xcb,
+	0x42, 0x5f, 0xf3, 0xa2, 0x94, 0xde, 0xfa, 0x45, 0x31, 0xf2, 0x2f, 0xe7, 0xf2, 0xdf, 0x85, 0x9b,
+	0x46, 0x02, 0xd7, 0x02, 0xe1, 0x39, 0xd4, 0xf7, 0x3c, 0x3e, 0x3e, 0xa7, 0xc8, 0x66, 0x13, 0x79,
+	0x29, 0xc6, 0xb1, 0x8f, 0x7a, 0xca, 0x91, 0xdf, 0xe2, 0xd1, 0x0a, 0x91, 0x31, 0xef, 0x0c, 0xd3,
+	0xc1, 0x43, 0x2f, 0x9d, 0xdf, 0xc0, 0xb6, 0x3c, 0x6c, 0xb0, 0xf7, 0x2d, 0x28, 0x0b, 0x50, 0x14,
+	0x2b, 0xd8, 0x54, 0x2d, 0x36, 0xf3, 0xf7, 0x0e, 0x54, 0x3c, 0x1e, 0x87, 0xc1, 0x58, 0xdf, 0x57,
+	0xbd, 0x5a, 0xe5, 0xf5, 0xd2, 0x1a, 0x5e, 0xff, 0x97, 0x05, 0xad, 0x85, 0x7f, 0x9d, 0xfe, 0xf7,
+	0xf2, 0xb4, 0xf4, 0xc0, 0x48, 0x7e, 0x59, 0xd7, 0xa4, 0xa6, 0xce, 0xef, 0x2d, 0xcd, 0x43, 0x5d,
+	0x31, 0x8b, 0x08, 0x30, 0x34, 0x7c, 0x3b, 0xcb, 0x16, 0x14, 0x54, 0x54, 0x6b, 0xbd, 0x25, 0x47,
+	0x65, 0x5c, 0x5b, 0xbc, 0x84, 0x6b, 0x67, 0x1a, 0x57, 0xe3, 0xb1, 0xff, 0x0e, 0xd4, 0x12, 0xf5,
+	0x99, 0x66, 0xf6, 0x35, 0xd3, 0x4d, 0xa6, 0x48, 0x33, 0xb5, 0xb7, 0x06, 0xdd, 0x39, 0xd0, 0x70,
+	0x1a, 0x0f, 0x0b, 0x79, 0x0a, 0x55, 0x95, 0x67, 0xea, 0x76, 0x13, 0x1c, 0xa9, 0x9a, 0xf3, 0x4b,
+	0x20, 0x52, 0x9e, 0x67, 0xdb, 0x77, 0xd3, 0x17, 0xce, 0x5f, 0x2c, 0x78, 0x2f, 0x67, 0x5d, 0x87,
+	0xf9, 0x71, 0xbe, 0xea, 0x0f, 0x97, 0x83, 0xcc, 0xab, 0xe7, 0x0a, 0xff, 0xf9, 0x35, 0xeb, 0xbe,
+	0xf2, 0x00, 0x14, 0x56, 0x1f, 0x80, 0x3f, 0xa6, 0x1d, 0x6a, 0xd2, 0x66, 0x6f, 0xa5, 0x94, 0xa6,
+	0x2b, 0x43, 0xf3, 0x7f, 0xa9, 0xa5, 0xbc, 0xb0, 0xd3, 0x74, 0xee, 0x91, 0xdf, 0xe4, 0xeb, 0xd0,
+	0xf0, 0x65, 0xbe, 0xae, 0x82, 0xbc, 0x24, 0x21, 0xaf, 0x2b, 0x99, 0x60, 0x5b, 0xe6, 0xec, 0xc3,
+	0x4d, 0x23, 0x2e, 0x0d, 0x62, 0x17, 0x6a, 0x3a, 0x8e, 0x34, 0xb0, 0x75, 0xad, 0x9c, 0xe9, 0x38,
+	0x3f, 0x01, 0x22, 0x5e, 0xfa, 0x11, 0x4f, 0xd0, 0x0b, 0xff, 0x9f, 0xb9, 0xc0, 0xf9, 0x2d, 0xbc,
+	0x27, 0xfa, 0x5f, 0x0f, 0xf9, 0x2c, 0x45, 0xca, 0xf8, 0x6d, 0x65, 0x99, 0xbf, 0xad, 0xae, 0x9e,
+	0xd4, 0xb2, 0x61, 0xab, 0x68, 0x0e, 0x5b, 0x1b, 0x1f, 0x92, 0x3f, 0x5b, 0x70, 0x2b, 0x1f, 0x80,
+	0x4e, 0xe6, 0x93, 0x7c, 0x32, 0xdf, 0x30, 0x92, 0x59, 0xa7, 0x7f, 0xe5, 0xb0, 0xf3, 0xf1, 0x25,
+	0xc3, 0xce, 0x3d, 0x80, 0xec, 0x67, 0xa6, 0x9a, 0xc5, 0xca, 0xd4, 0x4e, 0x7f, 0x67, 0xb2, 0xde,
+	0xbf, 0x2b, 0x60, 0xeb, 0x1a, 0x1c, 0xec, 0x91, 0x67, 0x50, 0x1c, 0xce, 0x38, 0x59, 0x4f, 0x02,
+	0x9d, 0x9d, 0x65, 0xb1, 0x4e, 0xe7, 0x19, 0x14, 0x0f, 0x31, 0x7f, 0xea, 0x10, 0xd7, 0x9e, 0x32,
+	0x29, 0xf5, 0xfb, 0x50, 0x12, 0x95, 0x23, 0x3b, 0x2b, 0xa5, 0x54, 0xe7, 0x6e, 0x6f, 0x28, 0x31,
+	0xf9, 0x21, 0x54, 0xd4, 0xc5, 0x23, 0xe6, 0x0f, 0xba, 0x1c, 0x31, 0x74, 0xde, 0x5f, 0xb3, 0xb3,
+	0xf0, 0x2b, 0xa6, 0x95, 0x9c, 0x5f, 0x63, 0x9c, 0xea, 0xdc, 0x5e, 0x91, 0x2f, 0x0e, 0x8a, 0xc6,
+	0x26, 0x1b, 0xee, 0x55, 0xe7, 0xf6, 0x8a, 0x5c, 0x1f, 0xfc, 0x0c, 0xec, 0xec, 0x41, 0x25, 0xf9,
+	0x9f, 0xe6, 0xf9, 0x39, 0xa1, 0x73, 0x77, 0xfd, 0xa6, 0xb6, 0xb3, 0x0f, 0xb5, 0xf4, 0xb1, 0x21,
+	0x9d, 0xb5, 0x2f, 0x90, 0xb2, 0x72, 0xe7, 0x92, 0xd7, 0x29, 0x33, 0x32, 0x9c, 0xad, 0x31, 0x32,
+	0x9c, 0x6d, 0x36, 0x62, 0x56, 0xfc, 0x85, 0x7e, 0xdf, 0x75, 0x1d, 0xee, 0x6d, 0x22, 0x46, 0x65,
+	0xea, 0x83, 0xcb, 0x79, 0x53, 0xe0, 0x93, 0xd1, 0x06, 0x59, 0xf1, 0x6b, 0x42, 0x7c, 0x77, 0xfd,
+	0xa6, 0xb6, 0xd3, 0x07, 0x58, 0x30, 0xc7, 0xc6, 0xbe, 0xba, 0xb7, 0x24, 0xcf, 0x13, 0xcd, 0x53,
+	0x8b, 0xbc, 0x82, 0x86, 0x79, 0x0b, 0xc9, 0x07, 0x1b, 0xaf, 0xa7, 0x32, 0x78, 0xff, 0x8a, 0xeb,
+	0xbb, 0x57, 0xfa, 0xbc, 0x30, 0x3d, 0x39, 0xa9, 0xc8, 0x99, 0xec, 0xbb, 0xff, 0x1d, 0x00, 0xda,
+	0xbb, 0xa4, 0x3d, 0x65, 0x14, 0x00, 0x00,
 }
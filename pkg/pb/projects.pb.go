@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: projects.proto
+
+package pb
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Project is a namespace of its own for the paths stored by its API keys
+type Project struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Project) Reset()         { *m = Project{} }
+func (m *Project) String() string { return proto.CompactTextString(m) }
+func (*Project) ProtoMessage()    {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{0}
+}
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Project.Unmarshal(m, b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Project.Marshal(b, m, deterministic)
+}
+func (dst *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(dst, src)
+}
+func (m *Project) XXX_Size() int {
+	return xxx_messageInfo_Project.Size(m)
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *Project) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Project) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Project) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+// APIKeyInfo is the root secret of an API key and the project it belongs to
+type APIKeyInfo struct {
+	Id                   []byte               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Secret               []byte               `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	ProjectId            string               `protobuf:"bytes,3,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Created              *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *APIKeyInfo) Reset()         { *m = APIKeyInfo{} }
+func (m *APIKeyInfo) String() string { return proto.CompactTextString(m) }
+func (*APIKeyInfo) ProtoMessage()    {}
+func (*APIKeyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{1}
+}
+func (m *APIKeyInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_APIKeyInfo.Unmarshal(m, b)
+}
+func (m *APIKeyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_APIKeyInfo.Marshal(b, m, deterministic)
+}
+func (dst *APIKeyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_APIKeyInfo.Merge(dst, src)
+}
+func (m *APIKeyInfo) XXX_Size() int {
+	return xxx_messageInfo_APIKeyInfo.Size(m)
+}
+func (m *APIKeyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_APIKeyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_APIKeyInfo proto.InternalMessageInfo
+
+func (m *APIKeyInfo) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *APIKeyInfo) GetSecret() []byte {
+	if m != nil {
+		return m.Secret
+	}
+	return nil
+}
+
+func (m *APIKeyInfo) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *APIKeyInfo) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+type CreateProjectRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,2,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectRequest) Reset()         { *m = CreateProjectRequest{} }
+func (m *CreateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectRequest) ProtoMessage()    {}
+func (*CreateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{2}
+}
+func (m *CreateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectRequest.Unmarshal(m, b)
+}
+func (m *CreateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectRequest.Merge(dst, src)
+}
+func (m *CreateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectRequest.Size(m)
+}
+func (m *CreateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectRequest proto.InternalMessageInfo
+
+func (m *CreateProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateProjectRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type CreateProjectResponse struct {
+	Project              *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateProjectResponse) Reset()         { *m = CreateProjectResponse{} }
+func (m *CreateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateProjectResponse) ProtoMessage()    {}
+func (*CreateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{3}
+}
+func (m *CreateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateProjectResponse.Unmarshal(m, b)
+}
+func (m *CreateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateProjectResponse.Merge(dst, src)
+}
+func (m *CreateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateProjectResponse.Size(m)
+}
+func (m *CreateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateProjectResponse proto.InternalMessageInfo
+
+func (m *CreateProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,2,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProjectRequest) Reset()         { *m = GetProjectRequest{} }
+func (m *GetProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*GetProjectRequest) ProtoMessage()    {}
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{4}
+}
+func (m *GetProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProjectRequest.Unmarshal(m, b)
+}
+func (m *GetProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProjectRequest.Merge(dst, src)
+}
+func (m *GetProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_GetProjectRequest.Size(m)
+}
+func (m *GetProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProjectRequest proto.InternalMessageInfo
+
+func (m *GetProjectRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetProjectRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type GetProjectResponse struct {
+	Project              *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetProjectResponse) Reset()         { *m = GetProjectResponse{} }
+func (m *GetProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*GetProjectResponse) ProtoMessage()    {}
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{5}
+}
+func (m *GetProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetProjectResponse.Unmarshal(m, b)
+}
+func (m *GetProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetProjectResponse.Merge(dst, src)
+}
+func (m *GetProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_GetProjectResponse.Size(m)
+}
+func (m *GetProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetProjectResponse proto.InternalMessageInfo
+
+func (m *GetProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+type ListProjectsRequest struct {
+	AdminKey             []byte   `protobuf:"bytes,1,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListProjectsRequest) Reset()         { *m = ListProjectsRequest{} }
+func (m *ListProjectsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsRequest) ProtoMessage()    {}
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{6}
+}
+func (m *ListProjectsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsRequest.Unmarshal(m, b)
+}
+func (m *ListProjectsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListProjectsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsRequest.Merge(dst, src)
+}
+func (m *ListProjectsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsRequest.Size(m)
+}
+func (m *ListProjectsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsRequest proto.InternalMessageInfo
+
+func (m *ListProjectsRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type ListProjectsResponse struct {
+	Projects             []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListProjectsResponse) Reset()         { *m = ListProjectsResponse{} }
+func (m *ListProjectsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListProjectsResponse) ProtoMessage()    {}
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{7}
+}
+func (m *ListProjectsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListProjectsResponse.Unmarshal(m, b)
+}
+func (m *ListProjectsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListProjectsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListProjectsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListProjectsResponse.Merge(dst, src)
+}
+func (m *ListProjectsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListProjectsResponse.Size(m)
+}
+func (m *ListProjectsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListProjectsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListProjectsResponse proto.InternalMessageInfo
+
+func (m *ListProjectsResponse) GetProjects() []*Project {
+	if m != nil {
+		return m.Projects
+	}
+	return nil
+}
+
+type UpdateProjectRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,3,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProjectRequest) Reset()         { *m = UpdateProjectRequest{} }
+func (m *UpdateProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectRequest) ProtoMessage()    {}
+func (*UpdateProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{8}
+}
+func (m *UpdateProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectRequest.Unmarshal(m, b)
+}
+func (m *UpdateProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectRequest.Merge(dst, src)
+}
+func (m *UpdateProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectRequest.Size(m)
+}
+func (m *UpdateProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectRequest proto.InternalMessageInfo
+
+func (m *UpdateProjectRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *UpdateProjectRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateProjectRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type UpdateProjectResponse struct {
+	Project              *Project `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateProjectResponse) Reset()         { *m = UpdateProjectResponse{} }
+func (m *UpdateProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateProjectResponse) ProtoMessage()    {}
+func (*UpdateProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{9}
+}
+func (m *UpdateProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateProjectResponse.Unmarshal(m, b)
+}
+func (m *UpdateProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateProjectResponse.Merge(dst, src)
+}
+func (m *UpdateProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateProjectResponse.Size(m)
+}
+func (m *UpdateProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateProjectResponse proto.InternalMessageInfo
+
+func (m *UpdateProjectResponse) GetProject() *Project {
+	if m != nil {
+		return m.Project
+	}
+	return nil
+}
+
+// DeleteProjectRequest deletes a project and revokes its API keys. The
+// paths stored in the project are not deleted.
+type DeleteProjectRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,2,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProjectRequest) Reset()         { *m = DeleteProjectRequest{} }
+func (m *DeleteProjectRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectRequest) ProtoMessage()    {}
+func (*DeleteProjectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{10}
+}
+func (m *DeleteProjectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectRequest.Unmarshal(m, b)
+}
+func (m *DeleteProjectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteProjectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectRequest.Merge(dst, src)
+}
+func (m *DeleteProjectRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectRequest.Size(m)
+}
+func (m *DeleteProjectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectRequest proto.InternalMessageInfo
+
+func (m *DeleteProjectRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeleteProjectRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type DeleteProjectResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteProjectResponse) Reset()         { *m = DeleteProjectResponse{} }
+func (m *DeleteProjectResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteProjectResponse) ProtoMessage()    {}
+func (*DeleteProjectResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{11}
+}
+func (m *DeleteProjectResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteProjectResponse.Unmarshal(m, b)
+}
+func (m *DeleteProjectResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteProjectResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteProjectResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteProjectResponse.Merge(dst, src)
+}
+func (m *DeleteProjectResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteProjectResponse.Size(m)
+}
+func (m *DeleteProjectResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteProjectResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteProjectResponse proto.InternalMessageInfo
+
+type CreateAPIKeyRequest struct {
+	ProjectId            string   `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,2,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyRequest) Reset()         { *m = CreateAPIKeyRequest{} }
+func (m *CreateAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyRequest) ProtoMessage()    {}
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{12}
+}
+func (m *CreateAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyRequest.Merge(dst, src)
+}
+func (m *CreateAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyRequest.Size(m)
+}
+func (m *CreateAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyRequest proto.InternalMessageInfo
+
+func (m *CreateAPIKeyRequest) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *CreateAPIKeyRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type CreateAPIKeyResponse struct {
+	ApiKey               string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	Id                   []byte   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAPIKeyResponse) Reset()         { *m = CreateAPIKeyResponse{} }
+func (m *CreateAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAPIKeyResponse) ProtoMessage()    {}
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{13}
+}
+func (m *CreateAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAPIKeyResponse.Merge(dst, src)
+}
+func (m *CreateAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAPIKeyResponse.Size(m)
+}
+func (m *CreateAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAPIKeyResponse proto.InternalMessageInfo
+
+func (m *CreateAPIKeyResponse) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *CreateAPIKeyResponse) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+// RevokeAPIKeyRequest revokes an API key and all keys restricted from it
+type RevokeAPIKeyRequest struct {
+	Id                   []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AdminKey             []byte   `protobuf:"bytes,2,opt,name=admin_key,json=adminKey,proto3" json:"admin_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyRequest) Reset()         { *m = RevokeAPIKeyRequest{} }
+func (m *RevokeAPIKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyRequest) ProtoMessage()    {}
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{14}
+}
+func (m *RevokeAPIKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAPIKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyRequest.Merge(dst, src)
+}
+func (m *RevokeAPIKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyRequest.Size(m)
+}
+func (m *RevokeAPIKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeAPIKeyRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *RevokeAPIKeyRequest) GetAdminKey() []byte {
+	if m != nil {
+		return m.AdminKey
+	}
+	return nil
+}
+
+type RevokeAPIKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAPIKeyResponse) Reset()         { *m = RevokeAPIKeyResponse{} }
+func (m *RevokeAPIKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeAPIKeyResponse) ProtoMessage()    {}
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2826043bb82544a, []int{15}
+}
+func (m *RevokeAPIKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeAPIKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeAPIKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAPIKeyResponse.Merge(dst, src)
+}
+func (m *RevokeAPIKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeAPIKeyResponse.Size(m)
+}
+func (m *RevokeAPIKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAPIKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAPIKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Project)(nil), "projects.Project")
+	proto.RegisterType((*APIKeyInfo)(nil), "projects.APIKeyInfo")
+	proto.RegisterType((*CreateProjectRequest)(nil), "projects.CreateProjectRequest")
+	proto.RegisterType((*CreateProjectResponse)(nil), "projects.CreateProjectResponse")
+	proto.RegisterType((*GetProjectRequest)(nil), "projects.GetProjectRequest")
+	proto.RegisterType((*GetProjectResponse)(nil), "projects.GetProjectResponse")
+	proto.RegisterType((*ListProjectsRequest)(nil), "projects.ListProjectsRequest")
+	proto.RegisterType((*ListProjectsResponse)(nil), "projects.ListProjectsResponse")
+	proto.RegisterType((*UpdateProjectRequest)(nil), "projects.UpdateProjectRequest")
+	proto.RegisterType((*UpdateProjectResponse)(nil), "projects.UpdateProjectResponse")
+	proto.RegisterType((*DeleteProjectRequest)(nil), "projects.DeleteProjectRequest")
+	proto.RegisterType((*DeleteProjectResponse)(nil), "projects.DeleteProjectResponse")
+	proto.RegisterType((*CreateAPIKeyRequest)(nil), "projects.CreateAPIKeyRequest")
+	proto.RegisterType((*CreateAPIKeyResponse)(nil), "projects.CreateAPIKeyResponse")
+	proto.RegisterType((*RevokeAPIKeyRequest)(nil), "projects.RevokeAPIKeyRequest")
+	proto.RegisterType((*RevokeAPIKeyResponse)(nil), "projects.RevokeAPIKeyResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProjectsClient is the client API for Projects service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProjectsClient interface {
+	Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error)
+	Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error)
+	List(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
+	Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error)
+	Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+}
+
+type projectsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewProjectsClient(cc *grpc.ClientConn) ProjectsClient {
+	return &projectsClient{cc}
+}
+
+func (c *projectsClient) Create(ctx context.Context, in *CreateProjectRequest, opts ...grpc.CallOption) (*CreateProjectResponse, error) {
+	out := new(CreateProjectResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) Get(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*GetProjectResponse, error) {
+	out := new(GetProjectResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) List(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
+	out := new(ListProjectsResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) Update(ctx context.Context, in *UpdateProjectRequest, opts ...grpc.CallOption) (*UpdateProjectResponse, error) {
+	out := new(UpdateProjectResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) Delete(ctx context.Context, in *DeleteProjectRequest, opts ...grpc.CallOption) (*DeleteProjectResponse, error) {
+	out := new(DeleteProjectResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/CreateAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *projectsClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, "/projects.Projects/RevokeAPIKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectsServer is the server API for Projects service.
+type ProjectsServer interface {
+	Create(context.Context, *CreateProjectRequest) (*CreateProjectResponse, error)
+	Get(context.Context, *GetProjectRequest) (*GetProjectResponse, error)
+	List(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
+	Update(context.Context, *UpdateProjectRequest) (*UpdateProjectResponse, error)
+	Delete(context.Context, *DeleteProjectRequest) (*DeleteProjectResponse, error)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+}
+
+func RegisterProjectsServer(s *grpc.Server, srv ProjectsServer) {
+	s.RegisterService(&_Projects_serviceDesc, srv)
+}
+
+func _Projects_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).Create(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).Get(ctx, req.(*GetProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).List(ctx, req.(*ListProjectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).Update(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).Delete(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/CreateAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Projects_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProjectsServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/projects.Projects/RevokeAPIKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProjectsServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Projects_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "projects.Projects",
+	HandlerType: (*ProjectsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Projects_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _Projects_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Projects_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _Projects_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Projects_Delete_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _Projects_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _Projects_RevokeAPIKey_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "projects.proto",
+}
+
+func init() { proto.RegisterFile("projects.proto", fileDescriptor_a2826043bb82544a) }
+
+var fileDescriptor_a2826043bb82544a = []byte{
+	// 539 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xdd, 0x6b, 0x9b, 0x50,
+	0x14, 0x47, 0x23, 0x26, 0x9e, 0x86, 0x42, 0x6f, 0x6c, 0x1b, 0xec, 0x9a, 0x04, 0x9f, 0x0a, 0x63,
+	0x16, 0xb2, 0xbd, 0xaf, 0x9f, 0x04, 0xc9, 0x06, 0x9d, 0x6c, 0x0c, 0xf6, 0x52, 0x4c, 0x3c, 0x2d,
+	0xae, 0x4d, 0x74, 0xd1, 0x0e, 0xf2, 0x27, 0xec, 0x75, 0x7f, 0xf1, 0xd8, 0xfd, 0xd0, 0xeb, 0x8d,
+	0xa6, 0xa5, 0x6f, 0x7a, 0xce, 0xc9, 0xef, 0x23, 0xe7, 0x77, 0x84, 0xdd, 0x74, 0x95, 0xfc, 0xc4,
+	0x79, 0x9e, 0x79, 0xe9, 0x2a, 0xc9, 0x13, 0xd2, 0x11, 0xef, 0xce, 0xf0, 0x3e, 0x49, 0xee, 0x1f,
+	0xf1, 0x94, 0xd6, 0x67, 0x4f, 0x77, 0xa7, 0x79, 0xbc, 0xc0, 0x2c, 0x0f, 0x17, 0x29, 0x1b, 0x75,
+	0xe7, 0xd0, 0xbe, 0x61, 0xc3, 0x64, 0x17, 0xf4, 0x38, 0xea, 0x6b, 0x23, 0xed, 0xc4, 0x0a, 0xf4,
+	0x38, 0x22, 0x04, 0x8c, 0x65, 0xb8, 0xc0, 0xbe, 0x4e, 0x2b, 0xf4, 0x99, 0x7c, 0x80, 0xf6, 0x7c,
+	0x85, 0x61, 0x8e, 0x51, 0xbf, 0x35, 0xd2, 0x4e, 0x76, 0xc6, 0x8e, 0xc7, 0x18, 0x3c, 0xc1, 0xe0,
+	0x7d, 0x15, 0x0c, 0x81, 0x18, 0x75, 0xff, 0x68, 0x00, 0xe7, 0x37, 0xfe, 0x14, 0xd7, 0xfe, 0xf2,
+	0x2e, 0x91, 0x88, 0xba, 0x94, 0xe8, 0x00, 0xcc, 0x0c, 0xe7, 0x2b, 0xcc, 0x29, 0x55, 0x37, 0xe0,
+	0x6f, 0xe4, 0x18, 0x80, 0x1b, 0xb9, 0x8d, 0x19, 0x9f, 0x15, 0x58, 0xbc, 0xe2, 0x47, 0xb2, 0x16,
+	0xe3, 0xe5, 0x5a, 0x26, 0x60, 0x5f, 0xd2, 0x47, 0x6e, 0x3b, 0xc0, 0x5f, 0x4f, 0x98, 0xe5, 0x85,
+	0x5b, 0x4d, 0x72, 0x7b, 0x04, 0x56, 0x18, 0x2d, 0xe2, 0xe5, 0xed, 0x03, 0xae, 0xb9, 0xb6, 0x0e,
+	0x2d, 0x4c, 0x71, 0xed, 0x5e, 0xc1, 0xbe, 0x02, 0x94, 0xa5, 0xc9, 0x32, 0x43, 0xf2, 0x16, 0xda,
+	0x5c, 0x24, 0x05, 0xdb, 0x19, 0xef, 0x79, 0xc5, 0x7e, 0xc4, 0xac, 0x98, 0x70, 0xcf, 0x60, 0x6f,
+	0x82, 0xb9, 0xa2, 0x45, 0xdd, 0xc4, 0x56, 0x1d, 0xe7, 0x40, 0x64, 0x84, 0xd7, 0x88, 0x18, 0x43,
+	0xef, 0x53, 0x9c, 0x09, 0x8c, 0x4c, 0xc8, 0xa8, 0xd0, 0x6a, 0x0a, 0xed, 0x35, 0xd8, 0xd5, 0xdf,
+	0x70, 0xe2, 0x77, 0x50, 0xa4, 0xaf, 0xaf, 0x8d, 0x5a, 0xf5, 0xcc, 0xc5, 0x88, 0xfb, 0x1d, 0xec,
+	0x6f, 0x69, 0xb4, 0xb9, 0x8e, 0x97, 0x84, 0xb1, 0xa2, 0xaf, 0xb5, 0xb9, 0x1e, 0x05, 0xf8, 0x35,
+	0xff, 0xcc, 0x25, 0xd8, 0x57, 0xf8, 0x88, 0xcf, 0xca, 0xdb, 0xba, 0xa1, 0x43, 0xd8, 0x57, 0x40,
+	0x98, 0x14, 0xf7, 0x0b, 0xf4, 0x58, 0x84, 0xd8, 0x71, 0x08, 0xf0, 0x6a, 0xee, 0x35, 0x35, 0xf7,
+	0x5b, 0xb9, 0x3e, 0x82, 0x5d, 0x85, 0xe4, 0xae, 0x0f, 0xa1, 0x1d, 0xa6, 0x71, 0xb1, 0x49, 0x2b,
+	0x30, 0xc3, 0x34, 0x9e, 0xe2, 0x9a, 0x3b, 0xd1, 0xc5, 0x31, 0xba, 0x17, 0xd0, 0x0b, 0xf0, 0x77,
+	0xf2, 0xa0, 0x68, 0x52, 0x6f, 0x76, 0xab, 0x88, 0x03, 0xb0, 0xab, 0x18, 0x4c, 0xc4, 0xf8, 0xaf,
+	0x01, 0x1d, 0x11, 0x18, 0xe2, 0x83, 0xc9, 0x94, 0x92, 0x41, 0xb9, 0x80, 0xba, 0xd3, 0x74, 0x86,
+	0x8d, 0x7d, 0x6e, 0xee, 0x0c, 0x5a, 0x13, 0xcc, 0xc9, 0x51, 0x39, 0xb7, 0x71, 0x53, 0xce, 0x9b,
+	0xfa, 0x26, 0x47, 0xb8, 0x06, 0xe3, 0x7f, 0x9a, 0xc9, 0x71, 0x39, 0x55, 0x73, 0x11, 0xce, 0xa0,
+	0xa9, 0xcd, 0x61, 0x7c, 0x30, 0x59, 0xe8, 0x64, 0x4f, 0x75, 0xf9, 0x76, 0x86, 0x8d, 0xfd, 0x12,
+	0x8a, 0x85, 0x46, 0x86, 0xaa, 0xcb, 0xa2, 0x33, 0x6c, 0xec, 0x73, 0xa8, 0xcf, 0xd0, 0x95, 0x33,
+	0x21, 0x9b, 0xac, 0x89, 0x9f, 0x33, 0x68, 0x6a, 0x97, 0x70, 0xf2, 0x76, 0x65, 0xb8, 0x9a, 0xe4,
+	0x38, 0x83, 0xa6, 0x36, 0x83, 0xbb, 0x30, 0x7e, 0xe8, 0xe9, 0x6c, 0x66, 0xd2, 0x6f, 0xf6, 0xfb,
+	0x7f, 0x03, 0x00, 0xff, 0x5e, 0x9b, 0xb3, 0xcb, 0x06, 0x00, 0x00,
+}
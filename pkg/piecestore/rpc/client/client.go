@@ -42,6 +42,7 @@ type PSClient interface {
 	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation) error
 	Get(ctx context.Context, id PieceID, size int64, ba *pb.PayerBandwidthAllocation) (ranger.Ranger, error)
 	Delete(ctx context.Context, pieceID PieceID) error
+	BatchDelete(ctx context.Context, pieceIDs []PieceID) (failed []PieceID, err error)
 	Stats(ctx context.Context) (*pb.StatSummary, error)
 	io.Closer
 }
@@ -161,6 +162,26 @@ func (client *Client) Delete(ctx context.Context, id PieceID) error {
 	return nil
 }
 
+// BatchDelete deletes many pieces from a piece store Server at once and
+// returns the IDs of the pieces that could not be deleted
+func (client *Client) BatchDelete(ctx context.Context, ids []PieceID) (failed []PieceID, err error) {
+	req := &pb.PieceBatchDelete{Ids: make([]string, 0, len(ids))}
+	for _, id := range ids {
+		req.Ids = append(req.Ids, id.String())
+	}
+
+	reply, err := client.route.BatchDelete(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("Route summary : %v", reply)
+
+	for _, id := range reply.GetFailedIds() {
+		failed = append(failed, PieceID(id))
+	}
+	return failed, nil
+}
+
 // Stats will retrieve stats about a piece storage node
 func (client *Client) Stats(ctx context.Context) (*pb.StatSummary, error) {
 	return client.route.Stats(ctx, &pb.StatsReq{})
@@ -201,6 +201,23 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 	return &pb.PieceDeleteSummary{Message: OK}, nil
 }
 
+// BatchDelete -- Delete many pieces at once, returning the IDs of the pieces
+// that could not be deleted
+func (s *Server) BatchDelete(ctx context.Context, in *pb.PieceBatchDelete) (*pb.PieceBatchDeleteSummary, error) {
+	log.Printf("Deleting %d pieces...", len(in.GetIds()))
+
+	var failed []string
+	for _, id := range in.GetIds() {
+		if err := s.deleteByID(id); err != nil {
+			log.Printf("Failed deleting %s: %v", id, err)
+			failed = append(failed, id)
+		}
+	}
+
+	log.Printf("Successfully deleted %d pieces.", len(in.GetIds())-len(failed))
+	return &pb.PieceBatchDeleteSummary{Message: OK, FailedIds: failed}, nil
+}
+
 func (s *Server) deleteByID(id string) error {
 	if err := pstore.Delete(id, s.DataDir); err != nil {
 		return err
@@ -478,6 +478,34 @@ func TestDelete(t *testing.T) {
 	}
 }
 
+func TestBatchDelete(t *testing.T) {
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	assert := assert.New(t)
+
+	ids := []string{"11111111111111111111", "33333333333333333333"}
+	for _, id := range ids {
+		// simulate piece stored with storagenode
+		if err := writeFileToDir(id, TS.s.DataDir); err != nil {
+			t.Fatalf("Error: %v\nCould not create test piece", err)
+		}
+	}
+
+	req := &pb.PieceBatchDelete{Ids: append(ids, "123", "22222222222222222223")}
+	resp, err := TS.c.BatchDelete(ctx, req)
+	assert.NoError(err)
+	assert.Equal("OK", resp.GetMessage())
+	assert.Equal([]string{"123"}, resp.GetFailedIds())
+
+	for _, id := range ids {
+		filePath, err := pstore.PathByID(id, TS.s.DataDir)
+		assert.NoError(err)
+		_, err = os.Stat(filePath)
+		assert.True(os.IsNotExist(err), "file %s not deleted", id)
+	}
+}
+
 func newTestServerStruct(t *testing.T) (*Server, func()) {
 	tmp, err := ioutil.TempDir("", "storj-piecestore")
 	if err != nil {
@@ -6,6 +6,7 @@ package audit
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"math/big"
 	"sync"
 
@@ -37,21 +38,35 @@ type Stripe struct {
 	Index int
 }
 
+// auditWindow is the number of pointers a stripe is chosen from, before the
+// next stripe is chosen from the pointers after them
+const auditWindow = 1000
+
+// errWindowFull stops the listing once the audit window is full
+var errWindowFull = errors.New("audit window full")
+
 // NextStripe returns a random stripe to be audited
 func (audit *Audit) NextStripe(ctx context.Context) (stripe *Stripe, more bool, err error) {
 	audit.mutex.Lock()
 	defer audit.mutex.Unlock()
 
-	var pointerItems []pdbclient.ListItem
-	var path paths.Path
-
-	if audit.lastPath == nil {
-		pointerItems, more, err = audit.pointers.List(ctx, nil, nil, nil, true, 0, meta.None)
-	} else {
-		pointerItems, more, err = audit.pointers.List(ctx, nil, *audit.lastPath, nil, true, 0, meta.None)
+	var startAfter paths.Path
+	if audit.lastPath != nil {
+		startAfter = *audit.lastPath
 	}
 
-	if err != nil {
+	// the pointers are streamed with their remote segments, so the chosen
+	// pointer need not be looked up again
+	var pointerItems []pdbclient.ListItem
+	err = audit.pointers.ListStream(ctx, nil, startAfter, true, meta.Size|meta.Remote, func(item pdbclient.ListItem) error {
+		if len(pointerItems) == auditWindow {
+			more = true
+			return errWindowFull
+		}
+		pointerItems = append(pointerItems, item)
+		return nil
+	})
+	if err != nil && err != errWindowFull {
 		return nil, more, err
 	}
 
@@ -61,9 +76,6 @@ func (audit *Audit) NextStripe(ctx context.Context) (stripe *Stripe, more bool,
 		return nil, more, err
 	}
 
-	// get path
-	path = pointerItem.Path
-
 	// keep track of last path listed
 	if !more {
 		audit.lastPath = nil
@@ -71,13 +83,8 @@ func (audit *Audit) NextStripe(ctx context.Context) (stripe *Stripe, more bool,
 		audit.lastPath = &pointerItems[len(pointerItems)-1].Path
 	}
 
-	// get pointer info
-	pointer, err := audit.pointers.Get(ctx, path)
-	if err != nil {
-		return nil, more, err
-	}
-
 	// create the erasure scheme so we can get the stripe size
+	pointer := pointerItem.Pointer
 	es, err := makeErasureScheme(pointer.GetRemote().GetRedundancy())
 	if err != nil {
 		return nil, more, err
@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/rand"
 	"errors"
+	"io"
 	"math"
 	"math/big"
 	"reflect"
@@ -58,6 +59,75 @@ func (pbd *pointerDBWrapper) Delete(ctx context.Context, in *pb.DeleteRequest, o
 	return pbd.s.Delete(ctx, in)
 }
 
+func (pbd *pointerDBWrapper) Copy(ctx context.Context, in *pb.CopyRequest, opts ...grpc.CallOption) (*pb.CopyResponse, error) {
+	return pbd.s.Copy(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) Move(ctx context.Context, in *pb.MoveRequest, opts ...grpc.CallOption) (*pb.MoveResponse, error) {
+	return pbd.s.Move(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) Reference(ctx context.Context, in *pb.ReferenceRequest, opts ...grpc.CallOption) (*pb.ReferenceResponse, error) {
+	return pbd.s.Reference(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) BatchGet(ctx context.Context, in *pb.BatchGetRequest, opts ...grpc.CallOption) (*pb.BatchGetResponse, error) {
+	return pbd.s.BatchGet(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) BatchPut(ctx context.Context, in *pb.BatchPutRequest, opts ...grpc.CallOption) (*pb.BatchPutResponse, error) {
+	return pbd.s.BatchPut(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) BatchDelete(ctx context.Context, in *pb.BatchDeleteRequest, opts ...grpc.CallOption) (*pb.BatchDeleteResponse, error) {
+	return pbd.s.BatchDelete(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) BatchMove(ctx context.Context, in *pb.BatchMoveRequest, opts ...grpc.CallOption) (*pb.BatchMoveResponse, error) {
+	return pbd.s.BatchMove(ctx, in)
+}
+
+func (pbd *pointerDBWrapper) ListStream(ctx context.Context, in *pb.ListRequest, opts ...grpc.CallOption) (pb.PointerDB_ListStreamClient, error) {
+	server := &listStreamServer{ctx: ctx}
+	if err := pbd.s.ListStream(in, server); err != nil {
+		return nil, err
+	}
+	return &listStreamClient{responses: server.responses}, nil
+}
+
+func (pbd *pointerDBWrapper) NodeSegments(ctx context.Context, in *pb.NodeSegmentsRequest, opts ...grpc.CallOption) (*pb.NodeSegmentsResponse, error) {
+	return pbd.s.NodeSegments(ctx, in)
+}
+
+// listStreamServer collects the responses the server streams
+type listStreamServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*pb.ListStreamResponse
+}
+
+func (stream *listStreamServer) Context() context.Context { return stream.ctx }
+
+func (stream *listStreamServer) Send(resp *pb.ListStreamResponse) error {
+	stream.responses = append(stream.responses, resp)
+	return nil
+}
+
+// listStreamClient replays the collected responses to the client
+type listStreamClient struct {
+	grpc.ClientStream
+	responses []*pb.ListStreamResponse
+}
+
+func (stream *listStreamClient) Recv() (*pb.ListStreamResponse, error) {
+	if len(stream.responses) == 0 {
+		return nil, io.EOF
+	}
+	resp := stream.responses[0]
+	stream.responses = stream.responses[1:]
+	return resp, nil
+}
+
 func newPointerDBWrapper(pdbs pb.PointerDBServer) pb.PointerDBClient {
 	return &pointerDBWrapper{pdbs}
 }
@@ -0,0 +1,114 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auth
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage/boltdb"
+)
+
+const (
+	// SecretBucket is the string representing the bucket used for the root
+	// secrets of the API keys
+	SecretBucket = "secrets"
+)
+
+// Config is a configuration struct that is everything you need to start an
+// API key responsibility, which keeps the projects and the root secrets of
+// their API keys for the responsibilities started after it
+type Config struct {
+	DatabaseURL string `help:"the database connection string of the projects and API key root secrets, or empty to accept only the single shared api key" default:""`
+	AdminKey    string `help:"the key required by the project admin RPCs, or empty to disable them" default:""`
+}
+
+// CtxKey used for assigning the key store
+type CtxKey int
+
+const (
+	ctxKeyKeyStore CtxKey = iota
+)
+
+// Run implements the provider.Responsibility interface
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if c.DatabaseURL == "" {
+		return server.Run(ctx)
+	}
+
+	keys, err := c.Open()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = keys.Close() }()
+	zap.S().Info("Checking macaroon API keys")
+
+	if c.AdminKey != "" {
+		pb.RegisterProjectsServer(server.GRPC(), NewProjectServer(keys, []byte(c.AdminKey), zap.L()))
+	}
+
+	return server.Run(context.WithValue(ctx, ctxKeyKeyStore, keys))
+}
+
+// Open opens the configured key store, e.g. to manage the API keys while
+// the satellite is not running
+func (c Config) Open() (*KeyStore, error) {
+	dburl, err := utils.ParseURL(c.DatabaseURL)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dburl.Scheme != "bolt" {
+		return nil, Error.New("unsupported db scheme: %s", dburl.Scheme)
+	}
+
+	bdb, err := boltdb.New(dburl.Path, SecretBucket)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return NewKeyStore(bdb), nil
+}
+
+// LoadFromContext gives access to the key store from the context, or returns
+// nil
+func LoadFromContext(ctx context.Context) *KeyStore {
+	if v, ok := ctx.Value(ctxKeyKeyStore).(*KeyStore); ok {
+		return v
+	}
+	return nil
+}
+
+// Authorize authorizes a request with the API key. Without a key store, the
+// API key must be the shared API key, which allows all actions and has no
+// project.
+func Authorize(ctx context.Context, keys *KeyStore, apiKey []byte) (*Authorization, error) {
+	if keys == nil {
+		if !ValidateAPIKey(string(apiKey)) {
+			return nil, macaroon.ErrUnauthorized.New("invalid api key")
+		}
+		return &Authorization{}, nil
+	}
+	return keys.Authorize(ctx, apiKey)
+}
+
+// Check authorizes a request with the API key and returns an error unless
+// the API key allows all actions, see Authorize
+func Check(ctx context.Context, keys *KeyStore, apiKey []byte, actions ...macaroon.Action) (*Authorization, error) {
+	authorization, err := Authorize(ctx, keys, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		if err = authorization.Check(action); err != nil {
+			return nil, err
+		}
+	}
+	return authorization, nil
+}
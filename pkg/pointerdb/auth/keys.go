@@ -0,0 +1,280 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+var (
+	mon = monkit.Package()
+	// Error is the errs class of standard auth errors
+	Error = errs.Class("auth error")
+	// ErrNotFound is the errs class of unknown projects and API keys
+	ErrNotFound = errs.Class("not found")
+)
+
+const (
+	// keyPrefix is the prefix of the root secrets of the API keys, stored as
+	// keys/<hex id> = APIKeyInfo
+	keyPrefix = "keys/"
+	// projectPrefix is the prefix of the projects, stored as
+	// projects/<id> = Project
+	projectPrefix = "projects/"
+)
+
+// KeyStore keeps the projects and the root secrets of their API keys
+type KeyStore struct {
+	db storage.KeyValueStore
+}
+
+// NewKeyStore creates a key store keeping the projects and root secrets in
+// db
+func NewKeyStore(db storage.KeyValueStore) *KeyStore {
+	return &KeyStore{db: db}
+}
+
+// CreateProject creates a new project with a random ID
+func (k *KeyStore) CreateProject(ctx context.Context, name string) (project *pb.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	project = &pb.Project{
+		Id:      hex.EncodeToString(id),
+		Name:    name,
+		Created: ptypes.TimestampNow(),
+	}
+	if err = k.put(storage.Key(projectPrefix+project.Id), project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// GetProject returns the project with the given ID
+func (k *KeyStore) GetProject(ctx context.Context, id string) (project *pb.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project = &pb.Project{}
+	if err = k.get(storage.Key(projectPrefix+id), project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// ListProjects returns all projects
+func (k *KeyStore) ListProjects(ctx context.Context) (projects []*pb.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = k.db.Iterate(storage.IterateOptions{
+		Prefix:  storage.Key(projectPrefix),
+		Recurse: true,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			project := &pb.Project{}
+			if err := proto.Unmarshal(item.Value, project); err != nil {
+				return err
+			}
+			projects = append(projects, project)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return projects, nil
+}
+
+// UpdateProject renames the project with the given ID
+func (k *KeyStore) UpdateProject(ctx context.Context, id, name string) (project *pb.Project, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	project, err = k.GetProject(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	project.Name = name
+	if err = k.put(storage.Key(projectPrefix+id), project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// DeleteProject deletes the project with the given ID and revokes all of
+// its API keys. The pointers stored in the project are kept.
+func (k *KeyStore) DeleteProject(ctx context.Context, id string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err = k.GetProject(ctx, id); err != nil {
+		return err
+	}
+
+	var revoked []storage.Key
+	err = k.db.Iterate(storage.IterateOptions{
+		Prefix:  storage.Key(keyPrefix),
+		Recurse: true,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			info := &pb.APIKeyInfo{}
+			if err := proto.Unmarshal(item.Value, info); err != nil {
+				return err
+			}
+			if info.ProjectId == id {
+				revoked = append(revoked, storage.CloneKey(item.Key))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	// the keys are revoked first, so a failed deletion can be retried
+	for _, key := range revoked {
+		if err = k.db.Delete(key); err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return Error.Wrap(k.db.Delete(storage.Key(projectPrefix + id)))
+}
+
+// Create stores a new root secret for the project and returns the
+// unrestricted API key for it
+func (k *KeyStore) Create(ctx context.Context, projectID string) (key *macaroon.APIKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err = k.GetProject(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	secret, err := macaroon.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+	key, err = macaroon.NewAPIKey(secret)
+	if err != nil {
+		return nil, err
+	}
+	info := &pb.APIKeyInfo{
+		Id:        key.ID(),
+		Secret:    secret,
+		ProjectId: projectID,
+		Created:   ptypes.TimestampNow(),
+	}
+	if err = k.put(secretKey(key.ID()), info); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// Revoke deletes the root secret with the given ID, which invalidates all
+// API keys derived from it
+func (k *KeyStore) Revoke(ctx context.Context, id []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = k.get(secretKey(id), &pb.APIKeyInfo{}); err != nil {
+		return err
+	}
+	return Error.Wrap(k.db.Delete(secretKey(id)))
+}
+
+// Authorization is an authorized API key of a request
+type Authorization struct {
+	// ProjectID is the project of the API key, or empty for the shared API
+	// key
+	ProjectID string
+
+	key    *macaroon.APIKey
+	secret []byte
+}
+
+// Check returns an ErrUnauthorized error unless the API key allows the
+// action. The shared API key allows all actions.
+func (a *Authorization) Check(action macaroon.Action) error {
+	if a.key == nil {
+		return nil
+	}
+	if action.Time.IsZero() {
+		action.Time = time.Now()
+	}
+	return a.key.Check(a.secret, action)
+}
+
+// Authorize looks up the root secret and the project of the serialized API
+// key, or returns an ErrUnauthorized error if the API key is invalid
+func (k *KeyStore) Authorize(ctx context.Context, apiKey []byte) (authorization *Authorization, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	key, err := macaroon.ParseAPIKey(string(apiKey))
+	if err != nil {
+		return nil, macaroon.ErrUnauthorized.Wrap(err)
+	}
+	info := &pb.APIKeyInfo{}
+	if err = k.get(secretKey(key.ID()), info); err != nil {
+		if ErrNotFound.Has(err) {
+			return nil, macaroon.ErrUnauthorized.New("unknown api key")
+		}
+		return nil, err
+	}
+	if err = key.Validate(info.Secret); err != nil {
+		return nil, err
+	}
+
+	return &Authorization{ProjectID: info.ProjectId, key: key, secret: info.Secret}, nil
+}
+
+// KeyID returns the ID of the root secret of the serialized API key, which
+// is shared by all keys restricted from the same key. Keys that are not
+// macaroons are their own ID.
+func KeyID(apiKey []byte) []byte {
+	key, err := macaroon.ParseAPIKey(string(apiKey))
+	if err != nil {
+		return apiKey
+	}
+	return key.ID()
+}
+
+// Close closes the database of the key store
+func (k *KeyStore) Close() error {
+	return k.db.Close()
+}
+
+func (k *KeyStore) get(key storage.Key, msg proto.Message) error {
+	value, err := k.db.Get(key)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return ErrNotFound.New("%s", key)
+		}
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(proto.Unmarshal(value, msg))
+}
+
+func (k *KeyStore) put(key storage.Key, msg proto.Message) error {
+	value, err := proto.Marshal(msg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	return Error.Wrap(k.db.Put(key, value))
+}
+
+func secretKey(id []byte) storage.Key {
+	return storage.Key(keyPrefix + hex.EncodeToString(id))
+}
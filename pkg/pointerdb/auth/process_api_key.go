@@ -20,9 +20,9 @@ var (
 // Default api key is preset with the mocked headers. This will be changed later.
 //
 // **Where this is going**:
-// We're going to be using macaroons to validate a token and permissions. This is a small step to building in that direction.
+// Macaroon API keys are checked against the root secrets of a KeyStore instead, if one is configured.
 
-// ValidateAPIKey : validates the X-API-Key header to an env/flag input
+// ValidateAPIKey : validates the X-API-Key header to an env/flag input, if no KeyStore is configured
 func ValidateAPIKey(header string) bool {
 	var expected = []byte(*apiKey)
 	var actual = []byte(header)
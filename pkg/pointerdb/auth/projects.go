@@ -0,0 +1,158 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+)
+
+// ProjectServer implements the project admin RPC service
+type ProjectServer struct {
+	keys     *KeyStore
+	adminKey []byte
+	logger   *zap.Logger
+}
+
+// NewProjectServer creates a project admin server managing the projects of
+// the key store. Every request must carry adminKey.
+func NewProjectServer(keys *KeyStore, adminKey []byte, logger *zap.Logger) *ProjectServer {
+	return &ProjectServer{keys: keys, adminKey: adminKey, logger: logger}
+}
+
+func (s *ProjectServer) validateAdminKey(key []byte) error {
+	if len(s.adminKey) == 0 || subtle.ConstantTimeCompare(s.adminKey, key) != 1 {
+		s.logger.Error("unauthorized project admin request")
+		return status.Error(codes.Unauthenticated, "Invalid admin credential")
+	}
+	return nil
+}
+
+// toStatus converts a key store error to a gRPC status error
+func (s *ProjectServer) toStatus(err error) error {
+	if ErrNotFound.Has(err) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	s.logger.Error("err managing projects", zap.Error(err))
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Create creates a new project
+func (s *ProjectServer) Create(ctx context.Context, req *pb.CreateProjectRequest) (resp *pb.CreateProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project name missing")
+	}
+
+	project, err := s.keys.CreateProject(ctx, req.GetName())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.CreateProjectResponse{Project: project}, nil
+}
+
+// Get returns the project with the requested ID
+func (s *ProjectServer) Get(ctx context.Context, req *pb.GetProjectRequest) (resp *pb.GetProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+
+	project, err := s.keys.GetProject(ctx, req.GetId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.GetProjectResponse{Project: project}, nil
+}
+
+// List returns all projects
+func (s *ProjectServer) List(ctx context.Context, req *pb.ListProjectsRequest) (resp *pb.ListProjectsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+
+	projects, err := s.keys.ListProjects(ctx)
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.ListProjectsResponse{Projects: projects}, nil
+}
+
+// Update renames the project with the requested ID
+func (s *ProjectServer) Update(ctx context.Context, req *pb.UpdateProjectRequest) (resp *pb.UpdateProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "project name missing")
+	}
+
+	project, err := s.keys.UpdateProject(ctx, req.GetId(), req.GetName())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.UpdateProjectResponse{Project: project}, nil
+}
+
+// Delete deletes the project with the requested ID and revokes its API keys
+func (s *ProjectServer) Delete(ctx context.Context, req *pb.DeleteProjectRequest) (resp *pb.DeleteProjectResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+
+	if err = s.keys.DeleteProject(ctx, req.GetId()); err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.DeleteProjectResponse{}, nil
+}
+
+// CreateAPIKey creates a new unrestricted API key for the requested project
+func (s *ProjectServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (resp *pb.CreateAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+
+	key, err := s.keys.Create(ctx, req.GetProjectId())
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	serialized, err := key.Serialize()
+	if err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.CreateAPIKeyResponse{ApiKey: serialized, Id: key.ID()}, nil
+}
+
+// RevokeAPIKey revokes the API key with the requested ID
+func (s *ProjectServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (resp *pb.RevokeAPIKeyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err = s.validateAdminKey(req.GetAdminKey()); err != nil {
+		return nil, err
+	}
+
+	if err = s.keys.Revoke(ctx, req.GetId()); err != nil {
+		return nil, s.toStatus(err)
+	}
+	return &pb.RevokeAPIKeyResponse{}, nil
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestProjectServer(t *testing.T) {
+	ctx := context.Background()
+	keys := NewKeyStore(teststore.New())
+	s := NewProjectServer(keys, []byte("admin"), zap.NewNop())
+	adminKey := []byte("admin")
+
+	_, err := s.Create(ctx, &pb.CreateProjectRequest{Name: "project", AdminKey: []byte("wrong")})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	createResp, err := s.Create(ctx, &pb.CreateProjectRequest{Name: "project", AdminKey: adminKey})
+	if !assert.NoError(t, err) {
+		return
+	}
+	id := createResp.Project.Id
+
+	updateResp, err := s.Update(ctx, &pb.UpdateProjectRequest{Id: id, Name: "renamed", AdminKey: adminKey})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "renamed", updateResp.Project.Name)
+	}
+	getResp, err := s.Get(ctx, &pb.GetProjectRequest{Id: id, AdminKey: adminKey})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "renamed", getResp.Project.Name)
+	}
+	listResp, err := s.List(ctx, &pb.ListProjectsRequest{AdminKey: adminKey})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Projects, 1)
+	}
+
+	_, err = s.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{ProjectId: "unknown", AdminKey: adminKey})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	keyResp, err := s.CreateAPIKey(ctx, &pb.CreateAPIKeyRequest{ProjectId: id, AdminKey: adminKey})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	authorization, err := keys.Authorize(ctx, []byte(keyResp.ApiKey))
+	if assert.NoError(t, err) {
+		assert.Equal(t, id, authorization.ProjectID)
+	}
+
+	// deleting the project revokes its API keys
+	_, err = s.Delete(ctx, &pb.DeleteProjectRequest{Id: id, AdminKey: adminKey})
+	assert.NoError(t, err)
+	_, err = keys.Authorize(ctx, []byte(keyResp.ApiKey))
+	assert.Error(t, err)
+	_, err = s.RevokeAPIKey(ctx, &pb.RevokeAPIKeyRequest{Id: keyResp.Id, AdminKey: adminKey})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.Get(ctx, &pb.GetProjectRequest{Id: id, AdminKey: adminKey})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,363 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
+	"storj.io/storj/storage"
+)
+
+// validateBatch checks that a batch request has no more items than a single
+// request may touch
+func validateBatch(items int) error {
+	if items > storage.LookupLimit {
+		return status.Errorf(codes.InvalidArgument, "batch of %d items exceeds the limit of %d", items, storage.LookupLimit)
+	}
+	return nil
+}
+
+// checkAuth checks that the already authorized API key of a batch request
+// allows all actions of one of its items
+func (s *Server) checkAuth(authorization *auth.Authorization, actions ...macaroon.Action) error {
+	for _, action := range actions {
+		if err := authorization.Check(action); err != nil {
+			s.logger.Error("unauthorized request: ", zap.Error(err))
+			return status.Error(codes.Unauthenticated, "Invalid API credential")
+		}
+	}
+	return nil
+}
+
+// batchResult returns the result of an item of a batch request that failed
+// with err, or succeeded if err is nil
+func batchResult(err error) *pb.BatchResult {
+	if err == nil {
+		return &pb.BatchResult{Code: int32(codes.OK)}
+	}
+	st := status.Convert(err)
+	return &pb.BatchResult{Code: int32(st.Code()), Message: st.Message()}
+}
+
+// batchError returns the error of an atomic batch request whose i-th item
+// failed with err
+func batchError(i int, err error) error {
+	st := status.Convert(err)
+	return status.Errorf(st.Code(), "item %d: %s", i, st.Message())
+}
+
+// BatchGet gets the pointers at the requested paths. Unless the request is
+// atomic, the items fail independently of each other.
+func (s *Server) BatchGet(ctx context.Context, req *pb.BatchGetRequest) (resp *pb.BatchGetResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb batch get")
+
+	if err = validateBatch(len(req.GetPaths())); err != nil {
+		return nil, err
+	}
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pb.BatchGetResponse_Item, len(req.GetPaths()))
+	for i, path := range req.GetPaths() {
+		getResp, err := s.batchGetItem(ctx, authorization, path, req.GetMetadataOnly())
+		if err != nil && req.GetAtomic() {
+			return nil, batchError(i, err)
+		}
+		items[i] = &pb.BatchGetResponse_Item{Result: batchResult(err)}
+		if err == nil {
+			items[i].Pointer = getResp.Pointer
+			items[i].Nodes = getResp.Nodes
+		}
+	}
+	return &pb.BatchGetResponse{Items: items}, nil
+}
+
+func (s *Server) batchGetItem(ctx context.Context, authorization *auth.Authorization, path string, metadataOnly bool) (*pb.GetResponse, error) {
+	if err := s.checkAuth(authorization, pathAction(macaroon.OpRead, path)); err != nil {
+		return nil, err
+	}
+	if err := s.validatePath(path); err != nil {
+		return nil, err
+	}
+	return s.get(ctx, projectPath(authorization.ProjectID, path), metadataOnly)
+}
+
+// BatchPut stores the pointers of the requests in their order, with the
+// same conditions as Put. An atomic request stores all pointers at once, or
+// none of them if any of them fails.
+func (s *Server) BatchPut(ctx context.Context, req *pb.BatchPutRequest) (resp *pb.BatchPutResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb batch put")
+
+	if err = validateBatch(len(req.GetRequests())); err != nil {
+		return nil, err
+	}
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey())
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range req.GetRequests() {
+		item.APIKey = req.GetAPIKey()
+	}
+
+	if req.GetAtomic() {
+		if err = s.batchPutAtomic(authorization, req.GetRequests()); err != nil {
+			return nil, err
+		}
+		results := make([]*pb.BatchResult, len(req.GetRequests()))
+		for i := range results {
+			results[i] = batchResult(nil)
+		}
+		return &pb.BatchPutResponse{Results: results}, nil
+	}
+
+	results := make([]*pb.BatchResult, len(req.GetRequests()))
+	for i, item := range req.GetRequests() {
+		results[i] = batchResult(s.batchPutItem(authorization, item))
+	}
+	return &pb.BatchPutResponse{Results: results}, nil
+}
+
+// checkPutItem checks a put request of a batch and returns the key of its
+// pointer
+func (s *Server) checkPutItem(authorization *auth.Authorization, req *pb.PutRequest) (path string, err error) {
+	if err = s.validateSegment(req); err != nil {
+		return "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = s.checkAuth(authorization, pathAction(macaroon.OpWrite, req.GetPath())); err != nil {
+		return "", err
+	}
+	if err = s.validatePath(req.GetPath()); err != nil {
+		return "", err
+	}
+	return projectPath(authorization.ProjectID, req.GetPath()), nil
+}
+
+func (s *Server) batchPutItem(authorization *auth.Authorization, req *pb.PutRequest) error {
+	path, err := s.checkPutItem(authorization, req)
+	if err != nil {
+		return err
+	}
+	return s.update(func(t *txn) error {
+		old, err := s.preparePut(t, path, req)
+		if err != nil {
+			return err
+		}
+		return s.finishPut(t, path, old, req)
+	})
+}
+
+// batchPutAtomic checks and stores all pointers of the put requests in a
+// single transaction
+func (s *Server) batchPutAtomic(authorization *auth.Authorization, reqs []*pb.PutRequest) error {
+	paths := make([]string, len(reqs))
+	seen := map[string]bool{}
+	for i, req := range reqs {
+		path, err := s.checkPutItem(authorization, req)
+		if err != nil {
+			return batchError(i, err)
+		}
+		if seen[path] {
+			return batchError(i, status.Error(codes.InvalidArgument, "duplicate path"))
+		}
+		seen[path] = true
+		paths[i] = path
+	}
+
+	return s.update(func(t *txn) error {
+		for i, path := range paths {
+			old, err := s.preparePut(t, path, reqs[i])
+			if err == nil {
+				err = s.finishPut(t, path, old, reqs[i])
+			}
+			if err != nil {
+				return batchError(i, err)
+			}
+		}
+		return nil
+	})
+}
+
+// BatchDelete deletes the pointers at the requested paths in their order,
+// like Delete. An atomic request deletes all pointers at once, or none of
+// them if any of them fails.
+func (s *Server) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (resp *pb.BatchDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb batch delete")
+
+	if err = validateBatch(len(req.GetPaths())); err != nil {
+		return nil, err
+	}
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey())
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*pb.BatchDeleteResponse_Item, len(req.GetPaths()))
+	if req.GetAtomic() {
+		inUse, err := s.batchDeleteAtomic(authorization, req.GetPaths())
+		if err != nil {
+			return nil, err
+		}
+		for i := range items {
+			items[i] = &pb.BatchDeleteResponse_Item{Result: batchResult(nil), PiecesInUse: inUse[i]}
+		}
+		return &pb.BatchDeleteResponse{Items: items}, nil
+	}
+
+	for i, path := range req.GetPaths() {
+		inUse, err := s.batchDeleteItem(authorization, path)
+		items[i] = &pb.BatchDeleteResponse_Item{Result: batchResult(err), PiecesInUse: inUse}
+	}
+	return &pb.BatchDeleteResponse{Items: items}, nil
+}
+
+// checkDeleteItem checks a path of a batch delete request and returns the
+// key of its pointer
+func (s *Server) checkDeleteItem(authorization *auth.Authorization, path string) (string, error) {
+	if err := s.checkAuth(authorization, pathAction(macaroon.OpDelete, path)); err != nil {
+		return "", err
+	}
+	if err := s.validatePath(path); err != nil {
+		return "", err
+	}
+	return projectPath(authorization.ProjectID, path), nil
+}
+
+func (s *Server) batchDeleteItem(authorization *auth.Authorization, path string) (inUse bool, err error) {
+	path, err = s.checkDeleteItem(authorization, path)
+	if err != nil {
+		return false, err
+	}
+	err = s.update(func(t *txn) (err error) {
+		inUse, err = s.deletePointer(t, path)
+		return err
+	})
+	return inUse, err
+}
+
+// batchDeleteAtomic checks and deletes all pointers at the paths in a single
+// transaction
+func (s *Server) batchDeleteAtomic(authorization *auth.Authorization, reqPaths []string) (inUse []bool, err error) {
+	paths := make([]string, len(reqPaths))
+	seen := map[string]bool{}
+	for i, reqPath := range reqPaths {
+		path, err := s.checkDeleteItem(authorization, reqPath)
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+		if seen[path] {
+			return nil, batchError(i, status.Error(codes.InvalidArgument, "duplicate path"))
+		}
+		seen[path] = true
+		paths[i] = path
+	}
+
+	inUse = make([]bool, len(paths))
+	err = s.update(func(t *txn) (err error) {
+		for i, path := range paths {
+			inUse[i], err = s.deletePointer(t, path)
+			if err != nil {
+				return batchError(i, err)
+			}
+		}
+		return nil
+	})
+	return inUse, err
+}
+
+// BatchMove moves the pointers of the requests in their order like Move, or
+// copies them like Copy, after deleting the pointers at the delete paths.
+// Either all or none of the pointers are changed.
+func (s *Server) BatchMove(ctx context.Context, req *pb.BatchMoveRequest) (resp *pb.BatchMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb batch move")
+
+	if err = validateBatch(len(req.GetRequests()) + len(req.GetDeletePaths())); err != nil {
+		return nil, err
+	}
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey())
+	if err != nil {
+		return nil, err
+	}
+
+	deletePaths := make([]string, len(req.GetDeletePaths()))
+	for i, path := range req.GetDeletePaths() {
+		deletePaths[i], err = s.checkDeleteItem(authorization, path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	srcPaths := make([]string, len(req.GetRequests()))
+	dstPaths := make([]string, len(req.GetRequests()))
+	for i, item := range req.GetRequests() {
+		srcPaths[i], dstPaths[i], err = s.checkMoveItem(authorization, item, req.GetCopy())
+		if err != nil {
+			return nil, batchError(i, err)
+		}
+	}
+
+	pointers := make([]*pb.Pointer, len(req.GetRequests()))
+	err = s.update(func(t *txn) error {
+		for _, path := range deletePaths {
+			_, err := s.deletePointer(t, path)
+			if err != nil && status.Code(err) != codes.NotFound {
+				return err
+			}
+		}
+		for i, item := range req.GetRequests() {
+			var err error
+			if req.GetCopy() {
+				pointers[i], err = s.getLivePointer(t, srcPaths[i])
+				if err == nil {
+					pointers[i].Metadata = item.GetMetadata()
+					err = s.putShared(t, dstPaths[i], &pb.PutRequest{Pointer: pointers[i]})
+				}
+			} else {
+				pointers[i], err = s.movePointer(t, srcPaths[i], dstPaths[i], item.GetMetadata())
+			}
+			if err != nil {
+				return batchError(i, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.BatchMoveResponse{Pointers: pointers}, nil
+}
+
+// checkMoveItem checks a move or copy request of a batch and returns the
+// keys of its source and destination pointers
+func (s *Server) checkMoveItem(authorization *auth.Authorization, req *pb.MoveRequest, copy bool) (srcPath, dstPath string, err error) {
+	actions := []macaroon.Action{
+		pathAction(macaroon.OpRead, req.GetSourcePath()),
+		pathAction(macaroon.OpWrite, req.GetDestinationPath()),
+	}
+	if !copy {
+		actions = append(actions, pathAction(macaroon.OpDelete, req.GetSourcePath()))
+	}
+	if err = s.checkAuth(authorization, actions...); err != nil {
+		return "", "", err
+	}
+	if err = s.validatePath(req.GetSourcePath()); err != nil {
+		return "", "", err
+	}
+	if err = s.validatePath(req.GetDestinationPath()); err != nil {
+		return "", "", err
+	}
+	return projectPath(authorization.ProjectID, req.GetSourcePath()),
+		projectPath(authorization.ProjectID, req.GetDestinationPath()), nil
+}
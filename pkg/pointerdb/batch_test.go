@@ -0,0 +1,163 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+// failingStore fails the changes of the key failPut
+type failingStore struct {
+	storage.KeyValueStore
+	failPut string
+}
+
+func (store *failingStore) CompareAndSwap(changes ...storage.Change) error {
+	for _, change := range changes {
+		if change.Key.String() == store.failPut {
+			return errors.New("put error")
+		}
+	}
+	return store.KeyValueStore.CompareAndSwap(changes...)
+}
+
+func remotePointer(pieceID string) *pb.Pointer {
+	return &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{PieceId: pieceID}}
+}
+
+func TestServiceBatch(t *testing.T) {
+	db := &failingStore{KeyValueStore: teststore.New()}
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: remotePointer("c")})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the items of a non-atomic batch fail independently
+	putResp, err := s.BatchPut(ctx, &pb.BatchPutRequest{Requests: []*pb.PutRequest{
+		{Path: "a/b/c", Pointer: remotePointer("c2"), IfNotExists: true},
+		{Path: "a/b/d", Pointer: remotePointer("d")},
+		{Path: "exp/b/e", Pointer: remotePointer("e")},
+	}})
+	if assert.NoError(t, err) && assert.Len(t, putResp.Results, 3) {
+		assert.Equal(t, int32(codes.FailedPrecondition), putResp.Results[0].Code)
+		assert.Equal(t, int32(codes.OK), putResp.Results[1].Code)
+		assert.Equal(t, int32(codes.InvalidArgument), putResp.Results[2].Code)
+	}
+
+	getResp, err := s.BatchGet(ctx, &pb.BatchGetRequest{Paths: []string{"a/b/c", "a/b/d", "a/b/e"}})
+	if assert.NoError(t, err) && assert.Len(t, getResp.Items, 3) {
+		assert.Equal(t, "c", getResp.Items[0].Pointer.Remote.PieceId)
+		assert.Equal(t, "d", getResp.Items[1].Pointer.Remote.PieceId)
+		assert.Equal(t, int32(codes.NotFound), getResp.Items[2].Result.Code)
+		assert.Nil(t, getResp.Items[2].Pointer)
+	}
+	_, err = s.BatchGet(ctx, &pb.BatchGetRequest{Paths: []string{"a/b/c", "a/b/e"}, Atomic: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// an atomic batch stores nothing if any item fails its checks
+	_, err = s.BatchPut(ctx, &pb.BatchPutRequest{Atomic: true, Requests: []*pb.PutRequest{
+		{Path: "a/b/e", Pointer: remotePointer("e")},
+		{Path: "a/b/c", Pointer: remotePointer("c2"), IfNotExists: true},
+	}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = s.BatchPut(ctx, &pb.BatchPutRequest{Atomic: true, Requests: []*pb.PutRequest{
+		{Path: "a/b/e", Pointer: remotePointer("e")},
+		{Path: "a/b/e", Pointer: remotePointer("e2")},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/b/e"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// no pointer is stored if the store fails to store any of them
+	db.failPut = "a/b/e"
+	_, err = s.BatchPut(ctx, &pb.BatchPutRequest{Atomic: true, Requests: []*pb.PutRequest{
+		{Path: "a/b/c", Pointer: remotePointer("c2")},
+		{Path: "a/b/f", Pointer: remotePointer("f")},
+		{Path: "a/b/e", Pointer: remotePointer("e")},
+	}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	db.failPut = ""
+
+	getResp, err = s.BatchGet(ctx, &pb.BatchGetRequest{Paths: []string{"a/b/c", "a/b/f"}})
+	if assert.NoError(t, err) && assert.Len(t, getResp.Items, 2) {
+		assert.Equal(t, "c", getResp.Items[0].Pointer.Remote.PieceId)
+		assert.Equal(t, int32(codes.NotFound), getResp.Items[1].Result.Code)
+	}
+
+	// an atomic delete deletes nothing if any path is missing
+	_, err = s.BatchDelete(ctx, &pb.BatchDeleteRequest{Paths: []string{"a/b/c", "a/b/e"}, Atomic: true})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	deleteResp, err := s.BatchDelete(ctx, &pb.BatchDeleteRequest{Paths: []string{"a/b/c", "a/b/d", "a/b/e"}})
+	if assert.NoError(t, err) && assert.Len(t, deleteResp.Items, 3) {
+		assert.Equal(t, int32(codes.OK), deleteResp.Items[0].Result.Code)
+		assert.Equal(t, int32(codes.OK), deleteResp.Items[1].Result.Code)
+		assert.Equal(t, int32(codes.NotFound), deleteResp.Items[2].Result.Code)
+	}
+
+	listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 0)
+	}
+
+	// batches are limited like lookups
+	_, err = s.BatchGet(ctx, &pb.BatchGetRequest{Paths: make([]string, storage.LookupLimit+1)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceBatchMove(t *testing.T) {
+	db := &failingStore{KeyValueStore: teststore.New()}
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	for _, path := range []string{"a/src/0", "a/src/1", "a/dst/0", "a/dst/1", "a/dst/2"} {
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: remotePointer(path)})
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	moves := []*pb.MoveRequest{
+		{SourcePath: "a/src/0", DestinationPath: "a/dst/0", Metadata: []byte("0")},
+		{SourcePath: "a/src/1", DestinationPath: "a/dst/1", Metadata: []byte("1")},
+	}
+	deletePaths := []string{"a/dst/0", "a/dst/1", "a/dst/2", "a/dst/3"}
+
+	// nothing is changed if any pointer fails to move
+	_, err := s.BatchMove(ctx, &pb.BatchMoveRequest{Requests: append(moves,
+		&pb.MoveRequest{SourcePath: "a/src/2", DestinationPath: "a/dst/2"}), DeletePaths: deletePaths})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	db.failPut = "a/dst/1"
+	_, err = s.BatchMove(ctx, &pb.BatchMoveRequest{Requests: moves, DeletePaths: deletePaths})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	db.failPut = ""
+
+	listResp, err := s.List(ctx, &pb.ListRequest{Prefix: "a", Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 5)
+	}
+
+	resp, err := s.BatchMove(ctx, &pb.BatchMoveRequest{Requests: moves, DeletePaths: deletePaths})
+	if assert.NoError(t, err) && assert.Len(t, resp.Pointers, 2) {
+		assert.Equal(t, "a/src/0", resp.Pointers[0].Remote.PieceId)
+		assert.Equal(t, []byte("1"), resp.Pointers[1].Metadata)
+	}
+
+	listResp, err = s.List(ctx, &pb.ListRequest{Prefix: "a", Recursive: true})
+	if assert.NoError(t, err) && assert.Len(t, listResp.Items, 2) {
+		assert.Equal(t, "dst/0", listResp.Items[0].Path)
+		assert.Equal(t, "dst/1", listResp.Items[1].Path)
+	}
+}
@@ -5,14 +5,20 @@ package pointerdb
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/rpc/client"
+	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/transport"
 	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
+	"storj.io/storj/storage/sqlkv"
 	"storj.io/storj/storage/storelogger"
 )
 
@@ -21,34 +27,102 @@ const (
 	PointerBucket = "pointers"
 )
 
+// CtxKey used for assigning the pointer store
+type CtxKey int
+
+const (
+	ctxKeyPointerDB CtxKey = iota
+)
+
 // Config is a configuration struct that is everything you need to start a
 // PointerDB responsibility
 type Config struct {
-	DatabaseURL          string `help:"the database connection string to use" default:"bolt://$CONFDIR/pointerdb.db"`
-	MinRemoteSegmentSize int    `default:"1240" help:"minimum remote segment size"`
-	MaxInlineSegmentSize int    `default:"8000" help:"maximum inline segment size"`
-	Overlay              bool   `default:"false" help:"toggle flag if overlay is enabled"`
+	DatabaseURL          string        `help:"the database connection string to use, bolt://, postgres:// or sqlite://" default:"bolt://$CONFDIR/pointerdb.db"`
+	MinRemoteSegmentSize int           `default:"1240" help:"minimum remote segment size"`
+	MaxInlineSegmentSize int           `default:"8000" help:"maximum inline segment size"`
+	Overlay              bool          `default:"false" help:"toggle flag if overlay is enabled"`
+	ReaperInterval       time.Duration `default:"1h" help:"how frequently expired pointers are deleted, or 0 to never delete them"`
+	ReaperBatchSize      int           `default:"1000" help:"number of expired pointers deleted at once"`
+	DeletionInterval     time.Duration `default:"10m" help:"how frequently the queued pieces are deleted from the storage nodes, or 0 to never delete them"`
+	DeletionBatchSize    int           `default:"1000" help:"number of queued piece deletions processed at once"`
+	DeletionMaxAttempts  int           `default:"144" help:"number of attempts to delete a piece from a storage node before giving up"`
 }
 
 // Run implements the provider.Responsibility interface
 func (c Config) Run(ctx context.Context, server *provider.Provider) error {
-	dburl, err := utils.ParseURL(c.DatabaseURL)
+	db, err := c.Open()
 	if err != nil {
 		return err
 	}
-	if dburl.Scheme != "bolt" {
-		return Error.New("unsupported db scheme: %s", dburl.Scheme)
+	defer func() { _ = db.Close() }()
+
+	cache := overlay.LoadFromContext(ctx)
+	dblogged := storelogger.New(zap.L(), db)
+	s := NewServer(dblogged, cache, zap.L(), c)
+	s.keys = auth.LoadFromContext(ctx)
+	pb.RegisterPointerDBServer(server.GRPC(), s)
+
+	if c.ReaperInterval > 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		go s.runReaper(ctx, c.ReaperInterval, c.ReaperBatchSize)
 	}
 
-	bdb, err := boltdb.New(dburl.Path, PointerBucket)
-	if err != nil {
-		return err
+	if c.DeletionInterval > 0 {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		deleter := &pieceDeleter{
+			s:           s,
+			dial:        nodeDialer(cache, server.Identity()),
+			maxAttempts: c.DeletionMaxAttempts,
+		}
+		go deleter.run(ctx, c.DeletionInterval, c.DeletionBatchSize)
 	}
-	defer func() { _ = bdb.Close() }()
 
-	cache := overlay.LoadFromContext(ctx)
-	bdblogged := storelogger.New(zap.L(), bdb)
-	pb.RegisterPointerDBServer(server.GRPC(), NewServer(bdblogged, cache, zap.L(), c))
+	return server.Run(context.WithValue(ctx, ctxKeyPointerDB, dblogged))
+}
+
+// LoadFromContext gives access to the pointer store from the context, or
+// returns nil
+func LoadFromContext(ctx context.Context) storage.KeyValueStore {
+	if v, ok := ctx.Value(ctxKeyPointerDB).(storage.KeyValueStore); ok {
+		return v
+	}
+	return nil
+}
 
-	return server.Run(ctx)
+// Open opens the configured pointer database, e.g. to rebuild its indexes
+// while the satellite is not running. Unlike bolt, a SQL database can be
+// shared by several satellite processes: the pointers and their piece
+// references are changed with atomic compare-and-swaps of the store.
+func (c Config) Open() (storage.KeyValueStore, error) {
+	dburl, err := utils.ParseURL(c.DatabaseURL)
+	if err != nil {
+		return nil, err
+	}
+	switch dburl.Scheme {
+	case "bolt":
+		return boltdb.New(dburl.Path, PointerBucket)
+	case "postgres", "postgresql", "sqlite":
+		return sqlkv.NewFromURL(c.DatabaseURL, PointerBucket)
+	default:
+		return nil, Error.New("unsupported db scheme: %s", dburl.Scheme)
+	}
+}
+
+// nodeDialer returns a function connecting to the piece store of the node
+// with the given ID, which is looked up in the overlay cache
+func nodeDialer(cache *overlay.Cache, identity *provider.FullIdentity) func(ctx context.Context, nodeID string) (client.PSClient, error) {
+	t := transport.NewClient(identity)
+	return func(ctx context.Context, nodeID string) (client.PSClient, error) {
+		node, err := cache.Get(ctx, nodeID)
+		if err != nil {
+			return nil, err
+		}
+		conn, err := t.DialNode(ctx, node)
+		if err != nil {
+			return nil, err
+		}
+		return client.NewPSClient(conn, 0, identity.Key)
+	}
 }
@@ -0,0 +1,125 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
+	"storj.io/storj/storage"
+)
+
+// contentPrefix is the reserved key prefix of the content index. For every
+// API key, or root secret of macaroon API keys, it maps the content IDs of
+// the uploaded segments to their pieces and the path of the pointer they
+// were uploaded with, as
+// content/<key id hash>/<content id> = <piece id>/<path>. The index does not
+// hold a reference to the pieces, so its entries go stale when the pointer is
+// deleted or replaced.
+const contentPrefix = "content/"
+
+func contentKey(keyID []byte, contentID string) storage.Key {
+	hash := sha256.Sum256(keyID)
+	return storage.Key(contentPrefix + hex.EncodeToString(hash[:16]) + "/" + contentID)
+}
+
+// indexContent records the pieces of the remote segment at path under its
+// content ID
+func indexContent(t *txn, apiKey []byte, contentID, path string, pointer *pb.Pointer) error {
+	if contentID == "" || pointer.GetRemote() == nil {
+		return nil
+	}
+	return t.Put(contentKey(auth.KeyID(apiKey), contentID), storage.Value(pointer.Remote.PieceId+"/"+path))
+}
+
+// Reference stores a pointer at the requested path that shares the pieces of
+// an earlier segment uploaded with the same content ID and API key, so the
+// data does not need to be uploaded again. It returns NotFound if there is no
+// such segment whose pieces are kept at least as long as the new pointer.
+// The pointer stored at the requested path is replaced like by Put.
+func (s *Server) Reference(ctx context.Context, req *pb.ReferenceRequest) (resp *pb.ReferenceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb reference")
+
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpWrite, req.GetPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetPath()); err != nil {
+		return nil, err
+	}
+	path := projectPath(authorization.ProjectID, req.GetPath())
+
+	if req.GetContentId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "content ID missing")
+	}
+
+	key := contentKey(auth.KeyID(req.GetAPIKey()), req.GetContentId())
+	var pointer *pb.Pointer
+	var srcPath string
+	var stale storage.Value
+	err = s.update(func(t *txn) error {
+		stale = nil
+		value, err := t.Get(key)
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return status.Error(codes.NotFound, "content not found")
+			}
+			s.logger.Error("err getting content", zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+		parts := strings.SplitN(string(value), "/", 2)
+		if len(parts) != 2 {
+			return status.Error(codes.Internal, "invalid content index entry")
+		}
+		var pieceID string
+		pieceID, srcPath = parts[0], parts[1]
+
+		// the referenced pointer must not be released before it is
+		// referenced, which the transaction ensures by reading it
+		pointer, err = s.getLivePointer(t, srcPath)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if pointer == nil || pointer.GetRemote().GetPieceId() != pieceID {
+			// the segment was deleted or replaced
+			stale = value
+			return status.Error(codes.NotFound, "content not found")
+		}
+
+		// the pieces expire on the storage nodes with the first pointer
+		expiration := expirationDate(&pb.Pointer{ExpirationDate: req.GetExpirationDate()})
+		srcExpiration := expirationDate(pointer)
+		if !srcExpiration.IsZero() && (expiration.IsZero() || expiration.After(srcExpiration)) {
+			return status.Error(codes.NotFound, "content expires too early")
+		}
+
+		pointer.Metadata = req.GetMetadata()
+		pointer.ExpirationDate = req.GetExpirationDate()
+		return s.putShared(t, path, &pb.PutRequest{Pointer: pointer})
+	})
+	if stale != nil {
+		// the entry is only dropped if it was not indexed again meanwhile
+		err := s.DB.CompareAndSwap(storage.Change{Key: key, Delete: true, Check: true, Old: stale})
+		if err != nil && !storage.ErrValueChanged.Has(err) {
+			s.logger.Error("err deleting stale content", zap.Error(err))
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("referenced content from path: " + srcPath + " at path: " + req.GetPath())
+	return &pb.ReferenceResponse{Pointer: pointer}, nil
+}
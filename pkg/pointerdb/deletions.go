@@ -0,0 +1,228 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/rpc/client"
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage"
+)
+
+// deletionPrefix is the reserved key prefix of the durable queue of pieces
+// that are no longer referenced by any pointer and must be deleted from the
+// storage nodes. There is a task for every node holding a piece, with the key
+// del/<nodeID>/<pieceID>, so the pieces of a node are next to each other and
+// can be deleted in batches. The value is the number of failed attempts.
+const deletionPrefix = "del/"
+
+// PieceDeletion is a task of the piece deletion queue
+type PieceDeletion struct {
+	NodeID   string
+	PieceID  string
+	Attempts int
+}
+
+func deletionKey(nodeID, pieceID string) storage.Key {
+	return storage.Key(fmt.Sprintf("%s%s/%s", deletionPrefix, nodeID, pieceID))
+}
+
+// parseDeletion returns the piece deletion task stored in the queue
+func parseDeletion(item storage.ListItem) (PieceDeletion, error) {
+	parts := strings.SplitN(strings.TrimPrefix(item.Key.String(), deletionPrefix), "/", 2)
+	if len(parts) != 2 {
+		return PieceDeletion{}, Error.New("invalid piece deletion key %q", item.Key)
+	}
+	attempts, err := strconv.Atoi(string(item.Value))
+	if err != nil {
+		return PieceDeletion{}, Error.Wrap(err)
+	}
+	return PieceDeletion{NodeID: parts[0], PieceID: parts[1], Attempts: attempts}, nil
+}
+
+// queuePieceDeletion adds a task for every node holding the pieces of the
+// remote segment to the deletion queue
+func queuePieceDeletion(t *txn, remote *pb.RemoteSegment) error {
+	for _, piece := range remote.GetRemotePieces() {
+		if piece.GetNodeId() == "" {
+			continue
+		}
+		err := t.Put(deletionKey(piece.NodeId, remote.PieceId), storage.Value("0"))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PieceDeletions returns up to limit tasks of the deletion queue, starting
+// after the given key
+func (s *Server) PieceDeletions(ctx context.Context, after storage.Key, limit int) (deletions []PieceDeletion, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, _, err := storage.ListV2(s.DB, storage.ListOptions{
+		Prefix:       storage.Key(deletionPrefix),
+		StartAfter:   after,
+		Recursive:    true,
+		IncludeValue: true,
+		Limit:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		deletion, err := parseDeletion(item)
+		if err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, deletion)
+	}
+	return deletions, nil
+}
+
+// pieceDeleter drains the piece deletion queue of a pointerdb server, deleting
+// the pieces of each node with a single call
+type pieceDeleter struct {
+	s           *Server
+	dial        func(ctx context.Context, nodeID string) (client.PSClient, error)
+	maxAttempts int
+
+	// after is the key of the last task processed by the previous batch, so
+	// every task gets its turn even if the tasks at the head keep failing
+	after storage.Key
+}
+
+// deleteBatch processes up to limit tasks of the deletion queue and returns
+// how many it processed. Tasks that fail are kept in the queue and retried by
+// a later batch, until they failed maxAttempts times.
+func (d *pieceDeleter) deleteBatch(ctx context.Context, limit int) (processed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	deletions, err := d.s.PieceDeletions(ctx, d.after, limit)
+	if err != nil {
+		return 0, err
+	}
+	if len(deletions) < limit {
+		d.after = nil
+	} else {
+		// the keys listed are relative to the prefix
+		last := deletions[len(deletions)-1]
+		d.after = storage.Key(last.NodeID + "/" + last.PieceID)
+	}
+
+	byNode := make(map[string][]PieceDeletion)
+	var nodes []string
+	for _, deletion := range deletions {
+		if _, ok := byNode[deletion.NodeID]; !ok {
+			nodes = append(nodes, deletion.NodeID)
+		}
+		byNode[deletion.NodeID] = append(byNode[deletion.NodeID], deletion)
+	}
+
+	for _, nodeID := range nodes {
+		failed, err := d.deleteFromNode(ctx, nodeID, byNode[nodeID])
+		if err != nil {
+			d.s.logger.Warn("failed deleting pieces from node",
+				zap.String("node", nodeID), zap.Error(err))
+		}
+		for _, deletion := range byNode[nodeID] {
+			if err == nil && !failed[deletion.PieceID] {
+				err := d.s.DB.Delete(deletionKey(deletion.NodeID, deletion.PieceID))
+				if err != nil && !storage.ErrKeyNotFound.Has(err) {
+					return processed, err
+				}
+			} else if err := d.retry(deletion); err != nil {
+				return processed, err
+			}
+			processed++
+		}
+	}
+
+	return processed, nil
+}
+
+// deleteFromNode deletes the pieces of the tasks from the node and returns
+// the IDs of the pieces that the node failed to delete
+func (d *pieceDeleter) deleteFromNode(ctx context.Context, nodeID string, deletions []PieceDeletion) (failed map[string]bool, err error) {
+	derived := make(map[client.PieceID]string, len(deletions))
+	ids := make([]client.PieceID, 0, len(deletions))
+	for _, deletion := range deletions {
+		derivedPieceID, err := client.PieceID(deletion.PieceID).Derive([]byte(nodeID))
+		if err != nil {
+			return nil, err
+		}
+		derived[derivedPieceID] = deletion.PieceID
+		ids = append(ids, derivedPieceID)
+	}
+
+	ps, err := d.dial(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	defer utils.LogClose(ps)
+
+	failedIDs, err := ps.BatchDelete(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	failed = make(map[string]bool, len(failedIDs))
+	for _, id := range failedIDs {
+		failed[derived[id]] = true
+	}
+	return failed, nil
+}
+
+// retry records a failed attempt of the task, or drops it from the queue
+// once it failed too many times
+func (d *pieceDeleter) retry(deletion PieceDeletion) error {
+	key := deletionKey(deletion.NodeID, deletion.PieceID)
+	attempts := deletion.Attempts + 1
+	if attempts >= d.maxAttempts {
+		d.s.logger.Error("giving up deleting piece from node",
+			zap.String("node", deletion.NodeID), zap.String("piece", deletion.PieceID),
+			zap.Int("attempts", attempts))
+		err := d.s.DB.Delete(key)
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+	return d.s.DB.Put(key, storage.Value(strconv.Itoa(attempts)))
+}
+
+// run drains the deletion queue in batches of batchSize every interval until
+// the context is canceled
+func (d *pieceDeleter) run(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for {
+				_, err := d.deleteBatch(ctx, batchSize)
+				if err != nil {
+					d.s.logger.Error("err deleting queued pieces", zap.Error(err))
+					break
+				}
+				// the whole queue was processed
+				if d.after == nil {
+					break
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
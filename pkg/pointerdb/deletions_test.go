@@ -0,0 +1,150 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/rpc/client"
+	"storj.io/storj/storage/teststore"
+)
+
+// fakePSClient deletes pieces without connecting to a piece store
+type fakePSClient struct {
+	client.PSClient
+	deleted []client.PieceID
+	failing bool
+}
+
+func (ps *fakePSClient) BatchDelete(ctx context.Context, ids []client.PieceID) ([]client.PieceID, error) {
+	if ps.failing {
+		return ids, nil
+	}
+	ps.deleted = append(ps.deleted, ids...)
+	return nil, nil
+}
+
+func (ps *fakePSClient) Close() error { return nil }
+
+func TestPieceDeleter(t *testing.T) {
+	db := teststore.New()
+	s := &Server{DB: db, logger: zap.NewNop()}
+
+	_, err := s.Put(ctx, &pb.PutRequest{
+		Path: "a/b/c",
+		Pointer: &pb.Pointer{
+			Type: pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{
+				PieceId: "piece",
+				RemotePieces: []*pb.RemotePiece{
+					{NodeId: "online"}, {NodeId: "offline"}, {NodeId: "failing"},
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	// deleting the pointer queues a task for every node
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	assert.NoError(t, err)
+
+	deletions, err := s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Len(t, deletions, 3)
+	}
+
+	online := &fakePSClient{}
+	failing := &fakePSClient{failing: true}
+	d := &pieceDeleter{
+		s: s,
+		dial: func(ctx context.Context, nodeID string) (client.PSClient, error) {
+			switch nodeID {
+			case "online":
+				return online, nil
+			case "failing":
+				return failing, nil
+			}
+			return nil, errors.New("node offline")
+		},
+		maxAttempts: 2,
+	}
+
+	processed, err := d.deleteBatch(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, processed)
+
+	derivedPieceID, err := client.PieceID("piece").Derive([]byte("online"))
+	assert.NoError(t, err)
+	assert.Equal(t, []client.PieceID{derivedPieceID}, online.deleted)
+
+	// the failed tasks are retried
+	deletions, err = s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []PieceDeletion{
+			{NodeID: "failing", PieceID: "piece", Attempts: 1},
+			{NodeID: "offline", PieceID: "piece", Attempts: 1},
+		}, deletions)
+	}
+
+	// and dropped once they failed too many times
+	processed, err = d.deleteBatch(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+
+	deletions, err = s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Empty(t, deletions)
+	}
+}
+
+func TestPieceDeleterBatches(t *testing.T) {
+	db := teststore.New()
+	s := &Server{DB: db, logger: zap.NewNop()}
+
+	for _, pieceID := range []string{"piece1", "piece2", "piece3"} {
+		err := s.update(func(t *txn) error {
+			return queuePieceDeletion(t, &pb.RemoteSegment{
+				PieceId:      pieceID,
+				RemotePieces: []*pb.RemotePiece{{NodeId: "node"}},
+			})
+		})
+		assert.NoError(t, err)
+	}
+
+	ps := &fakePSClient{}
+	dials := 0
+	d := &pieceDeleter{
+		s: s,
+		dial: func(ctx context.Context, nodeID string) (client.PSClient, error) {
+			dials++
+			return ps, nil
+		},
+		maxAttempts: 1,
+	}
+
+	processed, err := d.deleteBatch(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, processed)
+	assert.NotNil(t, d.after)
+
+	processed, err = d.deleteBatch(ctx, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	assert.Nil(t, d.after)
+
+	// the pieces of a node are deleted with one call per batch
+	assert.Equal(t, 2, dials)
+	assert.Len(t, ps.deleted, 3)
+
+	deletions, err := s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Empty(t, deletions)
+	}
+}
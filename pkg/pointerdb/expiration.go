@@ -0,0 +1,214 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// expirationPrefix is the reserved key prefix of the index of the pointers by
+// their expiration date. The keys are exp/<expiration>/<path>, where the
+// expiration is the zero padded number of nanoseconds since the Unix epoch,
+// so the index is sorted by it. The entry of a pointer that was deleted or
+// overwritten is removed when it is reaped.
+const expirationPrefix = "exp/"
+
+// expirationKey returns the key of the expiration index entry of the pointer
+// at path
+func expirationKey(expiration time.Time, path string) storage.Key {
+	return storage.Key(fmt.Sprintf("%s%020d/%s", expirationPrefix, expiration.UnixNano(), path))
+}
+
+// parseExpirationKey returns the expiration and the path of an expiration
+// index entry
+func parseExpirationKey(key storage.Key) (expiration time.Time, path string, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(key.String(), expirationPrefix), "/", 2)
+	if len(parts) != 2 {
+		return time.Time{}, "", Error.New("invalid expiration index key %q", key)
+	}
+	nanos, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return time.Time{}, "", Error.Wrap(err)
+	}
+	return time.Unix(0, nanos), parts[1], nil
+}
+
+// expirationDate returns the expiration date of the pointer, or the zero time
+// if it does not expire
+func expirationDate(pointer *pb.Pointer) time.Time {
+	if pointer.GetExpirationDate() == nil {
+		return time.Time{}
+	}
+	expiration, err := ptypes.Timestamp(pointer.GetExpirationDate())
+	if err != nil || expiration.Unix() <= 0 {
+		return time.Time{}
+	}
+	return expiration
+}
+
+// isExpired returns true if the pointer expired before now
+func isExpired(pointer *pb.Pointer, now time.Time) bool {
+	expiration := expirationDate(pointer)
+	return !expiration.IsZero() && !expiration.After(now)
+}
+
+// isExpiredValue returns true if the serialized pointer expired before now
+func isExpiredValue(value storage.Value, now time.Time) bool {
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(value, pointer); err != nil {
+		return false
+	}
+	return isExpired(pointer, now)
+}
+
+// indexExpiration adds the pointer at path to the expiration index if it
+// expires
+func indexExpiration(t *txn, path string, pointer *pb.Pointer) error {
+	expiration := expirationDate(pointer)
+	if expiration.IsZero() {
+		return nil
+	}
+	return t.Put(expirationKey(expiration, path), nil)
+}
+
+// unindexExpiration removes the pointer at path from the expiration index
+func unindexExpiration(t *txn, path string, pointer *pb.Pointer) error {
+	expiration := expirationDate(pointer)
+	if expiration.IsZero() {
+		return nil
+	}
+	return t.Delete(expirationKey(expiration, path))
+}
+
+// ReapExpired deletes up to limit pointers that expired before now, oldest
+// first, and returns how many index entries it processed. The storage nodes
+// delete the expired pieces on their own, so only the pointers and their
+// piece references are removed.
+func (s *Server) ReapExpired(ctx context.Context, now time.Time, limit int) (processed int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if limit <= 0 {
+		limit = storage.LookupLimit
+	}
+
+	var keys []storage.Key
+	err = s.DB.Iterate(storage.IterateOptions{
+		Prefix:  storage.Key(expirationPrefix),
+		Recurse: true,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for len(keys) < limit && it.Next(&item) {
+			expiration, _, err := parseExpirationKey(item.Key)
+			if err != nil {
+				return err
+			}
+			if expiration.After(now) {
+				break
+			}
+			keys = append(keys, storage.CloneKey(item.Key))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, key := range keys {
+		_, path, err := parseExpirationKey(key)
+		if err != nil {
+			return processed, err
+		}
+
+		err = s.reapPointer(key, path, now)
+		if err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	if len(keys) > 0 {
+		s.logger.Debug("reaped expired pointers", zap.Int("count", len(keys)))
+	}
+	return processed, nil
+}
+
+// reapPointer deletes the pointer at path if it is expired, then its
+// expiration index entry key. The pointer may have been deleted or
+// overwritten since it was indexed, so it is checked again.
+func (s *Server) reapPointer(key storage.Key, path string, now time.Time) error {
+	return s.update(func(t *txn) error {
+		if err := s.deleteExpired(t, path, now); err != nil {
+			return err
+		}
+		return t.Delete(key)
+	})
+}
+
+// deleteExpired deletes the pointer at path if it is expired
+func (s *Server) deleteExpired(t *txn, path string, now time.Time) error {
+	pointer, err := s.getPointer(t, path)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil
+		}
+		return err
+	}
+	if !isExpired(pointer, now) {
+		return nil
+	}
+
+	if err = t.Delete(storage.Key(path)); err != nil {
+		return err
+	}
+
+	if err = reindexNodes(t, path, pointer, nil); err != nil {
+		return err
+	}
+
+	if pointer.Remote != nil {
+		_, err = removePieceRef(t, pointer.Remote.PieceId)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runReaper deletes the expired pointers in batches of batchSize every
+// interval until the context is canceled
+func (s *Server) runReaper(ctx context.Context, interval time.Duration, batchSize int) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			for {
+				processed, err := s.ReapExpired(ctx, time.Now(), batchSize)
+				if err != nil {
+					s.logger.Error("err reaping expired pointers", zap.Error(err))
+					break
+				}
+				if processed < batchSize {
+					break
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
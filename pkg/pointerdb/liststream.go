@@ -0,0 +1,116 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/macaroon"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// listStreamBatch is the number of keys ListStream reads from the store at
+// once and the most items it sends with a single message
+const listStreamBatch = 100
+
+// ListStream streams the items below the requested prefix like List, but
+// without limiting them to a page. The store is read in batches, so a slow
+// client does not keep a transaction of the store open. The listing ends
+// after the requested limit of items, if there is one.
+func (s *Server) ListStream(req *pb.ListRequest, stream pb.PointerDB_ListStreamServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	if req.GetEndBefore() != "" {
+		return status.Error(codes.InvalidArgument, "streamed listings cannot end before a path")
+	}
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpList, req.GetPrefix()))
+	if err != nil {
+		return err
+	}
+
+	prefix := listPrefix(req.GetPrefix())
+	// the listing never leaves the namespace of the project
+	dbPrefix := storage.Key(projectPath(authorization.ProjectID, string(prefix)))
+
+	first := storage.Key(string(dbPrefix) + req.GetStartAfter())
+	skipFirst := req.GetStartAfter() != ""
+	remaining := int(req.GetLimit())
+
+	for {
+		if err = ctx.Err(); err != nil {
+			return status.Error(codes.Canceled, err.Error())
+		}
+
+		batch, done, err := listBatch(s.DB, dbPrefix, first, skipFirst, req.GetRecursive())
+		if err != nil {
+			return status.Errorf(codes.Internal, "ListStream: %v", err)
+		}
+
+		now := time.Now()
+		var items []*pb.ListResponse_Item
+		for _, rawItem := range batch {
+			relative := storage.ListItem{Key: rawItem.Key[len(dbPrefix):], Value: rawItem.Value, IsPrefix: rawItem.IsPrefix}
+			if !s.listed(authorization, prefix, relative, now) {
+				continue
+			}
+			items = append(items, s.createListItem(relative, req.GetMetaFlags()))
+			if remaining > 0 {
+				remaining--
+				if remaining == 0 {
+					done = true
+					break
+				}
+			}
+		}
+		if len(items) > 0 {
+			if err = stream.Send(&pb.ListStreamResponse{Items: items}); err != nil {
+				return err
+			}
+		}
+		if done {
+			return nil
+		}
+
+		// continue after the last key, or after all keys of the last prefix
+		last := batch[len(batch)-1]
+		if last.IsPrefix {
+			first, skipFirst = storage.AfterPrefix(last.Key), false
+		} else {
+			first, skipFirst = last.Key, true
+		}
+	}
+}
+
+// listBatch reads up to listStreamBatch items of db below the prefix starting
+// from first, skipping first itself if skipFirst. It reports whether there are
+// no more items.
+func listBatch(db storage.KeyValueStore, prefix, first storage.Key, skipFirst, recursive bool) (batch storage.Items, done bool, err error) {
+	err = db.Iterate(storage.IterateOptions{
+		Prefix:  prefix,
+		First:   first,
+		Recurse: recursive,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for len(batch) < listStreamBatch {
+			if !it.Next(&item) {
+				done = true
+				return nil
+			}
+			if skipFirst {
+				skipFirst = false
+				if item.Key.Equal(first) {
+					continue
+				}
+			}
+			batch = append(batch, storage.CloneItem(item))
+		}
+		return nil
+	})
+	return batch, done, err
+}
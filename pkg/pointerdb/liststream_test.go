@@ -0,0 +1,106 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+// listStreamServer collects the items a ListStream call sends
+type listStreamServer struct {
+	grpc.ServerStream
+	items    []*pb.ListResponse_Item
+	messages int
+}
+
+func (stream *listStreamServer) Context() context.Context { return ctx }
+
+func (stream *listStreamServer) Send(resp *pb.ListStreamResponse) error {
+	stream.items = append(stream.items, resp.Items...)
+	stream.messages++
+	return nil
+}
+
+func TestServiceListStream(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	pointerBytes, err := proto.Marshal(&pb.Pointer{Type: pb.Pointer_REMOTE, Size: 10, Remote: &pb.RemoteSegment{PieceId: "piece"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// more directories and objects than fit into a single batch
+	var items []storage.ListItem
+	for i := 0; i < 3*listStreamBatch/2; i++ {
+		for _, name := range []string{"a", "b"} {
+			items = append(items, storage.ListItem{Key: storage.Key(fmt.Sprintf("dir%03d/%s", i, name)), Value: pointerBytes})
+		}
+		items = append(items, storage.ListItem{Key: storage.Key(fmt.Sprintf("obj%03d", i)), Value: pointerBytes})
+	}
+	items = append(items, storage.ListItem{Key: storage.Key("refs/piece"), Value: pointerBytes})
+	if err = storage.PutAll(db, items...); err != nil {
+		t.Fatal(err)
+	}
+
+	listAll := func(req pb.ListRequest) []*pb.ListResponse_Item {
+		var all []*pb.ListResponse_Item
+		for {
+			resp, err := s.List(ctx, &req)
+			if !assert.NoError(t, err) {
+				return nil
+			}
+			all = append(all, resp.Items...)
+			if !resp.More {
+				return all
+			}
+			req.StartAfter = resp.Items[len(resp.Items)-1].Path
+		}
+	}
+
+	for i, req := range []pb.ListRequest{
+		{Recursive: true},
+		{Recursive: true, MetaFlags: meta.Size | meta.Remote},
+		{},
+		{Prefix: "dir010"},
+		{Recursive: true, StartAfter: "dir120/a"},
+		{StartAfter: "dir120/"},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		stream := &listStreamServer{}
+		req := req
+		if !assert.NoError(t, s.ListStream(&req, stream), errTag) {
+			continue
+		}
+		assert.Equal(t, listAll(req), stream.items, errTag)
+	}
+
+	stream := &listStreamServer{}
+	assert.NoError(t, s.ListStream(&pb.ListRequest{Recursive: true}, stream))
+	assert.Len(t, stream.items, 3*3*listStreamBatch/2)
+	assert.True(t, stream.messages > 1)
+
+	stream = &listStreamServer{}
+	assert.NoError(t, s.ListStream(&pb.ListRequest{Recursive: true, Limit: 150}, stream))
+	assert.Len(t, stream.items, 150)
+
+	stream = &listStreamServer{}
+	err = s.ListStream(&pb.ListRequest{EndBefore: "obj010"}, stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,215 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// nodeIndexPrefix is the reserved key prefix of the index of the remote
+// segments by the storage nodes holding their pieces, as
+// nodes/<node id>/<pointer key> = <piece numbers>. The piece numbers are
+// separated by commas.
+const nodeIndexPrefix = "nodes/"
+
+func nodeIndexKey(nodeID, path string) storage.Key {
+	return storage.Key(nodeIndexPrefix + nodeID + "/" + path)
+}
+
+// nodePieces returns the numbers of the pieces of the pointer per node
+func nodePieces(pointer *pb.Pointer) map[string][]int32 {
+	pieces := map[string][]int32{}
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		pieces[piece.NodeId] = append(pieces[piece.NodeId], piece.PieceNum)
+	}
+	return pieces
+}
+
+func formatPieceNums(pieceNums []int32) storage.Value {
+	formatted := make([]string, len(pieceNums))
+	for i, pieceNum := range pieceNums {
+		formatted[i] = strconv.Itoa(int(pieceNum))
+	}
+	return storage.Value(strings.Join(formatted, ","))
+}
+
+func parsePieceNums(value storage.Value) ([]int32, error) {
+	var pieceNums []int32
+	for _, field := range strings.Split(string(value), ",") {
+		pieceNum, err := strconv.ParseInt(field, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		pieceNums = append(pieceNums, int32(pieceNum))
+	}
+	return pieceNums, nil
+}
+
+// reindexNodes updates the node index of db after the pointer at path was
+// replaced by the new one. Either of them may be nil.
+func reindexNodes(db keyWriter, path string, old, new *pb.Pointer) error {
+	newPieces := nodePieces(new)
+	for nodeID := range nodePieces(old) {
+		if _, ok := newPieces[nodeID]; ok {
+			continue
+		}
+		err := db.Delete(nodeIndexKey(nodeID, path))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+	}
+	for nodeID, pieceNums := range newPieces {
+		if err := db.Put(nodeIndexKey(nodeID, path), formatPieceNums(pieceNums)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NodeSegments lists the segments with pieces on the requested storage node
+// in the order of their paths. API keys of a project only see the segments
+// of the project.
+func (s *Server) NodeSegments(ctx context.Context, req *pb.NodeSegmentsRequest) (resp *pb.NodeSegmentsResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb node segments")
+
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetNodeId() == "" || strings.Contains(req.GetNodeId(), "/") {
+		return nil, status.Error(codes.InvalidArgument, "invalid node id")
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > storage.LookupLimit {
+		limit = storage.LookupLimit
+	}
+
+	prefix := nodeIndexKey(req.GetNodeId(), projectPath(authorization.ProjectID, ""))
+	first := storage.Key(string(prefix) + req.GetStartAfter())
+
+	resp = &pb.NodeSegmentsResponse{}
+	err = s.DB.Iterate(storage.IterateOptions{
+		Prefix:  prefix,
+		First:   first,
+		Recurse: true,
+	}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if req.GetStartAfter() != "" && item.Key.Equal(first) {
+				continue
+			}
+			if len(resp.Items) == limit {
+				resp.More = true
+				return nil
+			}
+			pieceNums, err := parsePieceNums(item.Value)
+			if err != nil {
+				return err
+			}
+			resp.Items = append(resp.Items, &pb.NodeSegmentsResponse_Item{
+				Path:      string(item.Key[len(prefix):]),
+				PieceNums: pieceNums,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("err listing node segments", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return resp, nil
+}
+
+// RebuildNodeIndex replaces the node index of the pointers in db with one
+// built from the pointers and returns the number of indexed segments. The
+// pointers must not change while the index is rebuilt.
+func RebuildNodeIndex(ctx context.Context, db storage.KeyValueStore) (segments int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		batch, _, err := listBatch(db, storage.Key(nodeIndexPrefix), nil, false, true)
+		if err != nil {
+			return 0, Error.Wrap(err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, item := range batch {
+			if err = db.Delete(item.Key); err != nil && !storage.ErrKeyNotFound.Has(err) {
+				return 0, Error.Wrap(err)
+			}
+		}
+	}
+
+	err = WalkPointers(ctx, db, func(projectID, path string, pointer *pb.Pointer) error {
+		if pointer.GetRemote() == nil {
+			return nil
+		}
+		segments++
+		return Error.Wrap(reindexNodes(db, projectPath(projectID, path), nil, pointer))
+	})
+	return segments, err
+}
+
+// WalkNodeSegments calls fn with every remote segment of the store that still
+// has pieces on the storage node, along with the key of its pointer. Like
+// WalkPointers, the index is read in batches.
+func WalkNodeSegments(ctx context.Context, db storage.KeyValueStore, nodeID string, fn func(path string, pointer *pb.Pointer) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefix := nodeIndexKey(nodeID, "")
+	var first storage.Key
+	skipFirst := false
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, done, err := listBatch(db, prefix, first, skipFirst, true)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		now := time.Now()
+		for _, item := range batch {
+			path := string(item.Key[len(prefix):])
+			value, err := db.Get(storage.Key(path))
+			if storage.ErrKeyNotFound.Has(err) {
+				continue
+			}
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			pointer := &pb.Pointer{}
+			if err = proto.Unmarshal(value, pointer); err != nil {
+				return Error.Wrap(err)
+			}
+			// the index may be stale until it is rebuilt
+			if isExpired(pointer, now) || len(nodePieces(pointer)[nodeID]) == 0 {
+				continue
+			}
+			if err = fn(path, pointer); err != nil {
+				return err
+			}
+		}
+		if done || len(batch) == 0 {
+			return nil
+		}
+		first, skipFirst = batch[len(batch)-1].Key, true
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func nodesPointer(pieceID string, nodeIDs ...string) *pb.Pointer {
+	pointer := remotePointer(pieceID)
+	for i, nodeID := range nodeIDs {
+		pointer.Remote.RemotePieces = append(pointer.Remote.RemotePieces, &pb.RemotePiece{PieceNum: int32(i), NodeId: nodeID})
+	}
+	return pointer
+}
+
+func TestNodeIndex(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	nodeSegments := func(nodeID string) map[string][]int32 {
+		resp, err := s.NodeSegments(ctx, &pb.NodeSegmentsRequest{NodeId: nodeID})
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		segments := map[string][]int32{}
+		for _, item := range resp.Items {
+			segments[item.Path] = item.PieceNums
+		}
+		return segments
+	}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "l/bucket/a", Pointer: nodesPointer("a", "n1", "n2", "n1")})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string][]int32{"l/bucket/a": {0, 2}}, nodeSegments("n1"))
+	assert.Equal(t, map[string][]int32{"l/bucket/a": {1}}, nodeSegments("n2"))
+
+	// overwriting the pointer removes it from the nodes it left
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "l/bucket/a", Pointer: nodesPointer("a2", "n2", "n3")})
+	assert.NoError(t, err)
+	assert.Empty(t, nodeSegments("n1"))
+	assert.Equal(t, map[string][]int32{"l/bucket/a": {0}}, nodeSegments("n2"))
+	assert.Equal(t, map[string][]int32{"l/bucket/a": {1}}, nodeSegments("n3"))
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "l/bucket/a", DestinationPath: "l/bucket/b"})
+	assert.NoError(t, err)
+	_, err = s.Move(ctx, &pb.MoveRequest{SourcePath: "l/bucket/a", DestinationPath: "l/bucket/c"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]int32{"l/bucket/b": {0}, "l/bucket/c": {0}}, nodeSegments("n2"))
+
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "l/bucket/b"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]int32{"l/bucket/c": {1}}, nodeSegments("n3"))
+
+	// the index is paged and hidden from listings
+	for _, path := range []string{"l/bucket/d", "l/bucket/e"} {
+		_, err = s.Put(ctx, &pb.PutRequest{Path: path, Pointer: nodesPointer(path, "n3")})
+		assert.NoError(t, err)
+	}
+	resp, err := s.NodeSegments(ctx, &pb.NodeSegmentsRequest{NodeId: "n3", Limit: 2})
+	if assert.NoError(t, err) && assert.Len(t, resp.Items, 2) {
+		assert.True(t, resp.More)
+		assert.Equal(t, "l/bucket/d", resp.Items[1].Path)
+	}
+	resp, err = s.NodeSegments(ctx, &pb.NodeSegmentsRequest{NodeId: "n3", StartAfter: "l/bucket/d", Limit: 2})
+	if assert.NoError(t, err) && assert.Len(t, resp.Items, 1) {
+		assert.False(t, resp.More)
+		assert.Equal(t, "l/bucket/e", resp.Items[0].Path)
+	}
+	listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 3)
+	}
+
+	_, err = s.NodeSegments(ctx, &pb.NodeSegmentsRequest{NodeId: "n3/l"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// rebuilding drops stale entries and restores missing ones
+	assert.NoError(t, db.Put(nodeIndexKey("n1", "l/bucket/gone"), storage.Value("0")))
+	assert.NoError(t, db.Delete(nodeIndexKey("n2", "l/bucket/c")))
+	segments, err := RebuildNodeIndex(ctx, db)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3, segments)
+	}
+	assert.Empty(t, nodeSegments("n1"))
+	assert.Equal(t, map[string][]int32{"l/bucket/c": {0}}, nodeSegments("n2"))
+	assert.Len(t, nodeSegments("n3"), 3)
+}
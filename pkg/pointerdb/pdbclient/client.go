@@ -5,7 +5,9 @@ package pdbclient
 
 import (
 	"context"
+	"io"
 
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -45,14 +47,42 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// NodeSegment is a segment with pieces on a storage node
+type NodeSegment struct {
+	Path      p.Path
+	PieceNums []int32
+}
+
+// Relocation is a move or copy of a pointer of a batch, replacing its
+// metadata
+type Relocation struct {
+	SrcPath  p.Path
+	DstPath  p.Path
+	Metadata []byte
+}
+
 // Client services offerred for the interface
 type Client interface {
 	Put(ctx context.Context, path p.Path, pointer *pb.Pointer) error
+	CompareAndSwap(ctx context.Context, path p.Path, pointer *pb.Pointer, expectedVersion int64) error
 	Get(ctx context.Context, path p.Path) (*pb.Pointer, error)
+	Meta(ctx context.Context, path p.Path) (*pb.Pointer, error)
 	List(ctx context.Context, prefix, startAfter, endBefore p.Path,
 		recursive bool, limit int, metaFlags uint32) (
 		items []ListItem, more bool, err error)
-	Delete(ctx context.Context, path p.Path) error
+	Delete(ctx context.Context, path p.Path) (piecesInUse bool, err error)
+	Copy(ctx context.Context, srcPath, dstPath p.Path, metadata []byte) (*pb.Pointer, error)
+	Move(ctx context.Context, srcPath, dstPath p.Path, metadata []byte) (*pb.Pointer, error)
+	PutWithContentID(ctx context.Context, path p.Path, pointer *pb.Pointer, contentID string) error
+	Reference(ctx context.Context, contentID string, path p.Path, metadata []byte, expiration *timestamp.Timestamp) (*pb.Pointer, error)
+	BatchGet(ctx context.Context, paths []p.Path, metadataOnly bool) (pointers []*pb.Pointer, errs []error, err error)
+	BatchPut(ctx context.Context, paths []p.Path, pointers []*pb.Pointer, atomic bool) (errs []error, err error)
+	BatchDelete(ctx context.Context, paths []p.Path, atomic bool) (errs []error, err error)
+	BatchMove(ctx context.Context, relocations []Relocation, deletePaths []p.Path, copy bool) (pointers []*pb.Pointer, err error)
+	ListStream(ctx context.Context, prefix, startAfter p.Path, recursive bool, metaFlags uint32,
+		fn func(item ListItem) error) error
+	NodeSegments(ctx context.Context, nodeID string, startAfter p.Path, limit int) (
+		segments []NodeSegment, more bool, err error)
 }
 
 // NewClient initializes a new pointerdb client
@@ -95,6 +125,42 @@ func (pdb *PointerDB) Put(ctx context.Context, path p.Path, pointer *pb.Pointer)
 	return err
 }
 
+// PutWithContentID stores the pointer like Put and records the content ID of
+// its segment, so later segments with the same content can reference its
+// pieces
+func (pdb *PointerDB) PutWithContentID(ctx context.Context, path p.Path, pointer *pb.Pointer, contentID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.grpcClient.Put(ctx, &pb.PutRequest{
+		Path:      path.String(),
+		Pointer:   pointer,
+		APIKey:    pdb.APIKey,
+		ContentId: contentID,
+	})
+
+	return err
+}
+
+// CompareAndSwap stores the pointer only if the current pointer at the path
+// has the expected version, or if there is no pointer at the path when the
+// expected version is zero. Otherwise it returns ErrVersionMismatch.
+func (pdb *PointerDB) CompareAndSwap(ctx context.Context, path p.Path, pointer *pb.Pointer, expectedVersion int64) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.grpcClient.Put(ctx, &pb.PutRequest{
+		Path:            path.String(),
+		Pointer:         pointer,
+		APIKey:          pdb.APIKey,
+		ExpectedVersion: expectedVersion,
+		IfNotExists:     expectedVersion == 0,
+	})
+	if status.Code(err) == codes.FailedPrecondition {
+		return ErrVersionMismatch.Wrap(err)
+	}
+
+	return err
+}
+
 // Get is the interface to make a GET request, needs PATH and APIKey
 func (pdb *PointerDB) Get(ctx context.Context, path p.Path) (pointer *pb.Pointer, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -110,6 +176,22 @@ func (pdb *PointerDB) Get(ctx context.Context, path p.Path) (pointer *pb.Pointer
 	return res.GetPointer(), nil
 }
 
+// Meta gets the pointer at the path without the locations of its pieces and
+// without its inline data, so the request does not count as a download
+func (pdb *PointerDB) Meta(ctx context.Context, path p.Path) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.Get(ctx, &pb.GetRequest{Path: path.String(), APIKey: pdb.APIKey, MetadataOnly: true})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return res.GetPointer(), nil
+}
+
 // List is the interface to make a LIST request, needs StartingPathKey, Limit, and APIKey
 func (pdb *PointerDB) List(ctx context.Context, prefix, startAfter, endBefore p.Path,
 	recursive bool, limit int, metaFlags uint32) (
@@ -142,11 +224,298 @@ func (pdb *PointerDB) List(ctx context.Context, prefix, startAfter, endBefore p.
 	return items, res.GetMore(), nil
 }
 
-// Delete is the interface to make a Delete request, needs Path and APIKey
-func (pdb *PointerDB) Delete(ctx context.Context, path p.Path) (err error) {
+// ListStream calls fn with every item below prefix after startAfter, in
+// order, without paging through the listing. The listing stops at the first
+// error of fn, which ListStream returns.
+func (pdb *PointerDB) ListStream(ctx context.Context, prefix, startAfter p.Path, recursive bool, metaFlags uint32,
+	fn func(item ListItem) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = pdb.grpcClient.Delete(ctx, &pb.DeleteRequest{Path: path.String(), APIKey: pdb.APIKey})
+	// canceling the context ends the stream when fn stops the listing
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	return err
+	stream, err := pdb.grpcClient.ListStream(ctx, &pb.ListRequest{
+		Prefix:     prefix.String(),
+		StartAfter: startAfter.String(),
+		Recursive:  recursive,
+		MetaFlags:  metaFlags,
+		APIKey:     pdb.APIKey,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		for _, itm := range res.GetItems() {
+			err = fn(ListItem{
+				Path:     p.New(itm.GetPath()),
+				Pointer:  itm.GetPointer(),
+				IsPrefix: itm.IsPrefix,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// NodeSegments lists the segments with pieces on the storage node after
+// startAfter in the order of their paths
+func (pdb *PointerDB) NodeSegments(ctx context.Context, nodeID string, startAfter p.Path, limit int) (
+	segments []NodeSegment, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.NodeSegments(ctx, &pb.NodeSegmentsRequest{
+		NodeId:     nodeID,
+		StartAfter: startAfter.String(),
+		Limit:      int32(limit),
+		APIKey:     pdb.APIKey,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	segments = make([]NodeSegment, len(res.GetItems()))
+	for i, item := range res.GetItems() {
+		segments[i] = NodeSegment{Path: p.New(item.GetPath()), PieceNums: item.GetPieceNums()}
+	}
+	return segments, res.GetMore(), nil
+}
+
+// Delete is the interface to make a Delete request, needs Path and APIKey.
+// It returns whether the pieces of the deleted pointer are still referenced
+// by a copy of it.
+func (pdb *PointerDB) Delete(ctx context.Context, path p.Path) (piecesInUse bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.Delete(ctx, &pb.DeleteRequest{Path: path.String(), APIKey: pdb.APIKey})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return false, err
+	}
+
+	return res.GetPiecesInUse(), nil
+}
+
+// Copy is the interface to make a Copy request, needs the source and the
+// destination Path, the new metadata, and APIKey
+func (pdb *PointerDB) Copy(ctx context.Context, srcPath, dstPath p.Path, metadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.Copy(ctx, &pb.CopyRequest{
+		SourcePath:      srcPath.String(),
+		DestinationPath: dstPath.String(),
+		Metadata:        metadata,
+		APIKey:          pdb.APIKey,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return res.GetPointer(), nil
+}
+
+// Move is the interface to make a Move request, needs the source and the
+// destination Path, the new metadata, and APIKey
+func (pdb *PointerDB) Move(ctx context.Context, srcPath, dstPath p.Path, metadata []byte) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.Move(ctx, &pb.MoveRequest{
+		SourcePath:      srcPath.String(),
+		DestinationPath: dstPath.String(),
+		Metadata:        metadata,
+		APIKey:          pdb.APIKey,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return res.GetPointer(), nil
+}
+
+// Reference stores a pointer at path with the given metadata and expiration
+// that shares the pieces of an earlier segment with the same content ID. It
+// returns storage.ErrKeyNotFound if there is no such segment.
+func (pdb *PointerDB) Reference(ctx context.Context, contentID string, path p.Path, metadata []byte, expiration *timestamp.Timestamp) (pointer *pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.Reference(ctx, &pb.ReferenceRequest{
+		ContentId:      contentID,
+		Path:           path.String(),
+		Metadata:       metadata,
+		ExpirationDate: expiration,
+		APIKey:         pdb.APIKey,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return nil, Error.Wrap(err)
+	}
+
+	return res.GetPointer(), nil
+}
+
+// BatchGet gets the pointers at the paths with a single request, like Meta if
+// metadataOnly is true. The errors of the paths are returned in errs,
+// storage.ErrKeyNotFound for paths without a pointer.
+func (pdb *PointerDB) BatchGet(ctx context.Context, paths []p.Path, metadataOnly bool) (pointers []*pb.Pointer, errs []error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.BatchGet(ctx, &pb.BatchGetRequest{
+		Paths:        pathStrings(paths),
+		APIKey:       pdb.APIKey,
+		MetadataOnly: metadataOnly,
+	})
+	if err != nil {
+		return nil, nil, convertError(err)
+	}
+	if len(res.GetItems()) != len(paths) {
+		return nil, nil, Error.New("got %d results for %d paths", len(res.GetItems()), len(paths))
+	}
+
+	pointers = make([]*pb.Pointer, len(paths))
+	errs = make([]error, len(paths))
+	for i, item := range res.GetItems() {
+		pointers[i] = item.GetPointer()
+		errs[i] = batchItemError(item.GetResult())
+	}
+	return pointers, errs, nil
+}
+
+// BatchPut stores the pointers at the paths with a single request. If atomic
+// is true, either all or none of the pointers are stored and err tells why.
+// Otherwise the errors of the pointers are returned in errs.
+func (pdb *PointerDB) BatchPut(ctx context.Context, paths []p.Path, pointers []*pb.Pointer, atomic bool) (errs []error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(paths) != len(pointers) {
+		return nil, Error.New("got %d pointers for %d paths", len(pointers), len(paths))
+	}
+	reqs := make([]*pb.PutRequest, len(paths))
+	for i, path := range paths {
+		reqs[i] = &pb.PutRequest{Path: path.String(), Pointer: pointers[i]}
+	}
+
+	res, err := pdb.grpcClient.BatchPut(ctx, &pb.BatchPutRequest{
+		Requests: reqs,
+		APIKey:   pdb.APIKey,
+		Atomic:   atomic,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if len(res.GetResults()) != len(paths) {
+		return nil, Error.New("got %d results for %d paths", len(res.GetResults()), len(paths))
+	}
+
+	errs = make([]error, len(paths))
+	for i, result := range res.GetResults() {
+		errs[i] = batchItemError(result)
+	}
+	return errs, nil
+}
+
+// BatchDelete deletes the pointers at the paths with a single request. If
+// atomic is true, either all or none of the pointers are deleted and err
+// tells why. Otherwise the errors of the paths are returned in errs,
+// storage.ErrKeyNotFound for paths without a pointer.
+func (pdb *PointerDB) BatchDelete(ctx context.Context, paths []p.Path, atomic bool) (errs []error, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	res, err := pdb.grpcClient.BatchDelete(ctx, &pb.BatchDeleteRequest{
+		Paths:  pathStrings(paths),
+		APIKey: pdb.APIKey,
+		Atomic: atomic,
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if len(res.GetItems()) != len(paths) {
+		return nil, Error.New("got %d results for %d paths", len(res.GetItems()), len(paths))
+	}
+
+	errs = make([]error, len(paths))
+	for i, item := range res.GetItems() {
+		errs[i] = batchItemError(item.GetResult())
+	}
+	return errs, nil
+}
+
+// BatchMove moves, or copies if copy is true, the pointers of the
+// relocations with a single request, after deleting the pointers at
+// deletePaths. Either all or none of the pointers are changed. It returns
+// the moved pointers.
+func (pdb *PointerDB) BatchMove(ctx context.Context, relocations []Relocation, deletePaths []p.Path, copy bool) (pointers []*pb.Pointer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	reqs := make([]*pb.MoveRequest, len(relocations))
+	for i, relocation := range relocations {
+		reqs[i] = &pb.MoveRequest{
+			SourcePath:      relocation.SrcPath.String(),
+			DestinationPath: relocation.DstPath.String(),
+			Metadata:        relocation.Metadata,
+		}
+	}
+
+	res, err := pdb.grpcClient.BatchMove(ctx, &pb.BatchMoveRequest{
+		Requests:    reqs,
+		APIKey:      pdb.APIKey,
+		Copy:        copy,
+		DeletePaths: pathStrings(deletePaths),
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if len(res.GetPointers()) != len(relocations) {
+		return nil, Error.New("got %d pointers for %d relocations", len(res.GetPointers()), len(relocations))
+	}
+	return res.GetPointers(), nil
+}
+
+func pathStrings(paths []p.Path) []string {
+	strs := make([]string, len(paths))
+	for i, path := range paths {
+		strs[i] = path.String()
+	}
+	return strs
+}
+
+// batchItemError returns the error of an item of a batch request, or nil if
+// it succeeded
+func batchItemError(result *pb.BatchResult) error {
+	code := codes.Code(result.GetCode())
+	if code == codes.OK {
+		return nil
+	}
+	return convertError(status.Error(code, result.GetMessage()))
+}
+
+// convertError converts the status errors of pointerdb to the errors of the
+// single requests
+func convertError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return storage.ErrKeyNotFound.Wrap(err)
+	case codes.FailedPrecondition:
+		return ErrVersionMismatch.Wrap(err)
+	default:
+		return Error.Wrap(err)
+	}
 }
@@ -15,10 +15,13 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	p "storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/storage"
 )
 
 const (
@@ -110,6 +113,37 @@ func TestPut(t *testing.T) {
 	}
 }
 
+func TestCompareAndSwap(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for i, tt := range []struct {
+		expectedVersion int64
+		err             error
+		mismatch        bool
+	}{
+		{0, nil, false},
+		{3, nil, false},
+		{3, status.Error(codes.FailedPrecondition, "pointer version mismatch"), true},
+		{0, ErrUnauthenticated, false},
+	} {
+		putRequest := makePointer(p.New("file1/file2"), []byte("abc123"))
+		putRequest.ExpectedVersion = tt.expectedVersion
+		putRequest.IfNotExists = tt.expectedVersion == 0
+
+		errTag := fmt.Sprintf("Test case #%d", i)
+		gc := NewMockPointerDBClient(ctrl)
+		pdb := PointerDB{grpcClient: gc, APIKey: []byte("abc123")}
+
+		gc.EXPECT().Put(gomock.Any(), &putRequest).Return(nil, tt.err)
+
+		err := pdb.CompareAndSwap(ctx, p.New("file1/file2"), putRequest.Pointer, tt.expectedVersion)
+
+		assert.Equal(t, tt.err != nil, err != nil, errTag)
+		assert.Equal(t, tt.mismatch, ErrVersionMismatch.Has(err), errTag)
+	}
+}
+
 func TestGet(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -160,6 +194,27 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func TestMeta(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	path := p.New("file1/file2")
+	gc := NewMockPointerDBClient(ctrl)
+	pdb := PointerDB{grpcClient: gc, APIKey: []byte("abc123")}
+
+	// the pointer is requested without its pieces
+	gc.EXPECT().Get(gomock.Any(), &pb.GetRequest{Path: path.String(), APIKey: []byte("abc123"), MetadataOnly: true}).
+		Return(&pb.GetResponse{Pointer: &pb.Pointer{Size: 10}}, nil)
+	pointer, err := pdb.Meta(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(10), pointer.Size)
+	}
+
+	gc.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "not found"))
+	_, err = pdb.Meta(ctx, path)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+}
+
 func TestList(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -273,7 +328,7 @@ func TestDelete(t *testing.T) {
 
 		gc.EXPECT().Delete(gomock.Any(), &deleteRequest).Return(nil, tt.err)
 
-		err := pdb.Delete(ctx, tt.path)
+		_, err := pdb.Delete(ctx, tt.path)
 
 		if err != nil {
 			assert.EqualError(t, err, tt.errString, errTag)
@@ -282,3 +337,87 @@ func TestDelete(t *testing.T) {
 		}
 	}
 }
+
+func TestCopy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for i, tt := range []struct {
+		APIKey    []byte
+		srcPath   p.Path
+		dstPath   p.Path
+		metadata  []byte
+		err       error
+		errString string
+	}{
+		{[]byte("wrong key"), p.New("file1/file2"), p.New("file3"), nil, ErrUnauthenticated, unauthenticated},
+		{[]byte("abc123"), p.New(""), p.New("file3"), nil, ErrNoFileGiven, noPathGiven},
+		{[]byte("abc123"), p.New("file1/file2"), p.New("file3"), []byte("metadata"), nil, ""},
+	} {
+		copyRequest := pb.CopyRequest{
+			SourcePath:      tt.srcPath.String(),
+			DestinationPath: tt.dstPath.String(),
+			Metadata:        tt.metadata,
+			APIKey:          tt.APIKey,
+		}
+		copyResponse := pb.CopyResponse{Pointer: &pb.Pointer{Metadata: tt.metadata}}
+
+		errTag := fmt.Sprintf("Test case #%d", i)
+		gc := NewMockPointerDBClient(ctrl)
+		pdb := PointerDB{grpcClient: gc, APIKey: tt.APIKey}
+
+		gc.EXPECT().Copy(gomock.Any(), &copyRequest).Return(&copyResponse, tt.err)
+
+		pointer, err := pdb.Copy(ctx, tt.srcPath, tt.dstPath, tt.metadata)
+
+		if err != nil {
+			assert.True(t, strings.Contains(err.Error(), tt.errString), errTag)
+			assert.Nil(t, pointer, errTag)
+		} else {
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, tt.metadata, pointer.GetMetadata(), errTag)
+		}
+	}
+}
+
+func TestMove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for i, tt := range []struct {
+		APIKey    []byte
+		srcPath   p.Path
+		dstPath   p.Path
+		metadata  []byte
+		err       error
+		errString string
+	}{
+		{[]byte("wrong key"), p.New("file1/file2"), p.New("file3"), nil, ErrUnauthenticated, unauthenticated},
+		{[]byte("abc123"), p.New(""), p.New("file3"), nil, ErrNoFileGiven, noPathGiven},
+		{[]byte("abc123"), p.New("file1/file2"), p.New("file3"), []byte("metadata"), nil, ""},
+	} {
+		moveRequest := pb.MoveRequest{
+			SourcePath:      tt.srcPath.String(),
+			DestinationPath: tt.dstPath.String(),
+			Metadata:        tt.metadata,
+			APIKey:          tt.APIKey,
+		}
+		moveResponse := pb.MoveResponse{Pointer: &pb.Pointer{Metadata: tt.metadata}}
+
+		errTag := fmt.Sprintf("Test case #%d", i)
+		gc := NewMockPointerDBClient(ctrl)
+		pdb := PointerDB{grpcClient: gc, APIKey: tt.APIKey}
+
+		gc.EXPECT().Move(gomock.Any(), &moveRequest).Return(&moveResponse, tt.err)
+
+		pointer, err := pdb.Move(ctx, tt.srcPath, tt.dstPath, tt.metadata)
+
+		if err != nil {
+			assert.True(t, strings.Contains(err.Error(), tt.errString), errTag)
+			assert.Nil(t, pointer, errTag)
+		} else {
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, tt.metadata, pointer.GetMetadata(), errTag)
+		}
+	}
+}
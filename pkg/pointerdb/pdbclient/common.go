@@ -9,3 +9,7 @@ import (
 
 // Error is the pdbclient error class
 var Error = errs.Class("pointerdb client error")
+
+// ErrVersionMismatch is returned when a pointer was not stored because the
+// pointer at the path was changed in the meantime
+var ErrVersionMismatch = errs.Class("pointer version mismatch")
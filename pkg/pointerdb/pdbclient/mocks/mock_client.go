@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	paths "storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb/pdbclient"
@@ -37,13 +38,92 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method
-func (m *MockClient) Delete(arg0 context.Context, arg1 paths.Path) error {
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+// BatchDelete mocks base method
+func (m *MockClient) BatchDelete(arg0 context.Context, arg1 []paths.Path, arg2 bool) ([]error, error) {
+	ret := m.ctrl.Call(m, "BatchDelete", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDelete indicates an expected call of BatchDelete
+func (mr *MockClientMockRecorder) BatchDelete(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockClient)(nil).BatchDelete), arg0, arg1, arg2)
+}
+
+// BatchGet mocks base method
+func (m *MockClient) BatchGet(arg0 context.Context, arg1 []paths.Path, arg2 bool) ([]*pb.Pointer, []error, error) {
+	ret := m.ctrl.Call(m, "BatchGet", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*pb.Pointer)
+	ret1, _ := ret[1].([]error)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// BatchGet indicates an expected call of BatchGet
+func (mr *MockClientMockRecorder) BatchGet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGet", reflect.TypeOf((*MockClient)(nil).BatchGet), arg0, arg1, arg2)
+}
+
+// BatchMove mocks base method
+func (m *MockClient) BatchMove(arg0 context.Context, arg1 []pdbclient.Relocation, arg2 []paths.Path, arg3 bool) ([]*pb.Pointer, error) {
+	ret := m.ctrl.Call(m, "BatchMove", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*pb.Pointer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMove indicates an expected call of BatchMove
+func (mr *MockClientMockRecorder) BatchMove(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMove", reflect.TypeOf((*MockClient)(nil).BatchMove), arg0, arg1, arg2, arg3)
+}
+
+// BatchPut mocks base method
+func (m *MockClient) BatchPut(arg0 context.Context, arg1 []paths.Path, arg2 []*pb.Pointer, arg3 bool) ([]error, error) {
+	ret := m.ctrl.Call(m, "BatchPut", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]error)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPut indicates an expected call of BatchPut
+func (mr *MockClientMockRecorder) BatchPut(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPut", reflect.TypeOf((*MockClient)(nil).BatchPut), arg0, arg1, arg2, arg3)
+}
+
+// CompareAndSwap mocks base method
+func (m *MockClient) CompareAndSwap(arg0 context.Context, arg1 paths.Path, arg2 *pb.Pointer, arg3 int64) error {
+	ret := m.ctrl.Call(m, "CompareAndSwap", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CompareAndSwap indicates an expected call of CompareAndSwap
+func (mr *MockClientMockRecorder) CompareAndSwap(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompareAndSwap", reflect.TypeOf((*MockClient)(nil).CompareAndSwap), arg0, arg1, arg2, arg3)
+}
+
+// Copy mocks base method
+func (m *MockClient) Copy(arg0 context.Context, arg1, arg2 paths.Path, arg3 []byte) (*pb.Pointer, error) {
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*pb.Pointer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockClientMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockClient)(nil).Copy), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method
+func (m *MockClient) Delete(arg0 context.Context, arg1 paths.Path) (bool, error) {
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Delete indicates an expected call of Delete
 func (mr *MockClientMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0, arg1)
@@ -76,6 +156,58 @@ func (mr *MockClientMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// ListStream mocks base method
+func (m *MockClient) ListStream(arg0 context.Context, arg1, arg2 paths.Path, arg3 bool, arg4 uint32, arg5 func(pdbclient.ListItem) error) error {
+	ret := m.ctrl.Call(m, "ListStream", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockClientMockRecorder) ListStream(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockClient)(nil).ListStream), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// Meta mocks base method
+func (m *MockClient) Meta(arg0 context.Context, arg1 paths.Path) (*pb.Pointer, error) {
+	ret := m.ctrl.Call(m, "Meta", arg0, arg1)
+	ret0, _ := ret[0].(*pb.Pointer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Meta indicates an expected call of Meta
+func (mr *MockClientMockRecorder) Meta(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Meta", reflect.TypeOf((*MockClient)(nil).Meta), arg0, arg1)
+}
+
+// Move mocks base method
+func (m *MockClient) Move(arg0 context.Context, arg1, arg2 paths.Path, arg3 []byte) (*pb.Pointer, error) {
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*pb.Pointer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockClientMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockClient)(nil).Move), arg0, arg1, arg2, arg3)
+}
+
+// NodeSegments mocks base method
+func (m *MockClient) NodeSegments(arg0 context.Context, arg1 string, arg2 paths.Path, arg3 int) ([]pdbclient.NodeSegment, bool, error) {
+	ret := m.ctrl.Call(m, "NodeSegments", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]pdbclient.NodeSegment)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// NodeSegments indicates an expected call of NodeSegments
+func (mr *MockClientMockRecorder) NodeSegments(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSegments", reflect.TypeOf((*MockClient)(nil).NodeSegments), arg0, arg1, arg2, arg3)
+}
+
 // Put mocks base method
 func (m *MockClient) Put(arg0 context.Context, arg1 paths.Path, arg2 *pb.Pointer) error {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
@@ -87,3 +219,28 @@ func (m *MockClient) Put(arg0 context.Context, arg1 paths.Path, arg2 *pb.Pointer
 func (mr *MockClientMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockClient)(nil).Put), arg0, arg1, arg2)
 }
+
+// PutWithContentID mocks base method
+func (m *MockClient) PutWithContentID(arg0 context.Context, arg1 paths.Path, arg2 *pb.Pointer, arg3 string) error {
+	ret := m.ctrl.Call(m, "PutWithContentID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutWithContentID indicates an expected call of PutWithContentID
+func (mr *MockClientMockRecorder) PutWithContentID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutWithContentID", reflect.TypeOf((*MockClient)(nil).PutWithContentID), arg0, arg1, arg2, arg3)
+}
+
+// Reference mocks base method
+func (m *MockClient) Reference(arg0 context.Context, arg1 string, arg2 paths.Path, arg3 []byte, arg4 *timestamp.Timestamp) (*pb.Pointer, error) {
+	ret := m.ctrl.Call(m, "Reference", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*pb.Pointer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reference indicates an expected call of Reference
+func (mr *MockClientMockRecorder) Reference(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reference", reflect.TypeOf((*MockClient)(nil).Reference), arg0, arg1, arg2, arg3, arg4)
+}
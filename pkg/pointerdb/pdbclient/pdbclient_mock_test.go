@@ -39,6 +39,96 @@ func (m *MockPointerDBClient) EXPECT() *MockPointerDBClientMockRecorder {
 	return m.recorder
 }
 
+// BatchDelete mocks base method
+func (m *MockPointerDBClient) BatchDelete(arg0 context.Context, arg1 *pb.BatchDeleteRequest, arg2 ...grpc.CallOption) (*pb.BatchDeleteResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchDelete", varargs...)
+	ret0, _ := ret[0].(*pb.BatchDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDelete indicates an expected call of BatchDelete
+func (mr *MockPointerDBClientMockRecorder) BatchDelete(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockPointerDBClient)(nil).BatchDelete), varargs...)
+}
+
+// BatchGet mocks base method
+func (m *MockPointerDBClient) BatchGet(arg0 context.Context, arg1 *pb.BatchGetRequest, arg2 ...grpc.CallOption) (*pb.BatchGetResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchGet", varargs...)
+	ret0, _ := ret[0].(*pb.BatchGetResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchGet indicates an expected call of BatchGet
+func (mr *MockPointerDBClientMockRecorder) BatchGet(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchGet", reflect.TypeOf((*MockPointerDBClient)(nil).BatchGet), varargs...)
+}
+
+// BatchMove mocks base method
+func (m *MockPointerDBClient) BatchMove(arg0 context.Context, arg1 *pb.BatchMoveRequest, arg2 ...grpc.CallOption) (*pb.BatchMoveResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchMove", varargs...)
+	ret0, _ := ret[0].(*pb.BatchMoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMove indicates an expected call of BatchMove
+func (mr *MockPointerDBClientMockRecorder) BatchMove(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMove", reflect.TypeOf((*MockPointerDBClient)(nil).BatchMove), varargs...)
+}
+
+// BatchPut mocks base method
+func (m *MockPointerDBClient) BatchPut(arg0 context.Context, arg1 *pb.BatchPutRequest, arg2 ...grpc.CallOption) (*pb.BatchPutResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchPut", varargs...)
+	ret0, _ := ret[0].(*pb.BatchPutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchPut indicates an expected call of BatchPut
+func (mr *MockPointerDBClientMockRecorder) BatchPut(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchPut", reflect.TypeOf((*MockPointerDBClient)(nil).BatchPut), varargs...)
+}
+
+// Copy mocks base method
+func (m *MockPointerDBClient) Copy(arg0 context.Context, arg1 *pb.CopyRequest, arg2 ...grpc.CallOption) (*pb.CopyResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Copy", varargs...)
+	ret0, _ := ret[0].(*pb.CopyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockPointerDBClientMockRecorder) Copy(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockPointerDBClient)(nil).Copy), varargs...)
+}
+
 // Delete mocks base method
 func (m *MockPointerDBClient) Delete(arg0 context.Context, arg1 *pb.DeleteRequest, arg2 ...grpc.CallOption) (*pb.DeleteResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -93,6 +183,24 @@ func (mr *MockPointerDBClientMockRecorder) List(arg0, arg1 interface{}, arg2 ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPointerDBClient)(nil).List), varargs...)
 }
 
+// ListStream mocks base method
+func (m *MockPointerDBClient) ListStream(arg0 context.Context, arg1 *pb.ListRequest, arg2 ...grpc.CallOption) (pb.PointerDB_ListStreamClient, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStream", varargs...)
+	ret0, _ := ret[0].(pb.PointerDB_ListStreamClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockPointerDBClientMockRecorder) ListStream(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockPointerDBClient)(nil).ListStream), varargs...)
+}
+
 // ReverseList mocks base method
 func (m *MockPointerDBClient) ReverseList(arg0 context.Context, arg1 *pb.ListRequest, arg2 ...grpc.CallOption) (*pb.ListResponse, error) {
 	return m.List(arg0, arg1, arg2...)
@@ -103,6 +211,42 @@ func (mr *MockPointerDBClientMockRecorder) ReverseList(arg0, arg1 interface{}, a
 	return mr.List(arg0, arg1, arg2...)
 }
 
+// Move mocks base method
+func (m *MockPointerDBClient) Move(arg0 context.Context, arg1 *pb.MoveRequest, arg2 ...grpc.CallOption) (*pb.MoveResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Move", varargs...)
+	ret0, _ := ret[0].(*pb.MoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockPointerDBClientMockRecorder) Move(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockPointerDBClient)(nil).Move), varargs...)
+}
+
+// NodeSegments mocks base method
+func (m *MockPointerDBClient) NodeSegments(arg0 context.Context, arg1 *pb.NodeSegmentsRequest, arg2 ...grpc.CallOption) (*pb.NodeSegmentsResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NodeSegments", varargs...)
+	ret0, _ := ret[0].(*pb.NodeSegmentsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NodeSegments indicates an expected call of NodeSegments
+func (mr *MockPointerDBClientMockRecorder) NodeSegments(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeSegments", reflect.TypeOf((*MockPointerDBClient)(nil).NodeSegments), varargs...)
+}
+
 // Put mocks base method
 func (m *MockPointerDBClient) Put(arg0 context.Context, arg1 *pb.PutRequest, arg2 ...grpc.CallOption) (*pb.PutResponse, error) {
 	varargs := []interface{}{arg0, arg1}
@@ -120,3 +264,21 @@ func (mr *MockPointerDBClientMockRecorder) Put(arg0, arg1 interface{}, arg2 ...i
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockPointerDBClient)(nil).Put), varargs...)
 }
+
+// Reference mocks base method
+func (m *MockPointerDBClient) Reference(arg0 context.Context, arg1 *pb.ReferenceRequest, arg2 ...grpc.CallOption) (*pb.ReferenceResponse, error) {
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Reference", varargs...)
+	ret0, _ := ret[0].(*pb.ReferenceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reference indicates an expected call of Reference
+func (mr *MockPointerDBClientMockRecorder) Reference(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reference", reflect.TypeOf((*MockPointerDBClient)(nil).Reference), varargs...)
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"strconv"
+	"strings"
+
+	"storj.io/storj/storage"
+)
+
+// pieceRefPrefix is the reserved key prefix under which the number of
+// pointers referencing the pieces of a copied segment is kept. Pieces without
+// a reference count are referenced by a single pointer.
+const pieceRefPrefix = "refs/"
+
+// isReservedPath returns true if the path is in the reserved key space
+func isReservedPath(path string) bool {
+	return strings.HasPrefix(path, pieceRefPrefix) ||
+		strings.HasPrefix(path, expirationPrefix) ||
+		strings.HasPrefix(path, deletionPrefix) ||
+		strings.HasPrefix(path, contentPrefix) ||
+		strings.HasPrefix(path, projectPrefix) ||
+		strings.HasPrefix(path, nodeIndexPrefix)
+}
+
+func pieceRefKey(pieceID string) storage.Key {
+	return storage.Key(pieceRefPrefix + pieceID)
+}
+
+// getPieceRefs returns the number of pointers referencing the pieces
+func getPieceRefs(t *txn, pieceID string) (int64, error) {
+	value, err := t.Get(pieceRefKey(pieceID))
+	if storage.ErrKeyNotFound.Has(err) {
+		return 1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(string(value), 10, 64)
+}
+
+// addPieceRef records a new pointer referencing the pieces
+func addPieceRef(t *txn, pieceID string) error {
+	refs, err := getPieceRefs(t, pieceID)
+	if err != nil {
+		return err
+	}
+	return t.Put(pieceRefKey(pieceID), storage.Value(strconv.FormatInt(refs+1, 10)))
+}
+
+// removePieceRef removes a pointer referencing the pieces and returns
+// whether other pointers still reference them
+func removePieceRef(t *txn, pieceID string) (inUse bool, err error) {
+	refs, err := getPieceRefs(t, pieceID)
+	if err != nil {
+		return false, err
+	}
+	if refs <= 1 {
+		return false, nil
+	}
+	if refs == 2 {
+		return true, t.Delete(pieceRefKey(pieceID))
+	}
+	return true, t.Put(pieceRefKey(pieceID), storage.Value(strconv.FormatInt(refs-1, 10)))
+}
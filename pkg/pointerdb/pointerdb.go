@@ -5,6 +5,7 @@ package pointerdb
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -14,7 +15,9 @@ import (
 	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/storage/meta"
@@ -32,6 +35,7 @@ type Server struct {
 	logger *zap.Logger
 	config Config
 	cache  *overlay.Cache
+	keys   *auth.KeyStore
 }
 
 // NewServer creates instance of Server
@@ -44,10 +48,39 @@ func NewServer(db storage.KeyValueStore, cache *overlay.Cache, logger *zap.Logge
 	}
 }
 
-func (s *Server) validateAuth(APIKey []byte) error {
-	if !auth.ValidateAPIKey(string(APIKey)) {
-		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
-		return status.Errorf(codes.Unauthenticated, "Invalid API credential")
+// validateAuth checks that the API key allows all actions of the request
+// and returns its authorization, which holds the project of the request
+func (s *Server) validateAuth(ctx context.Context, APIKey []byte, actions ...macaroon.Action) (*auth.Authorization, error) {
+	authorization, err := auth.Check(ctx, s.keys, APIKey, actions...)
+	if err != nil {
+		if !macaroon.ErrUnauthorized.Has(err) {
+			s.logger.Error("err checking api key", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		s.logger.Error("unauthorized request: ", zap.Error(err))
+		return nil, status.Error(codes.Unauthenticated, "Invalid API credential")
+	}
+	return authorization, nil
+}
+
+// pathAction returns the action of the operation on the pointer at path.
+// The first component of the path is the kind of the segment and the second
+// the bucket, followed by the encrypted path of the object.
+func pathAction(op macaroon.Op, path string) macaroon.Action {
+	p := paths.New(path)
+	action := macaroon.Action{Op: op, Time: time.Now()}
+	if len(p) > 1 {
+		action.Bucket = p[1]
+	}
+	if len(p) > 2 {
+		action.EncryptedPath = p[2:].String()
+	}
+	return action
+}
+
+func (s *Server) validatePath(path string) error {
+	if isReservedPath(path) {
+		return status.Errorf(codes.InvalidArgument, "reserved path %q", path)
 	}
 	return nil
 }
@@ -80,28 +113,113 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
 
-	if err = s.validateAuth(req.GetAPIKey()); err != nil {
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpWrite, req.GetPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetPath()); err != nil {
+		return nil, err
+	}
+	path := projectPath(authorization.ProjectID, req.GetPath())
+
+	err = s.update(func(t *txn) error {
+		old, err := s.preparePut(t, path, req)
+		if err != nil {
+			return err
+		}
+		return s.finishPut(t, path, old, req)
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	// Update the pointer with the creation date
+	return &pb.PutResponse{}, nil
+}
+
+// preparePut checks the conditions of the put request against the pointer
+// stored at path, and stores the new pointer with its creation date and
+// version in its place. It returns the replaced pointer, if any.
+func (s *Server) preparePut(t *txn, path string, req *pb.PutRequest) (old *pb.Pointer, err error) {
 	req.GetPointer().CreationDate = ptypes.TimestampNow()
+	return s.prepareReplace(t, path, req)
+}
+
+// prepareReplace is preparePut for a pointer keeping its creation date
+func (s *Server) prepareReplace(t *txn, path string, req *pb.PutRequest) (old *pb.Pointer, err error) {
+	old, err = s.getPointer(t, path)
+	if err != nil && status.Code(err) != codes.NotFound {
+		return nil, err
+	}
+	// an expired pointer counts as gone
+	exists := old != nil && !isExpired(old, time.Now())
+
+	if req.GetIfNotExists() && exists {
+		return nil, status.Error(codes.FailedPrecondition, "pointer already exists")
+	}
+	if req.GetExpectedVersion() != 0 && (!exists || old.Version != req.GetExpectedVersion()) {
+		return nil, status.Error(codes.FailedPrecondition, "pointer version mismatch")
+	}
+
+	req.GetPointer().Version = 1
+	if exists {
+		req.GetPointer().Version = old.Version + 1
+	}
 
 	pointerBytes, err := proto.Marshal(req.GetPointer())
 	if err != nil {
 		s.logger.Error("err marshaling pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
+	return old, t.Put([]byte(path), pointerBytes)
+}
 
-	// TODO(kaloyan): make sure that we know we are overwriting the pointer!
-	// In such case we should delete the pieces of the old segment if it was
-	// a remote one.
-	if err = s.DB.Put([]byte(req.GetPath()), pointerBytes); err != nil {
-		s.logger.Error("err putting pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+// finishPut updates the indexes after the pointer of the put request was
+// stored at path in place of old
+func (s *Server) finishPut(t *txn, path string, old *pb.Pointer, req *pb.PutRequest) error {
+	if err := indexExpiration(t, path, req.GetPointer()); err != nil {
+		s.logger.Error("err indexing pointer expiration", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
 	}
 
-	return &pb.PutResponse{}, nil
+	if err := indexContent(t, req.GetAPIKey(), req.GetContentId(), path, req.GetPointer()); err != nil {
+		s.logger.Error("err indexing pointer content", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if err := reindexNodes(t, path, old, req.GetPointer()); err != nil {
+		s.logger.Error("err indexing pointer nodes", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if old != nil {
+		if err := releaseReplaced(t, path, old, req.GetPointer()); err != nil {
+			s.logger.Error("err releasing replaced pointer", zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+	return nil
+}
+
+// releaseReplaced cleans up after the old pointer at path was overwritten by
+// the new one. The pieces of the old remote segment are queued for deletion
+// from the storage nodes unless they are still referenced.
+func releaseReplaced(t *txn, path string, old, new *pb.Pointer) error {
+	if !expirationDate(old).Equal(expirationDate(new)) {
+		if err := unindexExpiration(t, path, old); err != nil {
+			return err
+		}
+	}
+
+	if old.Remote == nil || old.Remote.PieceId == new.GetRemote().GetPieceId() {
+		return nil
+	}
+
+	inUse, err := removePieceRef(t, old.Remote.PieceId)
+	if err != nil || inUse {
+		return err
+	}
+	return queuePieceDeletion(t, old.Remote)
 }
 
 // Get formats and hands off a file path to get from boltdb
@@ -110,11 +228,22 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 
 	s.logger.Debug("entering pointerdb get")
 
-	if err = s.validateAuth(req.GetAPIKey()); err != nil {
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpRead, req.GetPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetPath()); err != nil {
 		return nil, err
 	}
 
-	pointerBytes, err := s.DB.Get([]byte(req.GetPath()))
+	return s.get(ctx, projectPath(authorization.ProjectID, req.GetPath()), req.GetMetadataOnly())
+}
+
+// get returns the live pointer stored at path and the nodes of its pieces,
+// or the pointer without its pieces and inline data if metadataOnly is true
+func (s *Server) get(ctx context.Context, path string, metadataOnly bool) (*pb.GetResponse, error) {
+	pointerBytes, err := s.DB.Get([]byte(path))
 	if err != nil {
 		if storage.ErrKeyNotFound.Has(err) {
 			return nil, status.Errorf(codes.NotFound, err.Error())
@@ -129,6 +258,13 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		s.logger.Error("Error unmarshaling pointer")
 		return nil, err
 	}
+	// expired pointers are gone for the clients even before they are reaped
+	if isExpired(pointer, time.Now()) {
+		return nil, status.Error(codes.NotFound, "pointer expired")
+	}
+	if metadataOnly {
+		pointer.Remote, pointer.InlineSegment = nil, nil
+	}
 	nodes := []*pb.Node{}
 
 	var r = &pb.GetResponse{
@@ -160,38 +296,69 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(req.APIKey); err != nil {
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpList, req.GetPrefix()))
+	if err != nil {
 		return nil, err
 	}
 
-	var prefix storage.Key
-	if req.Prefix != "" {
-		prefix = storage.Key(req.Prefix)
-		if prefix[len(prefix)-1] != storage.Delimiter {
-			prefix = append(prefix, storage.Delimiter)
-		}
-	}
+	prefix := listPrefix(req.Prefix)
+	// the listing never leaves the namespace of the project
+	dbPrefix := storage.Key(projectPath(authorization.ProjectID, string(prefix)))
 
 	rawItems, more, err := storage.ListV2(s.DB, storage.ListOptions{
-		Prefix:       prefix, //storage.Key(req.Prefix),
+		Prefix:       dbPrefix,
 		StartAfter:   storage.Key(req.StartAfter),
 		EndBefore:    storage.Key(req.EndBefore),
 		Recursive:    req.Recursive,
 		Limit:        int(req.Limit),
-		IncludeValue: req.MetaFlags != meta.None,
+		IncludeValue: true,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "ListV2: %v", err)
 	}
 
+	now := time.Now()
 	var items []*pb.ListResponse_Item
 	for _, rawItem := range rawItems {
-		items = append(items, s.createListItem(rawItem, req.MetaFlags))
+		if s.listed(authorization, prefix, rawItem, now) {
+			items = append(items, s.createListItem(rawItem, req.MetaFlags))
+		}
 	}
 
 	return &pb.ListResponse{Items: items, More: more}, nil
 }
 
+// listPrefix returns the requested prefix of a listing with a trailing
+// delimiter
+func listPrefix(reqPrefix string) storage.Key {
+	var prefix storage.Key
+	if reqPrefix != "" {
+		prefix = storage.Key(reqPrefix)
+		if prefix[len(prefix)-1] != storage.Delimiter {
+			prefix = append(prefix, storage.Delimiter)
+		}
+	}
+	return prefix
+}
+
+// listed reports whether an item of a listing below prefix, with its key
+// relative to the prefix, is listed. Reserved paths, expired pointers and
+// paths the API key may not see are left out.
+func (s *Server) listed(authorization *auth.Authorization, prefix storage.Key, rawItem storage.ListItem, now time.Time) bool {
+	if isReservedPath(rawItem.Key.String()) {
+		return false
+	}
+	if !rawItem.IsPrefix && isExpiredValue(rawItem.Value, now) {
+		return false
+	}
+	path := string(prefix) + rawItem.Key.String()
+	if authorization.Check(pathAction(macaroon.OpList, path)) != nil ||
+		(!rawItem.IsPrefix && authorization.Check(pathAction(macaroon.OpRead, path)) != nil) {
+		return false
+	}
+	return true
+}
+
 // createListItem creates a new list item with the given path. It also adds
 // the metadata according to the given metaFlags.
 func (s *Server) createListItem(rawItem storage.ListItem, metaFlags uint32) *pb.ListResponse_Item {
@@ -238,24 +405,256 @@ func (s *Server) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 	if metaFlags&meta.UserDefined != 0 {
 		item.Pointer.Metadata = pr.GetMetadata()
 	}
+	if metaFlags&meta.Remote != 0 {
+		item.Pointer.Type = pr.GetType()
+		item.Pointer.Remote = pr.GetRemote()
+	}
 
 	return nil
 }
 
-// Delete formats and hands off a file path to delete from boltdb
+// Delete formats and hands off a file path to delete from boltdb. The
+// response tells if the pieces of the deleted pointer are still referenced
+// by a copy of it, so they must not be deleted.
 func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (resp *pb.DeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 	s.logger.Debug("entering pointerdb delete")
 
-	if err = s.validateAuth(req.GetAPIKey()); err != nil {
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(), pathAction(macaroon.OpDelete, req.GetPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetPath()); err != nil {
 		return nil, err
 	}
+	path := projectPath(authorization.ProjectID, req.GetPath())
 
-	err = s.DB.Delete([]byte(req.GetPath()))
+	var inUse bool
+	err = s.update(func(t *txn) (err error) {
+		inUse, err = s.deletePointer(t, path)
+		return err
+	})
 	if err != nil {
-		s.logger.Error("err deleting path and pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
+
 	s.logger.Debug("deleted pointer at path: " + req.GetPath())
-	return &pb.DeleteResponse{}, nil
+	return &pb.DeleteResponse{PiecesInUse: inUse}, nil
+}
+
+// deletePointer deletes the pointer at path, updates the indexes and queues
+// the deletion of its pieces unless they are still in use
+func (s *Server) deletePointer(t *txn, path string) (inUse bool, err error) {
+	pointer, err := s.getPointer(t, path)
+	if err != nil {
+		return false, err
+	}
+	if err = t.Delete([]byte(path)); err != nil {
+		return false, err
+	}
+
+	if err = unindexExpiration(t, path, pointer); err != nil {
+		s.logger.Error("err removing pointer expiration", zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	if err = reindexNodes(t, path, pointer, nil); err != nil {
+		s.logger.Error("err removing pointer nodes", zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	if pointer.Remote == nil {
+		return false, nil
+	}
+	inUse, err = removePieceRef(t, pointer.Remote.PieceId)
+	if err != nil {
+		s.logger.Error("err removing piece reference", zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	if !inUse {
+		if err = queuePieceDeletion(t, pointer.Remote); err != nil {
+			s.logger.Error("err queuing piece deletion", zap.Error(err))
+			return false, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return inUse, nil
+}
+
+// Copy duplicates the pointer at the source path to the destination path
+// with the given metadata. The pieces of a remote pointer are shared by both
+// pointers until the last of them is deleted.
+func (s *Server) Copy(ctx context.Context, req *pb.CopyRequest) (resp *pb.CopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb copy")
+
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(),
+		pathAction(macaroon.OpRead, req.GetSourcePath()),
+		pathAction(macaroon.OpWrite, req.GetDestinationPath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetSourcePath()); err != nil {
+		return nil, err
+	}
+	if err = s.validatePath(req.GetDestinationPath()); err != nil {
+		return nil, err
+	}
+	srcPath := projectPath(authorization.ProjectID, req.GetSourcePath())
+	dstPath := projectPath(authorization.ProjectID, req.GetDestinationPath())
+
+	var pointer *pb.Pointer
+	err = s.update(func(t *txn) (err error) {
+		pointer, err = s.getLivePointer(t, srcPath)
+		if err != nil {
+			return err
+		}
+		pointer.Metadata = req.GetMetadata()
+		return s.putShared(t, dstPath, &pb.PutRequest{Pointer: pointer})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("copied pointer from path: " + req.GetSourcePath() + " to path: " + req.GetDestinationPath())
+	return &pb.CopyResponse{Pointer: pointer}, nil
+}
+
+// putShared stores the pointer of the put request at path, replacing the
+// pointer stored there like Put, and adds a reference to the pieces it
+// shares with another pointer
+func (s *Server) putShared(t *txn, path string, req *pb.PutRequest) error {
+	old, err := s.preparePut(t, path, req)
+	if err != nil {
+		return err
+	}
+
+	// the replaced pointer may already reference the pieces, as when a
+	// pointer is copied onto itself
+	pieceID := req.GetPointer().GetRemote().GetPieceId()
+	if pieceID != "" && pieceID != old.GetRemote().GetPieceId() {
+		if err = addPieceRef(t, pieceID); err != nil {
+			s.logger.Error("err adding piece reference", zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return s.finishPut(t, path, old, req)
+}
+
+// Move moves the pointer at the source path to the destination path with
+// the given metadata. The pieces of the pointer are not touched, and the
+// pointer at the destination path is replaced like by Put.
+func (s *Server) Move(ctx context.Context, req *pb.MoveRequest) (resp *pb.MoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering pointerdb move")
+
+	authorization, err := s.validateAuth(ctx, req.GetAPIKey(),
+		pathAction(macaroon.OpRead, req.GetSourcePath()),
+		pathAction(macaroon.OpWrite, req.GetDestinationPath()),
+		pathAction(macaroon.OpDelete, req.GetSourcePath()))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.validatePath(req.GetSourcePath()); err != nil {
+		return nil, err
+	}
+	if err = s.validatePath(req.GetDestinationPath()); err != nil {
+		return nil, err
+	}
+	srcPath := projectPath(authorization.ProjectID, req.GetSourcePath())
+	dstPath := projectPath(authorization.ProjectID, req.GetDestinationPath())
+
+	var pointer *pb.Pointer
+	err = s.update(func(t *txn) (err error) {
+		pointer, err = s.movePointer(t, srcPath, dstPath, req.GetMetadata())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.logger.Debug("moved pointer from path: " + req.GetSourcePath() + " to path: " + req.GetDestinationPath())
+	return &pb.MoveResponse{Pointer: pointer}, nil
+}
+
+// movePointer moves the pointer at srcPath to dstPath with the metadata and
+// returns it
+func (s *Server) movePointer(t *txn, srcPath, dstPath string, metadata []byte) (*pb.Pointer, error) {
+	pointer, err := s.getLivePointer(t, srcPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pointer.Metadata = metadata
+	putReq := &pb.PutRequest{Pointer: pointer}
+
+	// the moved pointer keeps its creation date
+	old, err := s.prepareReplace(t, dstPath, putReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if srcPath != dstPath {
+		if err = t.Delete([]byte(srcPath)); err != nil {
+			return nil, err
+		}
+
+		if err = unindexExpiration(t, srcPath, pointer); err != nil {
+			s.logger.Error("err removing pointer expiration", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if err = reindexNodes(t, srcPath, pointer, nil); err != nil {
+			s.logger.Error("err removing pointer nodes", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// a replaced copy of the pointer drops its reference, which the
+		// replace path keeps for pointers sharing the pieces
+		if old.GetRemote() != nil && old.Remote.PieceId == pointer.GetRemote().GetPieceId() {
+			if _, err = removePieceRef(t, old.Remote.PieceId); err != nil {
+				s.logger.Error("err removing piece reference", zap.Error(err))
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+	}
+
+	if err = s.finishPut(t, dstPath, old, putReq); err != nil {
+		return nil, err
+	}
+	return pointer, nil
+}
+
+// getPointer returns the pointer stored at the path
+func (s *Server) getPointer(t *txn, path string) (*pb.Pointer, error) {
+	pointerBytes, err := t.Get([]byte(path))
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pointer := &pb.Pointer{}
+	err = proto.Unmarshal(pointerBytes, pointer)
+	if err != nil {
+		s.logger.Error("err unmarshaling pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return pointer, nil
+}
+
+// getLivePointer returns the pointer stored at the path unless it expired
+func (s *Server) getLivePointer(t *txn, path string) (*pb.Pointer, error) {
+	pointer, err := s.getPointer(t, path)
+	if err != nil {
+		return nil, err
+	}
+	if isExpired(pointer, time.Now()) {
+		return nil, status.Error(codes.NotFound, "pointer expired")
+	}
+	return pointer, nil
 }
@@ -7,7 +7,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
 	"testing"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -20,8 +25,10 @@ import (
 
 	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/storage"
+	"storj.io/storj/storage/sqlkv"
 	"storj.io/storj/storage/teststore"
 )
 
@@ -62,6 +69,65 @@ func TestServicePut(t *testing.T) {
 	}
 }
 
+func TestServicePutCompareAndSwap(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	put := func(pieceID string, expectedVersion int64, ifNotExists bool) error {
+		_, err := s.Put(ctx, &pb.PutRequest{
+			Path: "a/b/c",
+			Pointer: &pb.Pointer{
+				Type: pb.Pointer_REMOTE,
+				Remote: &pb.RemoteSegment{
+					PieceId:      pieceID,
+					RemotePieces: []*pb.RemotePiece{{NodeId: "node"}},
+				},
+			},
+			ExpectedVersion: expectedVersion,
+			IfNotExists:     ifNotExists,
+		})
+		return err
+	}
+	version := func() int64 {
+		resp, err := s.Get(ctx, &pb.GetRequest{Path: "a/b/c"})
+		if !assert.NoError(t, err) {
+			return 0
+		}
+		return resp.Pointer.Version
+	}
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(put("first", 1, false)))
+	assert.NoError(t, put("first", 0, true))
+	assert.Equal(t, int64(1), version())
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(put("second", 0, true)))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(put("second", 2, false)))
+	assert.NoError(t, put("second", 1, false))
+	assert.Equal(t, int64(2), version())
+
+	// the pieces of the overwritten pointer are queued for deletion
+	deletions, err := s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []PieceDeletion{{NodeID: "node", PieceID: "first"}}, deletions)
+	}
+
+	// shared pieces are not queued
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	assert.NoError(t, err)
+	assert.NoError(t, put("third", 0, false))
+	assert.Equal(t, int64(3), version())
+
+	deletions, err = s.PieceDeletions(ctx, nil, 10)
+	if assert.NoError(t, err) {
+		assert.Len(t, deletions, 1)
+	}
+
+	listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 2)
+	}
+}
+
 func TestServiceGet(t *testing.T) {
 	for i, tt := range []struct {
 		apiKey    []byte
@@ -116,8 +182,11 @@ func TestServiceDelete(t *testing.T) {
 
 		path := "a/b/c"
 
+		prBytes, err := proto.Marshal(&pb.Pointer{Size: 123})
+		assert.NoError(t, err, errTag)
+
 		db := teststore.New()
-		_ = db.Put(storage.Key(path), storage.Value("hello"))
+		_ = db.Put(storage.Key(path), storage.Value(prBytes))
 		s := Server{DB: db, logger: zap.NewNop()}
 
 		if tt.err != nil {
@@ -125,7 +194,7 @@ func TestServiceDelete(t *testing.T) {
 		}
 
 		req := pb.DeleteRequest{Path: path, APIKey: tt.apiKey}
-		_, err := s.Delete(ctx, &req)
+		_, err = s.Delete(ctx, &req)
 
 		if err != nil {
 			assert.EqualError(t, err, tt.errString, errTag)
@@ -135,6 +204,544 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceCopy(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	pr := &pb.Pointer{
+		Type:     pb.Pointer_REMOTE,
+		Remote:   &pb.RemoteSegment{PieceId: "piece"},
+		Size:     123,
+		Metadata: []byte("source"),
+	}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: pr})
+	assert.NoError(t, err)
+
+	resp, err := s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d", Metadata: []byte("copy")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("copy"), resp.Pointer.Metadata)
+		assert.Equal(t, int64(123), resp.Pointer.Size)
+	}
+
+	// copying a pointer onto itself does not add a reference
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/d", DestinationPath: "a/b/d", Metadata: []byte("copy")})
+	assert.NoError(t, err)
+
+	getResp, err := s.Get(ctx, &pb.GetRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("copy"), getResp.Pointer.Metadata)
+		assert.Equal(t, "piece", getResp.Pointer.Remote.PieceId)
+	}
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/x", DestinationPath: "a/b/y"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: pieceRefPrefix + "piece"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 2)
+	}
+
+	delResp, err := s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	if assert.NoError(t, err) {
+		assert.True(t, delResp.PiecesInUse)
+	}
+
+	delResp, err = s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.False(t, delResp.PiecesInUse)
+	}
+
+	keys, err := db.List(nil, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestServiceCopyReplace(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: nodesPointer("piece", "n1")})
+	assert.NoError(t, err)
+	replaced := nodesPointer("other", "n2")
+	replaced.ExpirationDate = ptypes.TimestampNow()
+	replaced.ExpirationDate.Seconds += 3600
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/d", Pointer: replaced})
+	assert.NoError(t, err)
+
+	// the replaced pointer is released like by a put
+	resp, err := s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), resp.Pointer.Version)
+	}
+	_, err = db.Get(deletionKey("n2", "other"))
+	assert.NoError(t, err)
+	_, err = db.Get(expirationKey(expirationDate(replaced), "a/b/d"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	refs, err := getPieceRefs(newTxn(db), "piece")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), refs)
+
+	// copying onto a copy does not add a reference
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	assert.NoError(t, err)
+	refs, err = getPieceRefs(newTxn(db), "piece")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), refs)
+
+	getResp, err := s.Get(ctx, &pb.GetRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), getResp.Pointer.Version)
+	}
+}
+
+func TestServiceConcurrentChanges(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "storj-pointerdb")
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { _ = os.RemoveAll(tempdir) }()
+
+	for i := 0; i < 50; i++ {
+		// the servers share the database like satellite processes
+		dbURL := "sqlite://" + filepath.Join(tempdir, fmt.Sprintf("pointers%d.db", i))
+		db, err := sqlkv.NewFromURL(dbURL, PointerBucket)
+		if !assert.NoError(t, err) {
+			return
+		}
+		otherDB, err := sqlkv.NewFromURL(dbURL, PointerBucket)
+		if !assert.NoError(t, err) {
+			return
+		}
+		s := &Server{DB: db, logger: zap.NewNop()}
+		other := &Server{DB: otherDB, logger: zap.NewNop()}
+
+		_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: nodesPointer("piece", "n1")})
+		if !assert.NoError(t, err) {
+			return
+		}
+		_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		// the requests race to release the reference of a/b/c
+		var wg sync.WaitGroup
+		for _, change := range []func(){
+			func() { _, _ = s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: nodesPointer("other", "n2")}) },
+			func() { _, _ = other.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"}) },
+			func() { _, _ = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/e"}) },
+			func() { _, _ = other.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"}) },
+		} {
+			wg.Add(1)
+			go func(change func()) {
+				defer wg.Done()
+				change()
+			}(change)
+		}
+		wg.Wait()
+
+		// the pieces of the copy are never queued for deletion and stay
+		// counted for every pointer referencing them
+		_, err = db.Get(deletionKey("n1", "piece"))
+		assert.True(t, storage.ErrKeyNotFound.Has(err), "pieces of the copy queued for deletion")
+
+		var pointers int64
+		for _, path := range []string{"a/b/c", "a/b/d", "a/b/e"} {
+			resp, err := s.Get(ctx, &pb.GetRequest{Path: path})
+			if err == nil && resp.Pointer.GetRemote().GetPieceId() == "piece" {
+				pointers++
+			}
+		}
+		refs, err := getPieceRefs(newTxn(db), "piece")
+		assert.NoError(t, err)
+		assert.Equal(t, pointers, refs)
+		assert.NoError(t, db.Close())
+		assert.NoError(t, otherDB.Close())
+	}
+}
+
+// interleavingStore runs a change of another process before applying the
+// next changes
+type interleavingStore struct {
+	storage.KeyValueStore
+	before func()
+}
+
+func (store *interleavingStore) CompareAndSwap(changes ...storage.Change) error {
+	if before := store.before; before != nil {
+		store.before = nil
+		before()
+	}
+	return store.KeyValueStore.CompareAndSwap(changes...)
+}
+
+func TestServiceConflict(t *testing.T) {
+	db := &interleavingStore{KeyValueStore: teststore.New()}
+	s := Server{DB: db, logger: zap.NewNop()}
+	other := Server{DB: db.KeyValueStore, logger: zap.NewNop()}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: nodesPointer("piece", "n1")})
+	assert.NoError(t, err)
+
+	// the copy is computed from the pointer deleted in the meantime, so it
+	// is retried and finds the pointer gone
+	db.before = func() {
+		_, err := other.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+		assert.NoError(t, err)
+	}
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/b/d"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = db.Get(pieceRefKey("piece"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// a conditional put is checked again against the concurrent put
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/e", Pointer: remotePointer("e")})
+	assert.NoError(t, err)
+	db.before = func() {
+		_, err := other.Put(ctx, &pb.PutRequest{Path: "a/b/e", Pointer: remotePointer("e2")})
+		assert.NoError(t, err)
+	}
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/e", Pointer: remotePointer("e3"), ExpectedVersion: 1})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServiceMove(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	pr := &pb.Pointer{
+		Type:     pb.Pointer_REMOTE,
+		Remote:   &pb.RemoteSegment{PieceId: "piece"},
+		Size:     123,
+		Metadata: []byte("source"),
+	}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: pr})
+	assert.NoError(t, err)
+
+	resp, err := s.Move(ctx, &pb.MoveRequest{SourcePath: "a/b/c", DestinationPath: "x/y/z", Metadata: []byte("moved")})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("moved"), resp.Pointer.Metadata)
+		assert.Equal(t, int64(123), resp.Pointer.Size)
+	}
+
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/b/c"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	getResp, err := s.Get(ctx, &pb.GetRequest{Path: "x/y/z"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("moved"), getResp.Pointer.Metadata)
+		assert.Equal(t, "piece", getResp.Pointer.Remote.PieceId)
+	}
+
+	_, err = s.Move(ctx, &pb.MoveRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Move(ctx, &pb.MoveRequest{SourcePath: "x/y/z", DestinationPath: pieceRefPrefix + "piece"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// moving does not add a reference to the pieces
+	delResp, err := s.Delete(ctx, &pb.DeleteRequest{Path: "x/y/z"})
+	if assert.NoError(t, err) {
+		assert.False(t, delResp.PiecesInUse)
+	}
+
+	keys, err := db.List(nil, 0)
+	assert.NoError(t, err)
+	assert.Empty(t, keys)
+}
+
+func TestServiceMoveReplace(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: nodesPointer("piece", "n1")})
+	assert.NoError(t, err)
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/d", Pointer: nodesPointer("other", "n2")})
+	assert.NoError(t, err)
+
+	// the replaced pointer is released like by a put
+	resp, err := s.Move(ctx, &pb.MoveRequest{SourcePath: "a/b/c", DestinationPath: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(2), resp.Pointer.Version)
+	}
+	_, err = db.Get(deletionKey("n2", "other"))
+	assert.NoError(t, err)
+
+	// moving a copy onto the pointer drops the reference of the copy
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/d", DestinationPath: "a/b/e"})
+	assert.NoError(t, err)
+	_, err = s.Move(ctx, &pb.MoveRequest{SourcePath: "a/b/e", DestinationPath: "a/b/d"})
+	assert.NoError(t, err)
+
+	refs, err := getPieceRefs(newTxn(db), "piece")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), refs)
+	_, err = db.Get(deletionKey("n1", "piece"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	getResp, err := s.Get(ctx, &pb.GetRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(3), getResp.Pointer.Version)
+	}
+}
+
+func TestServiceReference(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	expiration, err := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	pr := &pb.Pointer{
+		Type:           pb.Pointer_REMOTE,
+		Remote:         &pb.RemoteSegment{PieceId: "piece"},
+		Size:           123,
+		ExpirationDate: expiration,
+		Metadata:       []byte("source"),
+	}
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: pr, ContentId: "content"})
+	assert.NoError(t, err)
+
+	_, err = s.Reference(ctx, &pb.ReferenceRequest{ContentId: "other", Path: "a/b/d"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the pieces would be deleted before the new pointer expires
+	_, err = s.Reference(ctx, &pb.ReferenceRequest{ContentId: "content", Path: "a/b/d"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = s.Reference(ctx, &pb.ReferenceRequest{ContentId: "content", Path: contentPrefix + "x"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the replaced pointer is released like by a put
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/d", Pointer: nodesPointer("other", "n2")})
+	assert.NoError(t, err)
+
+	resp, err := s.Reference(ctx, &pb.ReferenceRequest{ContentId: "content", Path: "a/b/d", Metadata: []byte("ref"), ExpirationDate: expiration})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("ref"), resp.Pointer.Metadata)
+		assert.Equal(t, "piece", resp.Pointer.Remote.PieceId)
+		assert.Equal(t, int64(123), resp.Pointer.Size)
+		assert.Equal(t, int64(2), resp.Pointer.Version)
+	}
+	_, err = db.Get(deletionKey("n2", "other"))
+	assert.NoError(t, err)
+
+	// the content index is not listed
+	listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 2)
+	}
+
+	delResp, err := s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	if assert.NoError(t, err) {
+		assert.True(t, delResp.PiecesInUse)
+	}
+
+	// the index entry of the deleted pointer is stale
+	_, err = s.Reference(ctx, &pb.ReferenceRequest{ContentId: "content", Path: "a/b/e", ExpirationDate: expiration})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	delResp, err = s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.False(t, delResp.PiecesInUse)
+	}
+
+	// the stale index entry was removed
+	_, err = db.Get(contentKey(nil, "content"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// content IDs are scoped per API key
+	assert.NotEqual(t, contentKey([]byte("key1"), "content"), contentKey([]byte("key2"), "content"))
+}
+
+func TestServiceAPIKeys(t *testing.T) {
+	db := teststore.New()
+	keys := auth.NewKeyStore(teststore.New())
+	s := Server{DB: db, logger: zap.NewNop(), keys: keys}
+
+	project, err := keys.CreateProject(ctx, "project")
+	if !assert.NoError(t, err) {
+		return
+	}
+	key, err := keys.Create(ctx, project.Id)
+	if !assert.NoError(t, err) {
+		return
+	}
+	apiKey, err := key.Serialize()
+	assert.NoError(t, err)
+
+	restricted, err := key.Restrict(pb.Caveat{
+		DisallowWrites: true,
+		AllowedPaths:   []*pb.Caveat_Path{{Bucket: "bucket", EncryptedPathPrefix: "a"}},
+	})
+	assert.NoError(t, err)
+	restrictedKey, err := restricted.Serialize()
+	assert.NoError(t, err)
+
+	pr := &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{PieceId: "piece"}}
+	for _, path := range []string{"l/bucket/a/x", "l/bucket/b/x", "l/other/a/x"} {
+		_, err = s.Put(ctx, &pb.PutRequest{Path: path, Pointer: pr, APIKey: []byte(apiKey)})
+		assert.NoError(t, err)
+	}
+
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "l/bucket/a/y", Pointer: pr})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "l/bucket/a/y", Pointer: pr, APIKey: []byte(restrictedKey)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/a/x", APIKey: []byte(restrictedKey)})
+	assert.NoError(t, err)
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/b/x", APIKey: []byte(restrictedKey)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "l/bucket/b/x", DestinationPath: "l/bucket/a/z", APIKey: []byte(restrictedKey)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// only the allowed paths are listed
+	listResp, err := s.List(ctx, &pb.ListRequest{Prefix: "l", Recursive: true, APIKey: []byte(restrictedKey)})
+	if assert.NoError(t, err) && assert.Len(t, listResp.Items, 1) {
+		assert.Equal(t, "bucket/a/x", listResp.Items[0].Path)
+	}
+	listResp, err = s.List(ctx, &pb.ListRequest{Prefix: "l/bucket", APIKey: []byte(restrictedKey)})
+	if assert.NoError(t, err) && assert.Len(t, listResp.Items, 1) {
+		assert.Equal(t, "a/", listResp.Items[0].Path)
+	}
+	listResp, err = s.List(ctx, &pb.ListRequest{Prefix: "l", Recursive: true, APIKey: []byte(apiKey)})
+	if assert.NoError(t, err) {
+		assert.Len(t, listResp.Items, 3)
+	}
+
+	// revoking the key revokes all keys restricted from it
+	assert.NoError(t, keys.Revoke(ctx, key.ID()))
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/a/x", APIKey: []byte(restrictedKey)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/a/x", APIKey: []byte(apiKey)})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestServiceProjects(t *testing.T) {
+	db := teststore.New()
+	keys := auth.NewKeyStore(teststore.New())
+	s := Server{DB: db, logger: zap.NewNop(), keys: keys}
+
+	apiKeys := make([][]byte, 2)
+	for i := range apiKeys {
+		project, err := keys.CreateProject(ctx, "project")
+		if !assert.NoError(t, err) {
+			return
+		}
+		key, err := keys.Create(ctx, project.Id)
+		if !assert.NoError(t, err) {
+			return
+		}
+		serialized, err := key.Serialize()
+		assert.NoError(t, err)
+		apiKeys[i] = []byte(serialized)
+	}
+
+	// both projects store a pointer at the same path
+	for i, apiKey := range apiKeys {
+		pr := &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{PieceId: fmt.Sprintf("piece%d", i)}}
+		_, err := s.Put(ctx, &pb.PutRequest{Path: "l/bucket/a", Pointer: pr, APIKey: apiKey})
+		assert.NoError(t, err)
+	}
+	pr := &pb.Pointer{Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{PieceId: "piece"}}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "l/bucket/b", Pointer: pr, APIKey: apiKeys[0]})
+	assert.NoError(t, err)
+
+	for i, apiKey := range apiKeys {
+		getResp, err := s.Get(ctx, &pb.GetRequest{Path: "l/bucket/a", APIKey: apiKey})
+		if assert.NoError(t, err) {
+			assert.Equal(t, fmt.Sprintf("piece%d", i), getResp.Pointer.Remote.PieceId)
+		}
+	}
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/b", APIKey: apiKeys[1]})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// listing never crosses the project
+	for i, expected := range []int{2, 1} {
+		listResp, err := s.List(ctx, &pb.ListRequest{Recursive: true, APIKey: apiKeys[i]})
+		if assert.NoError(t, err) {
+			assert.Len(t, listResp.Items, expected)
+		}
+	}
+
+	_, err = s.Delete(ctx, &pb.DeleteRequest{Path: "l/bucket/a", APIKey: apiKeys[1]})
+	assert.NoError(t, err)
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "l/bucket/a", APIKey: apiKeys[0]})
+	assert.NoError(t, err)
+
+	// the project namespaces cannot be reached by their paths
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "projects/x/l/bucket/a", APIKey: apiKeys[0]})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestServiceExpiration(t *testing.T) {
+	db := teststore.New()
+	s := Server{DB: db, logger: zap.NewNop()}
+
+	now := time.Now()
+	put := func(path string, expiration time.Time) {
+		pr := &pb.Pointer{
+			Type:   pb.Pointer_REMOTE,
+			Remote: &pb.RemoteSegment{PieceId: path},
+		}
+		if !expiration.IsZero() {
+			var err error
+			pr.ExpirationDate, err = ptypes.TimestampProto(expiration)
+			assert.NoError(t, err)
+		}
+		_, err := s.Put(ctx, &pb.PutRequest{Path: path, Pointer: pr})
+		assert.NoError(t, err)
+	}
+
+	put("a/expired", now.Add(-time.Hour))
+	put("a/expiring", now.Add(time.Hour))
+	put("a/forever", time.Time{})
+	put("a/deleted", now.Add(-time.Hour))
+	_, err := s.Delete(ctx, &pb.DeleteRequest{Path: "a/deleted"})
+	assert.NoError(t, err)
+
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/expired"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = s.Get(ctx, &pb.GetRequest{Path: "a/expiring"})
+	assert.NoError(t, err)
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/expired", DestinationPath: "a/copy"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	listResp, err := s.List(ctx, &pb.ListRequest{Prefix: "a", Recursive: true})
+	if assert.NoError(t, err) && assert.Len(t, listResp.Items, 2) {
+		assert.Equal(t, "expiring", listResp.Items[0].Path)
+		assert.Equal(t, "forever", listResp.Items[1].Path)
+	}
+
+	processed, err := s.ReapExpired(ctx, now, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	_, err = db.Get(storage.Key("a/expired"))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// the pointer expiring later is reaped only after it expired
+	processed, err = s.ReapExpired(ctx, now.Add(2*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+
+	keys, err := db.List(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Keys{storage.Key("a/forever")}, keys)
+}
+
 func TestServiceList(t *testing.T) {
 	db := teststore.New()
 	server := Server{DB: db, logger: zap.NewNop()}
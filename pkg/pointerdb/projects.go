@@ -0,0 +1,23 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+// projectPrefix is the reserved key prefix under which the pointers of the
+// projects are kept, as projects/<project id>/<path>. The pointers of the
+// shared API key, which has no project, are kept at their paths.
+const projectPrefix = "projects/"
+
+// projectPath returns the key of the pointer at path in the project
+func projectPath(projectID, path string) string {
+	if projectID == "" {
+		return path
+	}
+	return projectPrefix + projectID + "/" + path
+}
+
+// PointerKey returns the key of the pointer at path in the project, which is
+// the path WalkNodeSegments passes to its function
+func PointerKey(projectID, path string) string {
+	return projectPath(projectID, path)
+}
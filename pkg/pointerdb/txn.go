@@ -0,0 +1,112 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/storage"
+)
+
+// maxTxnAttempts is the number of times an operation is tried before giving
+// up on concurrent operations changing the same keys
+const maxTxnAttempts = 10
+
+// keyWriter changes the keys of a store or of a transaction
+type keyWriter interface {
+	Put(storage.Key, storage.Value) error
+	Delete(storage.Key) error
+}
+
+// txn collects the changes of an operation on the pointers, which are
+// applied to the store at once. The keys read through the transaction must
+// keep their values until then, so the changes are computed from a
+// consistent state even if other satellite processes share the store.
+type txn struct {
+	db      storage.KeyValueStore
+	changes []storage.Change
+	// keys maps the keys to the index of their change
+	keys map[string]int
+}
+
+func newTxn(db storage.KeyValueStore) *txn {
+	return &txn{db: db, keys: map[string]int{}}
+}
+
+// Get returns the value of the key as changed by the transaction, or reads
+// it from the store and checks that it does not change until the
+// transaction is applied
+func (t *txn) Get(key storage.Key) (storage.Value, error) {
+	if i, ok := t.keys[string(key)]; ok {
+		change := &t.changes[i]
+		if change.Delete {
+			return nil, storage.ErrKeyNotFound.New("%s", key.String())
+		}
+		return storage.CloneValue(change.Value), nil
+	}
+
+	value, err := t.db.Get(key)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, err
+	}
+	// the value is written back unchanged unless the transaction changes it
+	t.keys[string(key)] = len(t.changes)
+	t.changes = append(t.changes, storage.Change{
+		Key:    storage.CloneKey(key),
+		Value:  value,
+		Delete: value == nil,
+		Check:  true,
+		Old:    value,
+	})
+	return storage.CloneValue(value), err
+}
+
+// Put sets the value of the key when the transaction is applied
+func (t *txn) Put(key storage.Key, value storage.Value) error {
+	change := t.change(key)
+	change.Value, change.Delete = storage.CloneValue(value), false
+	return nil
+}
+
+// Delete deletes the key when the transaction is applied
+func (t *txn) Delete(key storage.Key) error {
+	change := t.change(key)
+	change.Value, change.Delete = nil, true
+	return nil
+}
+
+func (t *txn) change(key storage.Key) *storage.Change {
+	i, ok := t.keys[string(key)]
+	if !ok {
+		i = len(t.changes)
+		t.keys[string(key)] = i
+		t.changes = append(t.changes, storage.Change{Key: storage.CloneKey(key)})
+	}
+	return &t.changes[i]
+}
+
+// update runs fn with a new transaction and applies its changes. When a key
+// read by fn changed in the meantime, fn runs again with the new values.
+func (s *Server) update(fn func(t *txn) error) error {
+	for attempt := 1; ; attempt++ {
+		t := newTxn(s.DB)
+		if err := fn(t); err != nil {
+			return err
+		}
+
+		err := s.DB.CompareAndSwap(t.changes...)
+		if err == nil {
+			return nil
+		}
+		if !storage.ErrValueChanged.Has(err) {
+			s.logger.Error("err applying changes", zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+		if attempt == maxTxnAttempts {
+			return status.Error(codes.Aborted, "too many concurrent changes")
+		}
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pointerdb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/storage"
+)
+
+// WalkPointers calls fn with every pointer of the store that has not expired,
+// along with the ID of its project and its path in the project. The pointers
+// of the shared API key have no project ID. Like ListStream, the store is read
+// in batches, so the walk does not keep a transaction of the store open.
+func WalkPointers(ctx context.Context, db storage.KeyValueStore, fn func(projectID, path string, pointer *pb.Pointer) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var first storage.Key
+	skipFirst := false
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		batch, done, err := listBatch(db, nil, first, skipFirst, true)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		now := time.Now()
+		for _, item := range batch {
+			projectID, path := "", item.Key.String()
+			if strings.HasPrefix(path, projectPrefix) {
+				parts := strings.SplitN(strings.TrimPrefix(path, projectPrefix), "/", 2)
+				if len(parts) < 2 {
+					continue
+				}
+				projectID, path = parts[0], parts[1]
+			}
+			if isReservedPath(path) {
+				continue
+			}
+
+			pointer := &pb.Pointer{}
+			if err = proto.Unmarshal(item.Value, pointer); err != nil {
+				return Error.Wrap(err)
+			}
+			if isExpired(pointer, now) {
+				continue
+			}
+			if err = fn(projectID, path, pointer); err != nil {
+				return err
+			}
+		}
+		if done || len(batch) == 0 {
+			return nil
+		}
+		first, skipFirst = batch[len(batch)-1].Key, true
+	}
+}
+
+// SharedPieces returns the IDs of the pieces referenced by several pointers,
+// as after a copy. Like WalkPointers, the store is read in batches.
+func SharedPieces(ctx context.Context, db storage.KeyValueStore) (pieceIDs map[string]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceIDs = map[string]bool{}
+	var first storage.Key
+	skipFirst := false
+	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		batch, done, err := listBatch(db, storage.Key(pieceRefPrefix), first, skipFirst, true)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		for _, item := range batch {
+			pieceIDs[strings.TrimPrefix(item.Key.String(), pieceRefPrefix)] = true
+		}
+		if done || len(batch) == 0 {
+			return pieceIDs, nil
+		}
+		first, skipFirst = batch[len(batch)-1].Key, true
+	}
+}
@@ -37,6 +37,8 @@ type Provider struct {
 	g        *grpc.Server
 	next     []Responsibility
 	identity *FullIdentity
+	unary    []grpc.UnaryServerInterceptor
+	stream   []grpc.StreamServerInterceptor
 }
 
 // NewProvider creates a Provider out of an Identity, a net.Listener, and a set
@@ -49,16 +51,17 @@ func NewProvider(identity *FullIdentity, lis net.Listener,
 		return nil, err
 	}
 
-	return &Provider{
-		lis: lis,
-		g: grpc.NewServer(
-			grpc.StreamInterceptor(streamInterceptor),
-			grpc.UnaryInterceptor(unaryInterceptor),
-			ident,
-		),
+	p := &Provider{
+		lis:      lis,
 		next:     responsibilities,
 		identity: identity,
-	}, nil
+	}
+	p.g = grpc.NewServer(
+		grpc.StreamInterceptor(p.streamInterceptor),
+		grpc.UnaryInterceptor(p.unaryInterceptor),
+		ident,
+	)
+	return p, nil
 }
 
 // SetupIdentity ensures a CA and identity exist and returns a config overrides map
@@ -95,6 +98,18 @@ func (p *Provider) Identity() *FullIdentity { return p.identity }
 // GRPC returns the provider's gRPC server for registration purposes
 func (p *Provider) GRPC() *grpc.Server { return p.g }
 
+// AddInterceptors makes the gRPC server call the interceptors around every
+// request, inside the interceptors added before them. Either may be nil.
+// Responsibilities add them before the server runs.
+func (p *Provider) AddInterceptors(unary grpc.UnaryServerInterceptor, stream grpc.StreamServerInterceptor) {
+	if unary != nil {
+		p.unary = append(p.unary, unary)
+	}
+	if stream != nil {
+		p.stream = append(p.stream, stream)
+	}
+}
+
 // Close shuts down the provider
 func (p *Provider) Close() error {
 	p.g.GracefulStop()
@@ -116,14 +131,22 @@ func (p *Provider) Run(ctx context.Context) (err error) {
 	return p.g.Serve(p.lis)
 }
 
-func streamInterceptor(srv interface{}, ss grpc.ServerStream,
+func (p *Provider) streamInterceptor(srv interface{}, ss grpc.ServerStream,
 	info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	for i := len(p.stream) - 1; i >= 0; i-- {
+		interceptor, next := p.stream[i], handler
+		handler = func(srv interface{}, ss grpc.ServerStream) error {
+			return interceptor(srv, ss, info, next)
+		}
+	}
+
 	err = handler(srv, ss)
 	if err != nil {
-		// no zap errors for canceled or wrong file downloads
+		// no zap errors for canceled, rate limited or wrong file downloads
 		if storage.ErrKeyNotFound.Has(err) ||
 			status.Code(err) == codes.Canceled ||
 			status.Code(err) == codes.Unavailable ||
+			status.Code(err) == codes.ResourceExhausted ||
 			err == io.EOF {
 			return err
 		}
@@ -132,13 +155,21 @@ func streamInterceptor(srv interface{}, ss grpc.ServerStream,
 	return err
 }
 
-func unaryInterceptor(ctx context.Context, req interface{},
+func (p *Provider) unaryInterceptor(ctx context.Context, req interface{},
 	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{},
 	err error) {
+	for i := len(p.unary) - 1; i >= 0; i-- {
+		interceptor, next := p.unary[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+
 	resp, err = handler(ctx, req)
 	if err != nil {
-		// no zap errors for wrong file downloads
-		if status.Code(err) == codes.NotFound {
+		// no zap errors for rate limited requests or wrong file downloads
+		if status.Code(err) == codes.NotFound ||
+			status.Code(err) == codes.ResourceExhausted {
 			return resp, err
 		}
 		zap.S().Errorf("%+v", err)
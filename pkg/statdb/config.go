@@ -8,25 +8,53 @@ import (
 
 	"go.uber.org/zap"
 
+	"storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/provider"
 	pb "storj.io/storj/pkg/statdb/proto"
 )
 
+// CtxKey used for assigning the statdb server
+type CtxKey int
+
+const (
+	ctxKeyStatDB CtxKey = iota
+)
+
 // Config is a configuration struct that is everything you need to start a
 // StatDB responsibility
 type Config struct {
 	DatabaseURL    string `help:"the database connection string to use" default:"$CONFDIR/stats.db"`
 	DatabaseDriver string `help:"the database driver to use" default:"sqlite3"`
+	AdminKey       string `help:"the key required by the RPCs changing the node stats" default:""`
+	ReadOnly       bool   `help:"serve only the RPCs reading the node stats, which need no admin key" default:"false"`
 }
 
 // Run implements the provider.Responsibility interface
 func (c Config) Run(ctx context.Context, server *provider.Provider) error {
+	if c.AdminKey == "" && !c.ReadOnly {
+		return Error.New("an admin key is required to change the node stats, or the stats must be read only")
+	}
+
 	ns, err := NewServer(c.DatabaseDriver, c.DatabaseURL, zap.L())
 	if err != nil {
 		return err
 	}
 
+	ns.keys = auth.LoadFromContext(ctx)
+	if !c.ReadOnly {
+		ns.adminKey = []byte(c.AdminKey)
+	}
+
 	pb.RegisterStatDBServer(server.GRPC(), ns)
 
-	return server.Run(ctx)
+	return server.Run(context.WithValue(ctx, ctxKeyStatDB, ns))
+}
+
+// LoadFromContext gives access to the statdb server from the context, or
+// returns nil
+func LoadFromContext(ctx context.Context) *Server {
+	if v, ok := ctx.Value(ctxKeyStatDB).(*Server); ok {
+		return v
+	}
+	return nil
 }
@@ -0,0 +1,151 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	dbx "storj.io/storj/pkg/statdb/dbx"
+	pb "storj.io/storj/pkg/statdb/proto"
+)
+
+// NodeState is whether a storagenode can be expected to serve its pieces
+type NodeState int
+
+const (
+	// NodeOnline nodes passed their last uptime check
+	NodeOnline NodeState = iota
+	// NodeOffline nodes failed their last uptime check
+	NodeOffline
+	// NodeDisqualified nodes will never be trusted with their pieces again
+	NodeDisqualified
+)
+
+// String implements fmt.Stringer
+func (state NodeState) String() string {
+	switch state {
+	case NodeOnline:
+		return "online"
+	case NodeOffline:
+		return "offline"
+	case NodeDisqualified:
+		return "disqualified"
+	}
+	return "unknown"
+}
+
+// NodeEvent reports that a storagenode changed its state
+type NodeEvent struct {
+	NodeID string
+	State  NodeState
+}
+
+// Subscribe makes the server call fn with every change of the state of a
+// node. fn is called from the RPC making the change, so it must not block.
+func (s *Server) Subscribe(fn func(NodeEvent)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscribers = append(s.subscribers, fn)
+}
+
+// publish records the state of the node and notifies the subscribers if it
+// changed. Nodes are online until they are reported otherwise, and
+// disqualified nodes stay disqualified.
+func (s *Server) publish(nodeID string, state NodeState) {
+	s.mu.Lock()
+	if s.states == nil {
+		s.states = map[string]NodeState{}
+	}
+	old := s.states[nodeID]
+	if old == state || old == NodeDisqualified {
+		s.mu.Unlock()
+		return
+	}
+	s.states[nodeID] = state
+	subscribers := append([]func(NodeEvent){}, s.subscribers...)
+	s.mu.Unlock()
+
+	s.logger.Info("node state changed", zap.String("node", nodeID), zap.Stringer("state", state))
+	for _, fn := range subscribers {
+		fn(NodeEvent{NodeID: nodeID, State: state})
+	}
+}
+
+// disqualifiedSchema keeps the disqualified nodes next to the node stats.
+// It is a table of its own so that it is added to existing databases.
+const disqualifiedSchema = `CREATE TABLE IF NOT EXISTS disqualified_nodes (
+	id TEXT NOT NULL,
+	disqualified_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);`
+
+// loadDisqualified creates the table of the disqualified nodes if needed and
+// restores their state
+func (s *Server) loadDisqualified() error {
+	if _, err := s.DB.Exec(disqualifiedSchema); err != nil {
+		return err
+	}
+
+	rows, err := s.DB.Query("SELECT id FROM disqualified_nodes")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.states == nil {
+		s.states = map[string]NodeState{}
+	}
+	for rows.Next() {
+		var nodeID string
+		if err = rows.Scan(&nodeID); err != nil {
+			return err
+		}
+		s.states[nodeID] = NodeDisqualified
+	}
+	return rows.Err()
+}
+
+// isNoRows checks if err is a dbx error for a missing row
+func isNoRows(err error) bool {
+	dbxErr, ok := err.(*dbx.Error)
+	return ok && dbxErr.Code == dbx.ErrorCode_NoRows
+}
+
+// Disqualify a storagenode, so its pieces are repaired elsewhere. Only the
+// admin key of the satellite may disqualify nodes.
+func (s *Server) Disqualify(ctx context.Context, disqualifyReq *pb.DisqualifyRequest) (resp *pb.DisqualifyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+	s.logger.Debug("entering statdb Disqualify")
+
+	if err = s.validateAdminKey(disqualifyReq.APIKey); err != nil {
+		return nil, err
+	}
+
+	nodeID := string(disqualifyReq.NodeId)
+	_, err = s.DB.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID))
+	if err != nil {
+		if isNoRows(err) {
+			return nil, status.Error(codes.NotFound, "node not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	_, err = s.DB.Exec(s.DB.Rebind(`INSERT INTO disqualified_nodes (id, disqualified_at)
+		SELECT ?, ? WHERE NOT EXISTS (SELECT 1 FROM disqualified_nodes WHERE id = ?)`),
+		nodeID, time.Now().UTC(), nodeID)
+	if err != nil {
+		s.logger.Error("err persisting disqualification", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	s.publish(nodeID, NodeDisqualified)
+
+	return &pb.DisqualifyResponse{}, nil
+}
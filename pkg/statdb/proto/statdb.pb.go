@@ -517,6 +517,84 @@ func (m *UpdateBatchResponse) GetStatsList() []*NodeStats {
 	return nil
 }
 
+// DisqualifyRequest is a request message for the Disqualify rpc call
+type DisqualifyRequest struct {
+	NodeId               []byte   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	APIKey               []byte   `protobuf:"bytes,2,opt,name=APIKey,proto3" json:"APIKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisqualifyRequest) Reset()         { *m = DisqualifyRequest{} }
+func (m *DisqualifyRequest) String() string { return proto.CompactTextString(m) }
+func (*DisqualifyRequest) ProtoMessage()    {}
+func (*DisqualifyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a368771650b1cdca, []int{10}
+}
+func (m *DisqualifyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifyRequest.Unmarshal(m, b)
+}
+func (m *DisqualifyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifyRequest.Marshal(b, m, deterministic)
+}
+func (dst *DisqualifyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifyRequest.Merge(dst, src)
+}
+func (m *DisqualifyRequest) XXX_Size() int {
+	return xxx_messageInfo_DisqualifyRequest.Size(m)
+}
+func (m *DisqualifyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifyRequest proto.InternalMessageInfo
+
+func (m *DisqualifyRequest) GetNodeId() []byte {
+	if m != nil {
+		return m.NodeId
+	}
+	return nil
+}
+
+func (m *DisqualifyRequest) GetAPIKey() []byte {
+	if m != nil {
+		return m.APIKey
+	}
+	return nil
+}
+
+// DisqualifyResponse is a response message for the Disqualify rpc call
+type DisqualifyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisqualifyResponse) Reset()         { *m = DisqualifyResponse{} }
+func (m *DisqualifyResponse) String() string { return proto.CompactTextString(m) }
+func (*DisqualifyResponse) ProtoMessage()    {}
+func (*DisqualifyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a368771650b1cdca, []int{11}
+}
+func (m *DisqualifyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisqualifyResponse.Unmarshal(m, b)
+}
+func (m *DisqualifyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisqualifyResponse.Marshal(b, m, deterministic)
+}
+func (dst *DisqualifyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisqualifyResponse.Merge(dst, src)
+}
+func (m *DisqualifyResponse) XXX_Size() int {
+	return xxx_messageInfo_DisqualifyResponse.Size(m)
+}
+func (m *DisqualifyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisqualifyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisqualifyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Node)(nil), "statdb.Node")
 	proto.RegisterType((*NodeStats)(nil), "statdb.NodeStats")
@@ -528,6 +606,8 @@ func init() {
 	proto.RegisterType((*UpdateResponse)(nil), "statdb.UpdateResponse")
 	proto.RegisterType((*UpdateBatchRequest)(nil), "statdb.UpdateBatchRequest")
 	proto.RegisterType((*UpdateBatchResponse)(nil), "statdb.UpdateBatchResponse")
+	proto.RegisterType((*DisqualifyRequest)(nil), "statdb.DisqualifyRequest")
+	proto.RegisterType((*DisqualifyResponse)(nil), "statdb.DisqualifyResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -550,6 +630,8 @@ type StatDBClient interface {
 	Update(ctx context.Context, in *UpdateRequest, opts ...grpc.CallOption) (*UpdateResponse, error)
 	// UpdateBatch updates storagenode stats for multiple farmers at a time
 	UpdateBatch(ctx context.Context, in *UpdateBatchRequest, opts ...grpc.CallOption) (*UpdateBatchResponse, error)
+	// Disqualify marks a storagenode as permanently unfit to store pieces
+	Disqualify(ctx context.Context, in *DisqualifyRequest, opts ...grpc.CallOption) (*DisqualifyResponse, error)
 }
 
 type statDBClient struct {
@@ -596,6 +678,15 @@ func (c *statDBClient) UpdateBatch(ctx context.Context, in *UpdateBatchRequest,
 	return out, nil
 }
 
+func (c *statDBClient) Disqualify(ctx context.Context, in *DisqualifyRequest, opts ...grpc.CallOption) (*DisqualifyResponse, error) {
+	out := new(DisqualifyResponse)
+	err := c.cc.Invoke(ctx, "/statdb.StatDB/Disqualify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatDBServer is the server API for StatDB service.
 type StatDBServer interface {
 	// Create a db entry for the provided storagenode ID
@@ -606,6 +697,8 @@ type StatDBServer interface {
 	Update(context.Context, *UpdateRequest) (*UpdateResponse, error)
 	// UpdateBatch updates storagenode stats for multiple farmers at a time
 	UpdateBatch(context.Context, *UpdateBatchRequest) (*UpdateBatchResponse, error)
+	// Disqualify marks a storagenode as permanently unfit to store pieces
+	Disqualify(context.Context, *DisqualifyRequest) (*DisqualifyResponse, error)
 }
 
 func RegisterStatDBServer(s *grpc.Server, srv StatDBServer) {
@@ -684,6 +777,24 @@ func _StatDB_UpdateBatch_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatDB_Disqualify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisqualifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBServer).Disqualify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/statdb.StatDB/Disqualify",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBServer).Disqualify(ctx, req.(*DisqualifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StatDB_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "statdb.StatDB",
 	HandlerType: (*StatDBServer)(nil),
@@ -704,6 +815,10 @@ var _StatDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBatch",
 			Handler:    _StatDB_UpdateBatch_Handler,
 		},
+		{
+			MethodName: "Disqualify",
+			Handler:    _StatDB_Disqualify_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "statdb.proto",
@@ -712,37 +827,39 @@ var _StatDB_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("statdb.proto", fileDescriptor_a368771650b1cdca) }
 
 var fileDescriptor_a368771650b1cdca = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
+	// 535 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6a, 0xdb, 0x40,
-	0x10, 0x45, 0x91, 0xac, 0xc4, 0x23, 0x39, 0x90, 0x71, 0x9b, 0x0a, 0x97, 0x82, 0xaa, 0x50, 0xea,
-	0x5e, 0x8c, 0x49, 0xa1, 0xc1, 0x87, 0x1e, 0x92, 0x96, 0x1a, 0xd3, 0x50, 0xca, 0x06, 0xd3, 0xa3,
-	0x50, 0xac, 0x85, 0x0a, 0x52, 0x4b, 0xf5, 0x8e, 0x0e, 0xf9, 0x91, 0x7e, 0x68, 0x8f, 0x3d, 0x95,
-	0x9d, 0x5d, 0x61, 0x29, 0x8d, 0x0f, 0x86, 0x1e, 0xf7, 0xbd, 0xd9, 0xf7, 0xde, 0xbe, 0xb1, 0x0c,
-	0xa1, 0xa2, 0x8c, 0xf2, 0xdb, 0x49, 0xb5, 0x29, 0xa9, 0x44, 0xdf, 0x9c, 0x92, 0x3f, 0x0e, 0x78,
-	0x5f, 0xca, 0x5c, 0xe2, 0x33, 0x38, 0x5c, 0x97, 0xb9, 0x4c, 0x8b, 0x3c, 0x72, 0x62, 0x67, 0x1c,
-	0x0a, 0x5f, 0x1f, 0x17, 0x39, 0xbe, 0x84, 0xf0, 0x2e, 0x23, 0xb9, 0x5e, 0xdd, 0xa7, 0x77, 0x85,
-	0xa2, 0xe8, 0x20, 0x76, 0xc7, 0xae, 0x08, 0x2c, 0x76, 0x5d, 0x28, 0xc2, 0x33, 0x18, 0x64, 0x75,
-	0x5e, 0x50, 0xaa, 0xea, 0xd5, 0x4a, 0x2a, 0x15, 0xb9, 0xb1, 0x33, 0x3e, 0x12, 0x21, 0x83, 0x37,
-	0x06, 0xc3, 0x21, 0xf4, 0x0a, 0x95, 0xd6, 0x55, 0xe4, 0x31, 0xe9, 0x15, 0x6a, 0x59, 0xe1, 0x2b,
-	0x38, 0xae, 0xab, 0x3c, 0x23, 0x99, 0x5a, 0xbd, 0xa8, 0xc7, 0xec, 0xc0, 0xa0, 0xd7, 0x06, 0xc4,
-	0x29, 0x3c, 0xb1, 0x63, 0x5d, 0x1f, 0x9f, 0x87, 0xd1, 0x70, 0x97, 0x6d, 0xb7, 0x33, 0xb0, 0x12,
-	0x69, 0x5d, 0x51, 0xf1, 0x43, 0x46, 0x87, 0x26, 0x92, 0x01, 0x97, 0x8c, 0x25, 0xbf, 0x1c, 0xe8,
-	0xeb, 0xc7, 0xdf, 0x50, 0x46, 0x6a, 0x77, 0x03, 0x2f, 0x00, 0x9a, 0x06, 0x66, 0xd3, 0xe8, 0x20,
-	0x76, 0xc6, 0xae, 0xe8, 0x5b, 0x64, 0x36, 0xc5, 0x09, 0x0c, 0x3b, 0xa9, 0xd2, 0x4d, 0x46, 0x45,
-	0xc9, 0x1d, 0x38, 0xe2, 0xa4, 0xdd, 0x81, 0xd0, 0x84, 0x2e, 0xd4, 0x64, 0xb2, 0x83, 0x1e, 0x0f,
-	0x06, 0x06, 0xe3, 0x91, 0x64, 0x01, 0x83, 0x0f, 0x1b, 0x99, 0x91, 0x14, 0xf2, 0x67, 0x2d, 0x15,
-	0x61, 0x0c, 0x9e, 0x0e, 0xc3, 0xc1, 0x82, 0xf3, 0x70, 0x62, 0x77, 0xa9, 0xc3, 0x0b, 0x66, 0xf0,
-	0x14, 0xfc, 0xcb, 0xaf, 0x8b, 0xcf, 0xf2, 0x9e, 0x03, 0x86, 0xc2, 0x9e, 0x92, 0x19, 0x1c, 0x37,
-	0x52, 0xaa, 0x2a, 0xd7, 0x4a, 0xe2, 0x6b, 0xe8, 0xe9, 0xeb, 0xca, 0x8a, 0x9d, 0xb4, 0xc5, 0xb8,
-	0x09, 0x61, 0xf8, 0xe4, 0x3d, 0xc0, 0x5c, 0x52, 0x13, 0x61, 0x67, 0x3d, 0xbb, 0x9c, 0xdf, 0x41,
-	0xc0, 0xd7, 0xf7, 0xb5, 0x5d, 0xc0, 0x60, 0xc9, 0x5b, 0xfa, 0x2f, 0x8f, 0x6f, 0xa4, 0xf6, 0x4d,
-	0xf1, 0x0d, 0xd0, 0x5c, 0xbd, 0xca, 0x68, 0xf5, 0xbd, 0x89, 0xf2, 0x06, 0xfa, 0x5c, 0x02, 0x7f,
-	0x09, 0x4e, 0xec, 0xfe, 0x93, 0xe7, 0x48, 0xd3, 0xfc, 0x51, 0xec, 0xca, 0x34, 0x87, 0x61, 0x47,
-	0xd8, 0x06, 0x9b, 0x02, 0xb0, 0x71, 0x5b, 0xfa, 0x91, 0x74, 0x7d, 0x1e, 0xd2, 0x06, 0xe7, 0xbf,
-	0x1d, 0xf0, 0x35, 0xf8, 0xf1, 0x0a, 0x2f, 0xc0, 0x37, 0x4b, 0xc6, 0xa7, 0xcd, 0x95, 0xce, 0xef,
-	0x67, 0x74, 0xfa, 0x10, 0xb6, 0xae, 0x13, 0x70, 0xe7, 0x92, 0x10, 0x1b, 0x7a, 0xbb, 0xef, 0xd1,
-	0xb0, 0x83, 0xd9, 0xf9, 0x0b, 0xf0, 0x4d, 0xf8, 0xad, 0x51, 0x67, 0x57, 0x5b, 0xa3, 0x07, 0xbd,
-	0x7f, 0x82, 0xa0, 0xf5, 0x6a, 0x1c, 0x75, 0xc7, 0xda, 0x1d, 0x8f, 0x9e, 0x3f, 0xca, 0x19, 0x9d,
-	0x5b, 0x9f, 0xff, 0xbe, 0xde, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x3d, 0x96, 0xbe, 0xeb, 0xce,
-	0x04, 0x00, 0x00,
+	0x14, 0x44, 0x96, 0xad, 0xc4, 0xcf, 0x72, 0xc0, 0xcf, 0xa9, 0xab, 0xaa, 0x14, 0x54, 0x85, 0x52,
+	0xf7, 0x62, 0x4c, 0x0a, 0x0d, 0x3e, 0xf4, 0x90, 0xc4, 0xd4, 0x98, 0x86, 0x52, 0x14, 0x4c, 0x8f,
+	0x42, 0xb1, 0xb6, 0x74, 0xc1, 0xb5, 0x14, 0xef, 0xea, 0xe0, 0x1f, 0xe9, 0xaf, 0xf4, 0xdf, 0x7a,
+	0x2a, 0xfb, 0x76, 0x85, 0xa5, 0xc4, 0x3e, 0xa4, 0xf4, 0xb8, 0x33, 0xb3, 0xf3, 0x66, 0x67, 0x57,
+	0x02, 0x57, 0xc8, 0x44, 0xa6, 0x77, 0xa3, 0x7c, 0x93, 0xc9, 0x0c, 0x1d, 0xbd, 0x0a, 0xff, 0x58,
+	0xd0, 0xfc, 0x92, 0xa5, 0x0c, 0x9f, 0xc3, 0xd1, 0x3a, 0x4b, 0x59, 0xcc, 0x53, 0xcf, 0x0a, 0xac,
+	0xa1, 0x1b, 0x39, 0x6a, 0x39, 0x4f, 0xf1, 0x35, 0xb8, 0xab, 0x44, 0xb2, 0xf5, 0x72, 0x1b, 0xaf,
+	0xb8, 0x90, 0x5e, 0x23, 0xb0, 0x87, 0x76, 0xd4, 0x31, 0xd8, 0x0d, 0x17, 0x12, 0xcf, 0xa0, 0x9b,
+	0x14, 0x29, 0x97, 0xb1, 0x28, 0x96, 0x4b, 0x26, 0x84, 0x67, 0x07, 0xd6, 0xf0, 0x38, 0x72, 0x09,
+	0xbc, 0xd5, 0x18, 0xf6, 0xa1, 0xc5, 0x45, 0x5c, 0xe4, 0x5e, 0x93, 0xc8, 0x26, 0x17, 0x8b, 0x1c,
+	0xdf, 0xc0, 0x49, 0x91, 0xa7, 0x89, 0x64, 0xb1, 0xf1, 0xf3, 0x5a, 0xc4, 0x76, 0x35, 0x7a, 0xa3,
+	0x41, 0x1c, 0xc3, 0xa9, 0x91, 0xd5, 0xe7, 0x38, 0x24, 0x46, 0xcd, 0x5d, 0x56, 0xa7, 0x9d, 0x81,
+	0xb1, 0x88, 0x8b, 0x5c, 0xf2, 0x9f, 0xcc, 0x3b, 0xd2, 0x91, 0x34, 0xb8, 0x20, 0x2c, 0xfc, 0x65,
+	0x41, 0x5b, 0x1d, 0xfe, 0x56, 0x26, 0x52, 0x1c, 0x6e, 0xe0, 0x15, 0x40, 0xd9, 0xc0, 0x64, 0xec,
+	0x35, 0x02, 0x6b, 0x68, 0x47, 0x6d, 0x83, 0x4c, 0xc6, 0x38, 0x82, 0x7e, 0x2d, 0x55, 0xbc, 0x49,
+	0x24, 0xcf, 0xa8, 0x03, 0x2b, 0xea, 0x55, 0x3b, 0x88, 0x14, 0xa1, 0x0a, 0xd5, 0x99, 0x8c, 0xb0,
+	0x49, 0xc2, 0x8e, 0xc6, 0x48, 0x12, 0xce, 0xa1, 0x7b, 0xbd, 0x61, 0x89, 0x64, 0x11, 0xbb, 0x2f,
+	0x98, 0x90, 0x18, 0x40, 0x53, 0x85, 0xa1, 0x60, 0x9d, 0x73, 0x77, 0x64, 0xee, 0x52, 0x85, 0x8f,
+	0x88, 0xc1, 0x01, 0x38, 0x97, 0x5f, 0xe7, 0x9f, 0xd9, 0x96, 0x02, 0xba, 0x91, 0x59, 0x85, 0x13,
+	0x38, 0x29, 0xad, 0x44, 0x9e, 0xad, 0x05, 0xc3, 0xb7, 0xd0, 0x52, 0xdb, 0x85, 0x31, 0xeb, 0x55,
+	0xcd, 0xa8, 0x89, 0x48, 0xf3, 0xe1, 0x47, 0x80, 0x19, 0x93, 0x65, 0x84, 0x83, 0xf5, 0x1c, 0x9a,
+	0xfc, 0x01, 0x3a, 0xb4, 0xfd, 0xa9, 0x63, 0xe7, 0xd0, 0x5d, 0xd0, 0x2d, 0xfd, 0x97, 0xc3, 0x97,
+	0x56, 0x4f, 0x4d, 0xf1, 0x0d, 0x50, 0x6f, 0xbd, 0x4a, 0xe4, 0xf2, 0x47, 0x19, 0xe5, 0x1d, 0xb4,
+	0xa9, 0x04, 0xfa, 0x12, 0xac, 0xc0, 0x7e, 0x94, 0xe7, 0x58, 0xd1, 0xf4, 0x51, 0x1c, 0xca, 0x34,
+	0x83, 0x7e, 0xcd, 0xd8, 0x04, 0x1b, 0x03, 0xd0, 0xe0, 0xaa, 0xf5, 0x9e, 0x74, 0x6d, 0x12, 0xa9,
+	0x01, 0xe1, 0x14, 0x7a, 0x53, 0x2e, 0xee, 0x8b, 0x64, 0xc5, 0xbf, 0x6f, 0xff, 0xf9, 0x96, 0x4e,
+	0x01, 0xab, 0x2e, 0x3a, 0xcd, 0xf9, 0xef, 0x06, 0x38, 0x6a, 0xe0, 0xf4, 0x0a, 0x2f, 0xc0, 0xd1,
+	0x0f, 0x08, 0x9f, 0x95, 0x71, 0x6a, 0x6f, 0xd3, 0x1f, 0x3c, 0x84, 0xcd, 0x89, 0x46, 0x60, 0xcf,
+	0x98, 0x44, 0x2c, 0xe9, 0xdd, 0x5b, 0xf2, 0xfb, 0x35, 0xcc, 0xe8, 0x2f, 0xc0, 0xd1, 0xc5, 0xec,
+	0x06, 0xd5, 0xde, 0x81, 0x3f, 0x78, 0x08, 0x9b, 0x8d, 0x9f, 0xa0, 0x53, 0x69, 0x14, 0xfd, 0xba,
+	0xac, 0x7a, 0x7f, 0xfe, 0xcb, 0xbd, 0x9c, 0xf1, 0xb9, 0x06, 0xd8, 0x55, 0x81, 0x2f, 0x4a, 0xe9,
+	0xa3, 0x92, 0x7d, 0x7f, 0x1f, 0xa5, 0x4d, 0xee, 0x1c, 0xfa, 0xbf, 0xbe, 0xff, 0x3b, 0x00, 0x92,
+	0x55, 0x74, 0xda, 0x6f, 0x05, 0x00, 0x00,
 }
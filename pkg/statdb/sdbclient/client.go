@@ -30,6 +30,7 @@ type Client interface {
 	Update(ctx context.Context, nodeID []byte, auditSuccess, isUp bool, latencyList []int64,
 		updateAuditSuccess, updateUptime, updateLatency bool) (*pb.NodeStats, error)
 	UpdateBatch(ctx context.Context, nodes []*pb.Node) ([]*pb.NodeStats, error)
+	Disqualify(ctx context.Context, nodeID []byte) error
 }
 
 // NewClient initializes a new statdb client
@@ -130,3 +131,17 @@ func (sdb *StatDB) UpdateBatch(ctx context.Context, nodes []*pb.Node) (statsList
 
 	return res.StatsList, err
 }
+
+// Disqualify is used for marking a node as unfit to store pieces, so that its
+// pieces are repaired
+func (sdb *StatDB) Disqualify(ctx context.Context, nodeID []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	disqualifyReq := &pb.DisqualifyRequest{
+		NodeId: nodeID,
+		APIKey: sdb.APIKey,
+	}
+	_, err = sdb.grpcClient.Disqualify(ctx, disqualifyReq)
+
+	return err
+}
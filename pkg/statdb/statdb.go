@@ -5,13 +5,17 @@ package statdb
 
 import (
 	"context"
+	"crypto/subtle"
 	"strings"
+	"sync"
+	"time"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/macaroon"
 	"storj.io/storj/pkg/pointerdb/auth"
 	dbx "storj.io/storj/pkg/statdb/dbx"
 	pb "storj.io/storj/pkg/statdb/proto"
@@ -23,8 +27,14 @@ var (
 
 // Server implements the statdb RPC service
 type Server struct {
-	DB     *dbx.DB
-	logger *zap.Logger
+	DB       *dbx.DB
+	logger   *zap.Logger
+	keys     *auth.KeyStore
+	adminKey []byte
+
+	mu          sync.Mutex
+	states      map[string]NodeState
+	subscribers []func(NodeEvent)
 }
 
 // NewServer creates instance of Server
@@ -39,16 +49,44 @@ func NewServer(driver, source string, logger *zap.Logger) (*Server, error) {
 		return nil, err
 	}
 
-	return &Server{
+	s := &Server{
 		DB:     db,
 		logger: logger,
-	}, nil
+	}
+	if err = s.loadDisqualified(); err != nil {
+		return nil, err
+	}
+	return s, nil
 }
 
-func (s *Server) validateAuth(APIKeyBytes []byte) error {
-	if !auth.ValidateAPIKey(string(APIKeyBytes)) {
-		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
-		return status.Errorf(codes.Unauthenticated, "Invalid API credential")
+// validateAdminKey checks that the key is the admin key of the satellite,
+// which is required to change the node stats. Without an admin key the stats
+// are read only.
+func (s *Server) validateAdminKey(key []byte) error {
+	if len(s.adminKey) == 0 {
+		return status.Error(codes.Unimplemented, "the node stats are read only")
+	}
+	if subtle.ConstantTimeCompare(s.adminKey, key) != 1 {
+		s.logger.Error("unauthorized statdb admin request")
+		return status.Error(codes.Unauthenticated, "Invalid admin credential")
+	}
+	return nil
+}
+
+// validateAuth checks that the API key, or the admin key, allows the
+// operation, which does not concern any path
+func (s *Server) validateAuth(ctx context.Context, APIKeyBytes []byte, op macaroon.Op) error {
+	if len(s.adminKey) > 0 && subtle.ConstantTimeCompare(s.adminKey, APIKeyBytes) == 1 {
+		return nil
+	}
+	_, err := auth.Check(ctx, s.keys, APIKeyBytes, macaroon.Action{Op: op, Time: time.Now()})
+	if err != nil {
+		if !macaroon.ErrUnauthorized.Has(err) {
+			s.logger.Error("err checking api key", zap.Error(err))
+			return status.Error(codes.Internal, err.Error())
+		}
+		s.logger.Error("unauthorized request: ", zap.Error(err))
+		return status.Error(codes.Unauthenticated, "Invalid API credential")
 	}
 	return nil
 }
@@ -58,8 +96,7 @@ func (s *Server) Create(ctx context.Context, createReq *pb.CreateRequest) (resp
 	defer mon.Task()(&ctx)(&err)
 	s.logger.Debug("entering statdb Create")
 
-	APIKeyBytes := createReq.APIKey
-	if err := s.validateAuth(APIKeyBytes); err != nil {
+	if err := s.validateAdminKey(createReq.APIKey); err != nil {
 		return nil, err
 	}
 
@@ -99,7 +136,7 @@ func (s *Server) Get(ctx context.Context, getReq *pb.GetRequest) (resp *pb.GetRe
 	s.logger.Debug("entering statdb Get")
 
 	APIKeyBytes := getReq.APIKey
-	err = s.validateAuth(APIKeyBytes)
+	err = s.validateAuth(ctx, APIKeyBytes, macaroon.OpRead)
 	if err != nil {
 		return nil, err
 	}
@@ -124,8 +161,7 @@ func (s *Server) Update(ctx context.Context, updateReq *pb.UpdateRequest) (resp
 	defer mon.Task()(&ctx)(&err)
 	s.logger.Debug("entering statdb Update")
 
-	APIKeyBytes := updateReq.APIKey
-	err = s.validateAuth(APIKeyBytes)
+	err = s.validateAdminKey(updateReq.APIKey)
 	if err != nil {
 		return nil, err
 	}
@@ -174,6 +210,14 @@ func (s *Server) Update(ctx context.Context, updateReq *pb.UpdateRequest) (resp
 		return nil, status.Errorf(codes.Internal, err.Error())
 	}
 
+	if node.UpdateUptime {
+		if node.IsUp {
+			s.publish(dbNode.Id, NodeOnline)
+		} else {
+			s.publish(dbNode.Id, NodeOffline)
+		}
+	}
+
 	nodeStats := &pb.NodeStats{
 		NodeId:            []byte(dbNode.Id),
 		AuditSuccessRatio: dbNode.AuditSuccessRatio,
@@ -191,6 +235,10 @@ func (s *Server) UpdateBatch(ctx context.Context, updateBatchReq *pb.UpdateBatch
 	s.logger.Debug("entering statdb UpdateBatch")
 
 	APIKeyBytes := updateBatchReq.APIKey
+	if err = s.validateAdminKey(APIKeyBytes); err != nil {
+		return nil, err
+	}
+
 	nodeStatsList := make([]*pb.NodeStats, len(updateBatchReq.NodeList))
 	for i, node := range updateBatchReq.NodeList {
 		updateReq := &pb.UpdateRequest{
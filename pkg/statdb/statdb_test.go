@@ -12,13 +12,16 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	dbx "storj.io/storj/pkg/statdb/dbx"
 	pb "storj.io/storj/pkg/statdb/proto"
 )
 
 var (
-	ctx = context.Background()
+	ctx      = context.Background()
+	adminKey = []byte("admin")
 )
 
 func TestCreateExists(t *testing.T) {
@@ -26,7 +29,7 @@ func TestCreateExists(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 	node := &pb.Node{
 		NodeId:             nodeID,
@@ -56,7 +59,7 @@ func TestCreateDuplicate(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 
 	auditSuccessCount, totalAuditCount, auditRatio := getRatio(4, 10)
@@ -83,7 +86,7 @@ func TestGetExists(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 
 	auditSuccessCount, totalAuditCount, auditRatio := getRatio(4, 10)
@@ -110,7 +113,7 @@ func TestGetDoesNotExist(t *testing.T) {
 	statdb, _, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 
 	getReq := &pb.GetRequest{
@@ -126,7 +129,7 @@ func TestUpdateExists(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 
 	auditSuccessCount, totalAuditCount, auditRatio := getRatio(4, 10)
@@ -161,7 +164,7 @@ func TestUpdateDoesNotExist(t *testing.T) {
 	statdb, _, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID := []byte("testnodeid")
 
 	node := &pb.Node{
@@ -184,7 +187,7 @@ func TestUpdateBatchExists(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID1 := []byte("testnodeid1")
 	nodeID2 := []byte("testnodeid2")
 
@@ -235,7 +238,7 @@ func TestUpdateBatchDoesNotExist(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID1 := []byte("testnodeid1")
 	nodeID2 := []byte("testnodeid2")
 
@@ -271,7 +274,7 @@ func TestUpdateBatchEmpty(t *testing.T) {
 	statdb, db, err := getServerAndDB(dbPath)
 	assert.NoError(t, err)
 
-	apiKey := []byte("")
+	apiKey := adminKey
 	nodeID1 := []byte("testnodeid1")
 
 	auditSuccessCount1, totalAuditCount1, auditRatio1 := getRatio(4, 10)
@@ -289,6 +292,92 @@ func TestUpdateBatchEmpty(t *testing.T) {
 	assert.Equal(t, len(resp.StatsList), 0)
 }
 
+func TestNodeEvents(t *testing.T) {
+	dbPath := getDBPath()
+	statdb, db, err := getServerAndDB(dbPath)
+	assert.NoError(t, err)
+
+	apiKey := adminKey
+	nodeID := []byte("testnodeid")
+	err = createNode(ctx, db, nodeID, 0, 0, 0, 0, 0, 0)
+	assert.NoError(t, err)
+
+	var events []NodeState
+	statdb.Subscribe(func(event NodeEvent) {
+		assert.Equal(t, string(nodeID), event.NodeID)
+		events = append(events, event.State)
+	})
+
+	for _, isUp := range []bool{true, false, false, true, false} {
+		updateReq := &pb.UpdateRequest{
+			Node:   &pb.Node{NodeId: nodeID, UpdateUptime: true, IsUp: isUp},
+			APIKey: apiKey,
+		}
+		_, err = statdb.Update(ctx, updateReq)
+		assert.NoError(t, err)
+	}
+	_, err = statdb.Disqualify(ctx, &pb.DisqualifyRequest{NodeId: nodeID, APIKey: apiKey})
+	assert.NoError(t, err)
+
+	// disqualified nodes stay disqualified
+	updateReq := &pb.UpdateRequest{
+		Node:   &pb.Node{NodeId: nodeID, UpdateUptime: true, IsUp: true},
+		APIKey: apiKey,
+	}
+	_, err = statdb.Update(ctx, updateReq)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []NodeState{NodeOffline, NodeOnline, NodeOffline, NodeDisqualified}, events)
+
+	_, err = statdb.Disqualify(ctx, &pb.DisqualifyRequest{NodeId: []byte("unknown"), APIKey: apiKey})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the disqualification is persisted with the node stats
+	restarted, _, err := getServerAndDB(dbPath)
+	if !assert.NoError(t, err) {
+		return
+	}
+	restarted.Subscribe(func(event NodeEvent) {
+		t.Errorf("unexpected event %v", event)
+	})
+	_, err = restarted.Update(ctx, updateReq)
+	assert.NoError(t, err)
+	_, err = restarted.Disqualify(ctx, &pb.DisqualifyRequest{NodeId: nodeID, APIKey: apiKey})
+	assert.NoError(t, err)
+}
+
+func TestAdminKeyRequired(t *testing.T) {
+	dbPath := getDBPath()
+	statdb, db, err := getServerAndDB(dbPath)
+	assert.NoError(t, err)
+
+	nodeID := []byte("testnodeid")
+	err = createNode(ctx, db, nodeID, 0, 0, 0, 0, 0, 0)
+	assert.NoError(t, err)
+
+	// the shared api key may read the stats but not change them
+	apiKey := []byte("")
+	_, err = statdb.Get(ctx, &pb.GetRequest{NodeId: nodeID, APIKey: apiKey})
+	assert.NoError(t, err)
+
+	_, err = statdb.Create(ctx, &pb.CreateRequest{Node: &pb.Node{NodeId: []byte("other")}, APIKey: apiKey})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	node := &pb.Node{NodeId: nodeID, UpdateUptime: true}
+	_, err = statdb.Update(ctx, &pb.UpdateRequest{Node: node, APIKey: apiKey})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = statdb.UpdateBatch(ctx, &pb.UpdateBatchRequest{NodeList: []*pb.Node{node}, APIKey: apiKey})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = statdb.Disqualify(ctx, &pb.DisqualifyRequest{NodeId: nodeID, APIKey: apiKey})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// without an admin key the stats cannot be changed at all
+	statdb.adminKey = nil
+	_, err = statdb.Update(ctx, &pb.UpdateRequest{Node: node, APIKey: apiKey})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+	_, err = statdb.Update(ctx, &pb.UpdateRequest{Node: node, APIKey: adminKey})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
+
 func getDBPath() string {
 	return fmt.Sprintf("file:memdb%d?mode=memory&cache=shared", rand.Int63())
 }
@@ -298,6 +387,7 @@ func getServerAndDB(path string) (statdb *Server, db *dbx.DB, err error) {
 	if err != nil {
 		return &Server{}, &dbx.DB{}, err
 	}
+	statdb.adminKey = adminKey
 	db, err = dbx.Open("sqlite3", path)
 	if err != nil {
 		return &Server{}, &dbx.DB{}, err
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	paths "storj.io/storj/pkg/paths"
 	buckets "storj.io/storj/pkg/storage/buckets"
 	objects "storj.io/storj/pkg/storage/objects"
 )
@@ -36,6 +37,19 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// CopyObject mocks base method
+func (m *MockStore) CopyObject(arg0 context.Context, arg1 string, arg2 paths.Path, arg3 string, arg4 paths.Path, arg5 objects.SerializableMeta) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "CopyObject", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyObject indicates an expected call of CopyObject
+func (mr *MockStoreMockRecorder) CopyObject(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyObject", reflect.TypeOf((*MockStore)(nil).CopyObject), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Delete mocks base method
 func (m *MockStore) Delete(arg0 context.Context, arg1 string) error {
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
@@ -88,15 +102,54 @@ func (mr *MockStoreMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List), arg0, arg1, arg2, arg3)
 }
 
+// MoveObject mocks base method
+func (m *MockStore) MoveObject(arg0 context.Context, arg1 string, arg2 paths.Path, arg3 string, arg4 paths.Path) (objects.Meta, error) {
+	ret := m.ctrl.Call(m, "MoveObject", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(objects.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveObject indicates an expected call of MoveObject
+func (mr *MockStoreMockRecorder) MoveObject(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveObject", reflect.TypeOf((*MockStore)(nil).MoveObject), arg0, arg1, arg2, arg3, arg4)
+}
+
 // Put mocks base method
-func (m *MockStore) Put(arg0 context.Context, arg1 string) (buckets.Meta, error) {
-	ret := m.ctrl.Call(m, "Put", arg0, arg1)
+func (m *MockStore) Put(arg0 context.Context, arg1 string, arg2 paths.Cipher) (buckets.Meta, error) {
+	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
 	ret0, _ := ret[0].(buckets.Meta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put
-func (mr *MockStoreMockRecorder) Put(arg0, arg1 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1)
+func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2)
+}
+
+// SetVersioning mocks base method
+func (m *MockStore) SetVersioning(arg0 context.Context, arg1 string, arg2 buckets.Versioning) (buckets.Meta, error) {
+	ret := m.ctrl.Call(m, "SetVersioning", arg0, arg1, arg2)
+	ret0, _ := ret[0].(buckets.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetVersioning indicates an expected call of SetVersioning
+func (mr *MockStoreMockRecorder) SetVersioning(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVersioning", reflect.TypeOf((*MockStore)(nil).SetVersioning), arg0, arg1, arg2)
+}
+
+// Share mocks base method
+func (m *MockStore) Share(arg0 context.Context, arg1 string, arg2 paths.Path) (buckets.Scope, error) {
+	ret := m.ctrl.Call(m, "Share", arg0, arg1, arg2)
+	ret0, _ := ret[0].(buckets.Scope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Share indicates an expected call of Share
+func (mr *MockStoreMockRecorder) Share(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Share", reflect.TypeOf((*MockStore)(nil).Share), arg0, arg1, arg2)
 }
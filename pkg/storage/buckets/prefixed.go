@@ -10,12 +10,82 @@ import (
 
 	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/storage"
 )
 
+// prefixedObjStore is the store of the objects in a bucket. The paths below
+// pathPrefix are encrypted with pathKey, and the store has no access to the
+// paths that are not below it.
 type prefixedObjStore struct {
-	o      objects.Store
-	prefix string
+	o                   objects.Store
+	prefix              string
+	pathPrefix          paths.Path
+	encryptedPathPrefix paths.Path
+	pathCipher          paths.Cipher
+	pathKey             []byte
+	versioning          Versioning
+}
+
+// encryptPath encrypts the path with the bucket's cipher
+func (o *prefixedObjStore) encryptPath(path paths.Path) (paths.Path, error) {
+	if !path.HasPrefix(o.pathPrefix) {
+		return nil, ScopeError.New("%s/%s", o.prefix, path)
+	}
+	encrypted, err := path[len(o.pathPrefix):].EncryptWith(o.pathCipher, o.pathKey)
+	if err != nil {
+		return nil, err
+	}
+	return o.encryptedPathPrefix.Append(encrypted...), nil
+}
+
+// decryptPath decrypts the path encrypted with the bucket's cipher
+func (o *prefixedObjStore) decryptPath(encrypted paths.Path) (paths.Path, error) {
+	if !encrypted.HasPrefix(o.encryptedPathPrefix) {
+		return nil, ScopeError.New("%s/%s", o.prefix, encrypted)
+	}
+	decrypted, err := encrypted[len(o.encryptedPathPrefix):].DecryptWith(o.pathCipher, o.pathKey)
+	if err != nil {
+		return nil, err
+	}
+	return o.pathPrefix.Append(decrypted...), nil
+}
+
+// fullPath returns the path of the object in the underlying store, which is
+// the bucket followed by the encrypted object path
+func (o *prefixedObjStore) fullPath(path paths.Path) (paths.Path, error) {
+	encrypted, err := o.encryptPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return encrypted.Prepend(o.prefix), nil
+}
+
+// encryptRelative encrypts the path relative to the given prefix, the way it
+// is encrypted as part of the full path
+func (o *prefixedObjStore) encryptRelative(prefix, path paths.Path) (paths.Path, error) {
+	if len(path) == 0 {
+		return path, nil
+	}
+	encrypted, err := o.encryptPath(prefix.Append(path...))
+	if err != nil {
+		return nil, err
+	}
+	return encrypted[len(prefix):], nil
+}
+
+// decryptItems decrypts the listed paths, which are relative to the
+// encrypted prefix
+func (o *prefixedObjStore) decryptItems(prefix, encryptedPrefix paths.Path, items []objects.ListItem) error {
+	for i, item := range items {
+		decrypted, err := o.decryptPath(encryptedPrefix.Append(item.Path...))
+		if err != nil {
+			return err
+		}
+		items[i].Path = decrypted[len(prefix):]
+	}
+	return nil
 }
 
 func (o *prefixedObjStore) Meta(ctx context.Context, path paths.Path) (meta objects.Meta,
@@ -26,7 +96,12 @@ func (o *prefixedObjStore) Meta(ctx context.Context, path paths.Path) (meta obje
 		return objects.Meta{}, objects.NoPathError.New("")
 	}
 
-	m, err := o.o.Meta(ctx, path.Prepend(o.prefix))
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	m, err := o.o.Meta(ctx, fullPath)
 	return m, err
 }
 
@@ -38,7 +113,12 @@ func (o *prefixedObjStore) Get(ctx context.Context, path paths.Path) (
 		return nil, objects.Meta{}, objects.NoPathError.New("")
 	}
 
-	rr, m, err := o.o.Get(ctx, path.Prepend(o.prefix))
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return nil, objects.Meta{}, err
+	}
+
+	rr, m, err := o.o.Get(ctx, fullPath)
 	return rr, m, err
 }
 
@@ -50,7 +130,26 @@ func (o *prefixedObjStore) Put(ctx context.Context, path paths.Path, data io.Rea
 		return objects.Meta{}, objects.NoPathError.New("")
 	}
 
-	m, err := o.o.Put(ctx, path.Prepend(o.prefix), data, metadata, expiration)
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	if o.versioning.Enabled {
+		_, err = o.o.Archive(ctx, fullPath)
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return objects.Meta{}, err
+		}
+	}
+
+	m, err := o.o.Put(ctx, fullPath, data, metadata, expiration)
+	if err != nil {
+		return m, err
+	}
+
+	if o.versioning.Enabled && o.versioning.Retention > 0 {
+		err = o.pruneVersions(ctx, path)
+	}
 	return m, err
 }
 
@@ -61,12 +160,329 @@ func (o *prefixedObjStore) Delete(ctx context.Context, path paths.Path) (err err
 		return objects.NoPathError.New("")
 	}
 
-	return o.o.Delete(ctx, path.Prepend(o.prefix))
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return err
+	}
+
+	if o.versioning.Enabled {
+		// keep the deleted object as a previous version
+		_, err = o.o.Archive(ctx, fullPath)
+		return err
+	}
+
+	return o.o.Delete(ctx, fullPath)
+}
+
+func (o *prefixedObjStore) Copy(ctx context.Context, srcPath, dstPath paths.Path,
+	metadata objects.SerializableMeta) (meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(srcPath) == 0 || len(dstPath) == 0 {
+		return objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	srcFullPath, err := o.fullPath(srcPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+	dstFullPath, err := o.fullPath(dstPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return o.o.Copy(ctx, srcFullPath, dstFullPath, metadata)
 }
 
+func (o *prefixedObjStore) Move(ctx context.Context, srcPath, dstPath paths.Path) (
+	meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(srcPath) == 0 || len(dstPath) == 0 {
+		return objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	srcFullPath, err := o.fullPath(srcPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+	dstFullPath, err := o.fullPath(dstPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return o.o.Move(ctx, srcFullPath, dstFullPath)
+}
+
+// List lists the objects below prefix. The listing is ordered by the
+// encrypted paths, unless the paths of the bucket are not encrypted.
 func (o *prefixedObjStore) List(ctx context.Context, prefix, startAfter,
 	endBefore paths.Path, recursive bool, limit int, metaFlags uint32) (
 	items []objects.ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return o.o.List(ctx, prefix.Prepend(o.prefix), startAfter, endBefore, recursive, limit, metaFlags)
+
+	encryptedPrefix, err := o.encryptPath(prefix)
+	if err != nil {
+		return nil, false, err
+	}
+	startAfter, err = o.encryptRelative(prefix, startAfter)
+	if err != nil {
+		return nil, false, err
+	}
+	endBefore, err = o.encryptRelative(prefix, endBefore)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items, more, err = o.o.List(ctx, encryptedPrefix.Prepend(o.prefix), startAfter, endBefore, recursive, limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = o.decryptItems(prefix, encryptedPrefix, items)
+	if err != nil {
+		return nil, false, err
+	}
+	return items, more, nil
+}
+
+func (o *prefixedObjStore) ListStream(ctx context.Context, prefix,
+	startAfter paths.Path, recursive bool, metaFlags uint32,
+	fn func(item objects.ListItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedPrefix, err := o.encryptPath(prefix)
+	if err != nil {
+		return err
+	}
+	startAfter, err = o.encryptRelative(prefix, startAfter)
+	if err != nil {
+		return err
+	}
+
+	return o.o.ListStream(ctx, encryptedPrefix.Prepend(o.prefix), startAfter, recursive, metaFlags,
+		func(item objects.ListItem) error {
+			items := []objects.ListItem{item}
+			if err := o.decryptItems(prefix, encryptedPrefix, items); err != nil {
+				return err
+			}
+			return fn(items[0])
+		})
+}
+
+func (o *prefixedObjStore) Archive(ctx context.Context, path paths.Path) (
+	meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return o.o.Archive(ctx, fullPath)
+}
+
+func (o *prefixedObjStore) GetVersion(ctx context.Context, path paths.Path,
+	version string) (rr ranger.Ranger, meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return nil, objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return nil, objects.Meta{}, err
+	}
+
+	return o.o.GetVersion(ctx, fullPath, version)
+}
+
+func (o *prefixedObjStore) MetaVersion(ctx context.Context, path paths.Path,
+	version string) (meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return o.o.MetaVersion(ctx, fullPath, version)
+}
+
+func (o *prefixedObjStore) DeleteVersion(ctx context.Context, path paths.Path,
+	version string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return err
+	}
+
+	return o.o.DeleteVersion(ctx, fullPath, version)
+}
+
+// ListVersions lists the previous versions of the objects below prefix. The
+// last component of startAfter and endBefore is a version ID, which is not
+// encrypted.
+func (o *prefixedObjStore) ListVersions(ctx context.Context, prefix, startAfter,
+	endBefore paths.Path, limit int, metaFlags uint32) (
+	items []objects.ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedPrefix, err := o.encryptPath(prefix)
+	if err != nil {
+		return nil, false, err
+	}
+	startAfter, err = o.encryptVersionPath(prefix, startAfter)
+	if err != nil {
+		return nil, false, err
+	}
+	endBefore, err = o.encryptVersionPath(prefix, endBefore)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items, more, err = o.o.ListVersions(ctx, encryptedPrefix.Prepend(o.prefix), startAfter, endBefore, limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = o.decryptItems(prefix, encryptedPrefix, items)
+	if err != nil {
+		return nil, false, err
+	}
+	return items, more, nil
+}
+
+// Resume continues the failed upload of the object at path. A previous
+// version was already archived when the upload was started.
+func (o *prefixedObjStore) Resume(ctx context.Context, path paths.Path, data io.Reader,
+	metadata objects.SerializableMeta, expiration time.Time) (meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return objects.Meta{}, objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	m, err := o.o.Resume(ctx, fullPath, data, metadata, expiration)
+	if err != nil {
+		return m, err
+	}
+
+	if o.versioning.Enabled && o.versioning.Retention > 0 {
+		err = o.pruneVersions(ctx, path)
+	}
+	return m, err
+}
+
+func (o *prefixedObjStore) Abort(ctx context.Context, path paths.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return objects.NoPathError.New("")
+	}
+
+	fullPath, err := o.fullPath(path)
+	if err != nil {
+		return err
+	}
+
+	return o.o.Abort(ctx, fullPath)
+}
+
+// ListPending lists the objects below prefix whose uploads can be resumed,
+// in the order of the List
+func (o *prefixedObjStore) ListPending(ctx context.Context, prefix, startAfter,
+	endBefore paths.Path, recursive bool, limit int) (
+	items []objects.ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encryptedPrefix, err := o.encryptPath(prefix)
+	if err != nil {
+		return nil, false, err
+	}
+	startAfter, err = o.encryptRelative(prefix, startAfter)
+	if err != nil {
+		return nil, false, err
+	}
+	endBefore, err = o.encryptRelative(prefix, endBefore)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items, more, err = o.o.ListPending(ctx, encryptedPrefix.Prepend(o.prefix), startAfter, endBefore, recursive, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = o.decryptItems(prefix, encryptedPrefix, items)
+	if err != nil {
+		return nil, false, err
+	}
+	return items, more, nil
+}
+
+// encryptVersionPath encrypts the path of a version relative to the given
+// prefix, keeping the version ID at its end
+func (o *prefixedObjStore) encryptVersionPath(prefix, path paths.Path) (paths.Path, error) {
+	if len(path) == 0 {
+		return path, nil
+	}
+	encrypted, err := o.encryptRelative(prefix, path[:len(path)-1])
+	if err != nil {
+		return nil, err
+	}
+	return encrypted.Append(path[len(path)-1]), nil
+}
+
+// pruneVersions deletes the previous versions of the object at path, except
+// for the newest ones kept by the retention of the bucket
+func (o *prefixedObjStore) pruneVersions(ctx context.Context, path paths.Path) error {
+	var kept int
+	var startAfter paths.Path
+	for {
+		items, more, err := o.ListVersions(ctx, path, startAfter, nil, 0, meta.None)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			// versions of the objects below path are listed too
+			if len(item.Path) != 0 {
+				continue
+			}
+			kept++
+			if kept <= o.versioning.Retention {
+				continue
+			}
+			err = o.DeleteVersion(ctx, path, item.Meta.Version)
+			if err != nil && !storage.ErrKeyNotFound.Has(err) {
+				return err
+			}
+		}
+
+		if !more || len(items) == 0 {
+			return nil
+		}
+		last := items[len(items)-1]
+		startAfter = last.Path.Append(last.Meta.Version)
+	}
 }
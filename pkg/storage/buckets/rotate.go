@@ -0,0 +1,142 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/pkg/storage/streams"
+)
+
+// RotateKey re-encrypts all buckets and objects stored with the keys derived
+// from oldKey with the keys derived from newKey. src and dst are stream
+// stores over the same segments with oldKey and newKey as their root keys.
+// The data of the objects is not moved: only the segment keys are
+// re-encrypted and the encrypted paths renamed. Every version of a stream
+// records the ID of the key it is encrypted with, which tells the streams
+// rotated by an interrupted rotation apart, so it can be run again. A bucket
+// is rotated after its objects. Pending uploads are not rotated.
+func RotateKey(ctx context.Context, src, dst streams.Store, oldKey, newKey []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	oldStore := &BucketStore{o: objects.NewStore(src), rootKey: oldKey}
+	newStore := &BucketStore{o: objects.NewStore(dst), rootKey: newKey}
+
+	var startAfter string
+	for {
+		items, more, err := oldStore.List(ctx, startAfter, "", 0)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			err = rotateBucket(ctx, src, dst, oldStore, newStore, item.Bucket)
+			if err != nil {
+				return err
+			}
+		}
+		if !more || len(items) == 0 {
+			return nil
+		}
+		startAfter = items[len(items)-1].Bucket
+	}
+}
+
+// rotateBucket re-encrypts the objects of the bucket and then the bucket
+func rotateBucket(ctx context.Context, src, dst streams.Store, oldStore, newStore *BucketStore, bucket string) error {
+	rotated, err := streams.Rekeyed(ctx, dst, paths.New(bucket))
+	if err != nil || rotated {
+		return err
+	}
+
+	oldScope, err := oldStore.bucketScope(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	newScope, err := newStore.keyScope(bucket, oldScope.Meta)
+	if err != nil {
+		return err
+	}
+
+	// the encrypted paths change, so all of them are listed before any is
+	// renamed
+	encryptedPaths, err := listStreamPaths(ctx, src, paths.New(bucket))
+	if err != nil {
+		return err
+	}
+
+	cipher := oldScope.Meta.PathCipher
+	for _, encrypted := range encryptedPaths {
+		// the paths renamed by an interrupted rotation are listed too
+		renamed, err := streams.Rekeyed(ctx, dst, encrypted.Prepend(bucket))
+		if err != nil {
+			return err
+		}
+		if renamed {
+			continue
+		}
+
+		path, err := encrypted.DecryptWith(cipher, oldScope.PathKey)
+		if err != nil {
+			return err
+		}
+		newEncrypted, err := path.EncryptWith(cipher, newScope.PathKey)
+		if err != nil {
+			return err
+		}
+
+		err = streams.Rekey(ctx, src, dst, encrypted.Prepend(bucket), newEncrypted.Prepend(bucket))
+		if err != nil {
+			return err
+		}
+	}
+
+	return streams.Rekey(ctx, src, dst, paths.New(bucket), paths.New(bucket))
+}
+
+// listStreamPaths returns the paths below prefix of all streams with a
+// current or an older version
+func listStreamPaths(ctx context.Context, s streams.Store, prefix paths.Path) ([]paths.Path, error) {
+	var result []paths.Path
+	seen := map[string]bool{}
+	add := func(path paths.Path) {
+		if !seen[path.String()] {
+			seen[path.String()] = true
+			result = append(result, path)
+		}
+	}
+
+	var startAfter paths.Path
+	for {
+		items, more, err := s.List(ctx, prefix, startAfter, nil, true, 0, meta.None)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.Path)
+		}
+		if !more || len(items) == 0 {
+			break
+		}
+		startAfter = items[len(items)-1].Path
+	}
+
+	startAfter = nil
+	for {
+		items, more, err := s.ListVersions(ctx, prefix, startAfter, nil, 0, meta.None)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			add(item.Path)
+		}
+		if !more || len(items) == 0 {
+			return result, nil
+		}
+		last := items[len(items)-1]
+		startAfter = last.Path.Append(last.Meta.Version)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package buckets
+
+import (
+	"context"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/storage/objects"
+	"storj.io/storj/storage"
+)
+
+// ScopeError is an error class for access outside of a shared scope
+var ScopeError = errs.Class("no access outside of the shared scope")
+
+// Scope is the access to the objects below a path prefix of a bucket. It
+// holds the keys derived for the prefix, which give access to nothing above
+// it.
+type Scope struct {
+	Bucket string
+	Meta   Meta
+	// Prefix is the shared path prefix and EncryptedPrefix is the same prefix
+	// encrypted with the path cipher of the bucket
+	Prefix          paths.Path
+	EncryptedPrefix paths.Path
+	// PathKey is the key for encrypting the paths below the prefix
+	PathKey []byte
+	// StreamKey is the key for deriving the content keys of the streams
+	// below the prefix
+	StreamKey []byte
+}
+
+// StreamPrefix returns the path of the prefix in the stream store
+func (s Scope) StreamPrefix() paths.Path {
+	return s.EncryptedPrefix.Prepend(s.Bucket)
+}
+
+// narrow returns the scope of the given prefix, which must be below the
+// prefix of the current scope
+func (s Scope) narrow(prefix paths.Path) (Scope, error) {
+	if !prefix.HasPrefix(s.Prefix) {
+		return Scope{}, ScopeError.New("%s/%s", s.Bucket, prefix)
+	}
+	rel := prefix[len(s.Prefix):]
+
+	encryptedRel, err := rel.EncryptWith(s.Meta.PathCipher, s.PathKey)
+	if err != nil {
+		return Scope{}, err
+	}
+	pathKey, err := rel.DeriveKey(s.PathKey, len(rel))
+	if err != nil {
+		return Scope{}, err
+	}
+	streamKey, err := encryptedRel.DeriveKey(s.StreamKey, len(encryptedRel))
+	if err != nil {
+		return Scope{}, err
+	}
+
+	return Scope{
+		Bucket:          s.Bucket,
+		Meta:            s.Meta,
+		Prefix:          s.Prefix.Append(rel...),
+		EncryptedPrefix: s.EncryptedPrefix.Append(encryptedRel...),
+		PathKey:         pathKey,
+		StreamKey:       streamKey,
+	}, nil
+}
+
+// NewScopedStore instantiates a BucketStore that can only access the objects
+// in the given scope. The streams of obj must be scoped to the stream prefix
+// of the scope.
+func NewScopedStore(obj objects.Store, scope Scope) Store {
+	return &BucketStore{o: obj, scope: &scope}
+}
+
+// Share returns the scope of the objects below prefix in the bucket
+func (b *BucketStore) Share(ctx context.Context, bucket string, prefix paths.Path) (scope Scope, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scope, err = b.bucketScope(ctx, bucket)
+	if err != nil {
+		return Scope{}, err
+	}
+	return scope.narrow(prefix)
+}
+
+// bucketScope returns the widest scope of the store in the bucket
+func (b *BucketStore) bucketScope(ctx context.Context, bucket string) (Scope, error) {
+	if bucket == "" {
+		return Scope{}, NoBucketError.New("")
+	}
+	if b.scope != nil {
+		if bucket != b.scope.Bucket {
+			return Scope{}, minio.BucketNotFound{Bucket: bucket}
+		}
+		return *b.scope, nil
+	}
+
+	m, err := b.Get(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return Scope{}, minio.BucketNotFound{Bucket: bucket}
+		}
+		return Scope{}, err
+	}
+
+	return b.keyScope(bucket, m)
+}
+
+// keyScope returns the scope of the whole bucket with the keys derived from
+// the root key of the store
+func (b *BucketStore) keyScope(bucket string, m Meta) (Scope, error) {
+	// the paths and the streams of the bucket are both keyed below the
+	// bucket name
+	key, err := paths.New(bucket).DeriveKey(b.rootKey, 1)
+	if err != nil {
+		return Scope{}, err
+	}
+
+	return Scope{
+		Bucket:    bucket,
+		Meta:      m,
+		PathKey:   key,
+		StreamKey: key,
+	}, nil
+}
@@ -6,9 +6,9 @@ package buckets
 import (
 	"bytes"
 	"context"
+	"strconv"
 	"time"
 
-	minio "github.com/minio/minio/cmd"
 	"github.com/zeebo/errs"
 
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -27,10 +27,15 @@ var NoBucketError = errs.Class("no bucket specified")
 // Store creates an interface for interacting with buckets
 type Store interface {
 	Get(ctx context.Context, bucket string) (meta Meta, err error)
-	Put(ctx context.Context, bucket string) (meta Meta, err error)
+	Put(ctx context.Context, bucket string, pathCipher paths.Cipher) (meta Meta, err error)
 	Delete(ctx context.Context, bucket string) (err error)
 	List(ctx context.Context, startAfter, endBefore string, limit int) (items []ListItem, more bool, err error)
 	GetObjectStore(ctx context.Context, bucketName string) (store objects.Store, err error)
+	CopyObject(ctx context.Context, srcBucket string, srcPath paths.Path, destBucket string, destPath paths.Path,
+		metadata objects.SerializableMeta) (meta objects.Meta, err error)
+	MoveObject(ctx context.Context, srcBucket string, srcPath paths.Path, destBucket string, destPath paths.Path) (meta objects.Meta, err error)
+	SetVersioning(ctx context.Context, bucket string, versioning Versioning) (meta Meta, err error)
+	Share(ctx context.Context, bucket string, prefix paths.Path) (scope Scope, err error)
 }
 
 // ListItem is a single item in a listing
@@ -39,47 +44,137 @@ type ListItem struct {
 	Meta   Meta
 }
 
-// BucketStore contains objects store
+// BucketStore contains objects store. A scoped store can only access the
+// objects in its scope.
 type BucketStore struct {
-	o objects.Store
+	o       objects.Store
+	rootKey []byte
+	scope   *Scope
 }
 
-// Meta is the bucket metadata struct
+// Meta is the bucket metadata struct. The paths of the objects in the bucket
+// are encrypted with PathCipher.
 type Meta struct {
-	Created time.Time
+	Created    time.Time
+	PathCipher paths.Cipher
+	Versioning Versioning
 }
 
-// NewStore instantiates BucketStore
-func NewStore(obj objects.Store) Store {
-	return &BucketStore{o: obj}
+// Versioning is the versioning configuration of a bucket. If it is enabled,
+// overwriting or deleting an object keeps its previous version. Retention is
+// the number of previous versions kept per object, or zero to keep all.
+type Versioning struct {
+	Enabled   bool
+	Retention int
+}
+
+// keys of the bucket metadata that hold the path cipher and the versioning
+// configuration
+const (
+	pathCipherKey = "path-encryption"
+	versioningKey = "versioning"
+	retentionKey  = "version-retention"
+)
+
+// NewStore instantiates BucketStore. The paths of the objects are encrypted
+// with keys derived from rootKey.
+func NewStore(obj objects.Store, rootKey []byte) Store {
+	return &BucketStore{o: obj, rootKey: rootKey}
 }
 
 // GetObjectStore returns an implementation of objects.Store
 func (b *BucketStore) GetObjectStore(ctx context.Context, bucket string) (objects.Store, error) {
-	if bucket == "" {
-		return nil, NoBucketError.New("")
-	}
+	return b.getPrefixedStore(ctx, bucket)
+}
 
-	_, err := b.Get(ctx, bucket)
+// getPrefixedStore returns the store of the objects in the bucket
+func (b *BucketStore) getPrefixedStore(ctx context.Context, bucket string) (*prefixedObjStore, error) {
+	scope, err := b.bucketScope(ctx, bucket)
 	if err != nil {
-		if storage.ErrKeyNotFound.Has(err) {
-			return nil, minio.BucketNotFound{Bucket: bucket}
-		}
 		return nil, err
 	}
+
 	prefixed := prefixedObjStore{
-		o:      b.o,
-		prefix: bucket,
+		o:                   b.o,
+		prefix:              bucket,
+		pathPrefix:          scope.Prefix,
+		encryptedPathPrefix: scope.EncryptedPrefix,
+		pathCipher:          scope.Meta.PathCipher,
+		pathKey:             scope.PathKey,
+		versioning:          scope.Meta.Versioning,
 	}
 	return &prefixed, nil
 }
 
+// CopyObject copies an object to a path in the same or another bucket
+// without transferring its data
+func (b *BucketStore) CopyObject(ctx context.Context, srcBucket string, srcPath paths.Path, destBucket string, destPath paths.Path,
+	metadata objects.SerializableMeta) (meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	src, dest, err := b.objectPaths(ctx, srcBucket, srcPath, destBucket, destPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return b.o.Copy(ctx, src, dest, metadata)
+}
+
+// MoveObject moves an object to a path in the same or another bucket
+// without transferring its data
+func (b *BucketStore) MoveObject(ctx context.Context, srcBucket string, srcPath paths.Path, destBucket string, destPath paths.Path) (
+	meta objects.Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	src, dest, err := b.objectPaths(ctx, srcBucket, srcPath, destBucket, destPath)
+	if err != nil {
+		return objects.Meta{}, err
+	}
+
+	return b.o.Move(ctx, src, dest)
+}
+
+// objectPaths checks that both buckets exist and both object paths are not
+// empty, and returns the full paths of the objects, encrypted with the path
+// cipher of their bucket
+func (b *BucketStore) objectPaths(ctx context.Context, srcBucket string, srcPath paths.Path, destBucket string, destPath paths.Path) (
+	src, dest paths.Path, err error) {
+	srcStore, err := b.getPrefixedStore(ctx, srcBucket)
+	if err != nil {
+		return nil, nil, err
+	}
+	destStore, err := b.getPrefixedStore(ctx, destBucket)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(srcPath) == 0 || len(destPath) == 0 {
+		return nil, nil, objects.NoPathError.New("")
+	}
+
+	src, err = srcStore.fullPath(srcPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	dest, err = destStore.fullPath(destPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	return src, dest, nil
+}
+
 // Get calls objects store Get
 func (b *BucketStore) Get(ctx context.Context, bucket string) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if bucket == "" {
 		return Meta{}, NoBucketError.New("")
 	}
+	if b.scope != nil {
+		if bucket != b.scope.Bucket {
+			return Meta{}, storage.ErrKeyNotFound.New("%s", bucket)
+		}
+		return b.scope.Meta, nil
+	}
 
 	p := paths.New(bucket)
 	objMeta, err := b.o.Meta(ctx, p)
@@ -89,17 +184,67 @@ func (b *BucketStore) Get(ctx context.Context, bucket string) (meta Meta, err er
 	return convertMeta(objMeta), nil
 }
 
-// Put calls objects store Put
-func (b *BucketStore) Put(ctx context.Context, bucket string) (meta Meta, err error) {
+// Put calls objects store Put. The paths of the objects in the bucket are
+// encrypted with pathCipher, which cannot be changed later.
+func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher paths.Cipher) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 	if bucket == "" {
 		return Meta{}, NoBucketError.New("")
 	}
+	if b.scope != nil {
+		return Meta{}, ScopeError.New("%s", bucket)
+	}
+
+	serMeta := objects.SerializableMeta{}
+	if pathCipher != paths.Unencrypted {
+		serMeta.UserDefined = map[string]string{
+			pathCipherKey: pathCipher.String(),
+		}
+	}
 
 	p := paths.New(bucket)
 	r := bytes.NewReader(nil)
 	var exp time.Time
-	m, err := b.o.Put(ctx, p, r, objects.SerializableMeta{}, exp)
+	m, err := b.o.Put(ctx, p, r, serMeta, exp)
+	if err != nil {
+		return Meta{}, err
+	}
+	return convertMeta(m), nil
+}
+
+// SetVersioning stores the versioning configuration of the bucket. It
+// applies to the objects stored after it was set.
+func (b *BucketStore) SetVersioning(ctx context.Context, bucket string, versioning Versioning) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+	if bucket == "" {
+		return Meta{}, NoBucketError.New("")
+	}
+	if versioning.Retention < 0 {
+		return Meta{}, errs.New("version retention must not be negative")
+	}
+	if b.scope != nil {
+		return Meta{}, ScopeError.New("%s", bucket)
+	}
+
+	// keep the rest of the bucket metadata, like the path cipher
+	p := paths.New(bucket)
+	objMeta, err := b.o.Meta(ctx, p)
+	if err != nil {
+		return Meta{}, err
+	}
+	serMeta := objects.SerializableMeta{UserDefined: map[string]string{}}
+	for key, value := range objMeta.UserDefined {
+		serMeta.UserDefined[key] = value
+	}
+	delete(serMeta.UserDefined, versioningKey)
+	delete(serMeta.UserDefined, retentionKey)
+	if versioning.Enabled {
+		serMeta.UserDefined[versioningKey] = "enabled"
+		serMeta.UserDefined[retentionKey] = strconv.Itoa(versioning.Retention)
+	}
+
+	// copying the bucket onto itself replaces only its metadata
+	m, err := b.o.Copy(ctx, p, p, serMeta)
 	if err != nil {
 		return Meta{}, err
 	}
@@ -112,6 +257,9 @@ func (b *BucketStore) Delete(ctx context.Context, bucket string) (err error) {
 	if bucket == "" {
 		return NoBucketError.New("")
 	}
+	if b.scope != nil {
+		return ScopeError.New("%s", bucket)
+	}
 
 	p := paths.New(bucket)
 	return b.o.Delete(ctx, p)
@@ -121,6 +269,13 @@ func (b *BucketStore) Delete(ctx context.Context, bucket string) (err error) {
 func (b *BucketStore) List(ctx context.Context, startAfter, endBefore string, limit int) (
 	items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
+	if b.scope != nil {
+		// only the bucket of the scope is visible
+		if startAfter < b.scope.Bucket && (endBefore == "" || b.scope.Bucket < endBefore) {
+			items = append(items, ListItem{Bucket: b.scope.Bucket, Meta: b.scope.Meta})
+		}
+		return items, false, nil
+	}
 	objItems, more, err := b.o.List(ctx, nil, paths.New(startAfter), paths.New(endBefore), false, limit, meta.Modified)
 	if err != nil {
 		return items, more, err
@@ -140,7 +295,16 @@ func (b *BucketStore) List(ctx context.Context, startAfter, endBefore string, li
 
 // convertMeta converts stream metadata to object metadata
 func convertMeta(m objects.Meta) Meta {
-	return Meta{
+	meta := Meta{
 		Created: m.Modified,
 	}
+	// buckets created before the path cipher was recorded are unencrypted
+	if name, ok := m.UserDefined[pathCipherKey]; ok {
+		meta.PathCipher, _ = paths.ParseCipher(name)
+	}
+	if m.UserDefined[versioningKey] == "enabled" {
+		meta.Versioning.Enabled = true
+		meta.Versioning.Retention, _ = strconv.Atoi(m.UserDefined[retentionKey])
+	}
+	return meta
 }
@@ -43,6 +43,19 @@ func (m *MockPSClient) EXPECT() *MockPSClientMockRecorder {
 	return m.recorder
 }
 
+// BatchDelete mocks base method
+func (m *MockPSClient) BatchDelete(arg0 context.Context, arg1 []client.PieceID) ([]client.PieceID, error) {
+	ret := m.ctrl.Call(m, "BatchDelete", arg0, arg1)
+	ret0, _ := ret[0].([]client.PieceID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchDelete indicates an expected call of BatchDelete
+func (mr *MockPSClientMockRecorder) BatchDelete(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchDelete", reflect.TypeOf((*MockPSClient)(nil).BatchDelete), arg0, arg1)
+}
+
 // Close mocks base method
 func (m *MockPSClient) Close() error {
 	ret := m.ctrl.Call(m, "Close")
@@ -17,6 +17,8 @@ const (
 	Checksum
 	// UserDefined meta flag
 	UserDefined
+	// Remote meta flag, the remote segment with the pieces of a pointer
+	Remote
 	// All represents all the meta flags
 	All = ^uint32(0)
 )
@@ -5,6 +5,7 @@ package objects
 
 import (
 	"context"
+	"encoding/hex"
 	"io"
 	"time"
 
@@ -31,6 +32,7 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Checksum   string
+	Version    string
 }
 
 // ListItem is a single item in a listing
@@ -48,9 +50,29 @@ type Store interface {
 	Put(ctx context.Context, path paths.Path, data io.Reader,
 		metadata SerializableMeta, expiration time.Time) (meta Meta, err error)
 	Delete(ctx context.Context, path paths.Path) (err error)
+	Copy(ctx context.Context, srcPath, dstPath paths.Path,
+		metadata SerializableMeta) (meta Meta, err error)
+	Move(ctx context.Context, srcPath, dstPath paths.Path) (meta Meta, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore paths.Path,
 		recursive bool, limit int, metaFlags uint32) (items []ListItem,
 		more bool, err error)
+	ListStream(ctx context.Context, prefix, startAfter paths.Path,
+		recursive bool, metaFlags uint32, fn func(item ListItem) error) (
+		err error)
+	Archive(ctx context.Context, path paths.Path) (meta Meta, err error)
+	GetVersion(ctx context.Context, path paths.Path, version string) (
+		rr ranger.Ranger, meta Meta, err error)
+	MetaVersion(ctx context.Context, path paths.Path, version string) (
+		meta Meta, err error)
+	DeleteVersion(ctx context.Context, path paths.Path, version string) (
+		err error)
+	ListVersions(ctx context.Context, prefix, startAfter, endBefore paths.Path,
+		limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	Resume(ctx context.Context, path paths.Path, data io.Reader,
+		metadata SerializableMeta, expiration time.Time) (meta Meta, err error)
+	Abort(ctx context.Context, path paths.Path) (err error)
+	ListPending(ctx context.Context, prefix, startAfter, endBefore paths.Path,
+		recursive bool, limit int) (items []ListItem, more bool, err error)
 }
 
 type objStore struct {
@@ -116,6 +138,34 @@ func (o *objStore) Delete(ctx context.Context, path paths.Path) (err error) {
 	return o.s.Delete(ctx, path)
 }
 
+func (o *objStore) Copy(ctx context.Context, srcPath, dstPath paths.Path,
+	metadata SerializableMeta) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(srcPath) == 0 || len(dstPath) == 0 {
+		return Meta{}, NoPathError.New("")
+	}
+
+	b, err := proto.Marshal(&metadata)
+	if err != nil {
+		return Meta{}, err
+	}
+	m, err := o.s.Copy(ctx, srcPath, dstPath, b)
+	return convertMeta(m), err
+}
+
+func (o *objStore) Move(ctx context.Context, srcPath, dstPath paths.Path) (
+	meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(srcPath) == 0 || len(dstPath) == 0 {
+		return Meta{}, NoPathError.New("")
+	}
+
+	m, err := o.s.Move(ctx, srcPath, dstPath)
+	return convertMeta(m), err
+}
+
 func (o *objStore) List(ctx context.Context, prefix, startAfter,
 	endBefore paths.Path, recursive bool, limit int, metaFlags uint32) (
 	items []ListItem, more bool, err error) {
@@ -139,6 +189,144 @@ func (o *objStore) List(ctx context.Context, prefix, startAfter,
 	return items, more, nil
 }
 
+func (o *objStore) ListStream(ctx context.Context, prefix,
+	startAfter paths.Path, recursive bool, metaFlags uint32,
+	fn func(item ListItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return o.s.ListStream(ctx, prefix, startAfter, recursive, metaFlags,
+		func(itm streams.ListItem) error {
+			return fn(ListItem{
+				Path:     itm.Path,
+				Meta:     convertMeta(itm.Meta),
+				IsPrefix: itm.IsPrefix,
+			})
+		})
+}
+
+func (o *objStore) Archive(ctx context.Context, path paths.Path) (meta Meta,
+	err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return Meta{}, NoPathError.New("")
+	}
+
+	m, err := o.s.Archive(ctx, path)
+	return convertMeta(m), err
+}
+
+func (o *objStore) GetVersion(ctx context.Context, path paths.Path,
+	version string) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return nil, Meta{}, NoPathError.New("")
+	}
+
+	rr, m, err := o.s.GetVersion(ctx, path, version)
+	return rr, convertMeta(m), err
+}
+
+func (o *objStore) MetaVersion(ctx context.Context, path paths.Path,
+	version string) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return Meta{}, NoPathError.New("")
+	}
+
+	m, err := o.s.MetaVersion(ctx, path, version)
+	return convertMeta(m), err
+}
+
+func (o *objStore) DeleteVersion(ctx context.Context, path paths.Path,
+	version string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return NoPathError.New("")
+	}
+
+	return o.s.DeleteVersion(ctx, path, version)
+}
+
+func (o *objStore) ListVersions(ctx context.Context, prefix, startAfter,
+	endBefore paths.Path, limit int, metaFlags uint32) (items []ListItem,
+	more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	strItems, more, err := o.s.ListVersions(ctx, prefix, startAfter, endBefore,
+		limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items = make([]ListItem, len(strItems))
+	for i, itm := range strItems {
+		items[i] = ListItem{
+			Path: itm.Path,
+			Meta: convertMeta(itm.Meta),
+		}
+	}
+
+	return items, more, nil
+}
+
+func (o *objStore) Resume(ctx context.Context, path paths.Path, data io.Reader,
+	metadata SerializableMeta, expiration time.Time) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return Meta{}, NoPathError.New("")
+	}
+
+	b, err := proto.Marshal(&metadata)
+	if err != nil {
+		return Meta{}, err
+	}
+	m, err := o.s.Resume(ctx, path, data, b, expiration)
+	return convertMeta(m), err
+}
+
+func (o *objStore) Abort(ctx context.Context, path paths.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(path) == 0 {
+		return NoPathError.New("")
+	}
+
+	return o.s.Abort(ctx, path)
+}
+
+// ListPending lists the objects below prefix whose uploads can be resumed.
+// The modification time of an item is the time its upload was started.
+func (o *objStore) ListPending(ctx context.Context, prefix, startAfter,
+	endBefore paths.Path, recursive bool, limit int) (items []ListItem,
+	more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	strItems, more, err := o.s.ListPending(ctx, prefix, startAfter, endBefore,
+		recursive, limit)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items = make([]ListItem, len(strItems))
+	for i, itm := range strItems {
+		items[i] = ListItem{
+			Path: itm.Path,
+			Meta: Meta{
+				Modified:   itm.Meta.Modified,
+				Expiration: itm.Meta.Expiration,
+			},
+			IsPrefix: itm.IsPrefix,
+		}
+	}
+
+	return items, more, nil
+}
+
 // convertMeta converts stream metadata to object metadata
 func convertMeta(m streams.Meta) Meta {
 	ser := SerializableMeta{}
@@ -150,6 +338,8 @@ func convertMeta(m streams.Meta) Meta {
 		Modified:         m.Modified,
 		Expiration:       m.Expiration,
 		Size:             m.Size,
+		Checksum:         hex.EncodeToString(m.Checksum),
+		Version:          m.Version,
 		SerializableMeta: ser,
 	}
 }
@@ -38,6 +38,32 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// Commit mocks base method
+func (m *MockStore) Commit(arg0 context.Context, arg1 []Uploaded) ([]Meta, error) {
+	ret := m.ctrl.Call(m, "Commit", arg0, arg1)
+	ret0, _ := ret[0].([]Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Commit indicates an expected call of Commit
+func (mr *MockStoreMockRecorder) Commit(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Commit", reflect.TypeOf((*MockStore)(nil).Commit), arg0, arg1)
+}
+
+// Copy mocks base method
+func (m *MockStore) Copy(arg0 context.Context, arg1, arg2 paths.Path, arg3 []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Copy indicates an expected call of Copy
+func (mr *MockStoreMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockStore)(nil).Copy), arg0, arg1, arg2, arg3)
+}
+
 // Delete mocks base method
 func (m *MockStore) Delete(arg0 context.Context, arg1 paths.Path) error {
 	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
@@ -50,6 +76,18 @@ func (mr *MockStoreMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStore)(nil).Delete), arg0, arg1)
 }
 
+// DeleteAll mocks base method
+func (m *MockStore) DeleteAll(arg0 context.Context, arg1 []paths.Path) error {
+	ret := m.ctrl.Call(m, "DeleteAll", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAll indicates an expected call of DeleteAll
+func (mr *MockStoreMockRecorder) DeleteAll(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAll", reflect.TypeOf((*MockStore)(nil).DeleteAll), arg0, arg1)
+}
+
 // Get mocks base method
 func (m *MockStore) Get(arg0 context.Context, arg1 paths.Path) (ranger.Ranger, Meta, error) {
 	ret := m.ctrl.Call(m, "Get", arg0, arg1)
@@ -78,6 +116,18 @@ func (mr *MockStoreMockRecorder) List(arg0, arg1, arg2, arg3, arg4, arg5, arg6 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockStore)(nil).List), arg0, arg1, arg2, arg3, arg4, arg5, arg6)
 }
 
+// ListStream mocks base method
+func (m *MockStore) ListStream(arg0 context.Context, arg1, arg2 paths.Path, arg3 bool, arg4 uint32, arg5 func(ListItem) error) error {
+	ret := m.ctrl.Call(m, "ListStream", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ListStream indicates an expected call of ListStream
+func (mr *MockStoreMockRecorder) ListStream(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStream", reflect.TypeOf((*MockStore)(nil).ListStream), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // Meta mocks base method
 func (m *MockStore) Meta(arg0 context.Context, arg1 paths.Path) (Meta, error) {
 	ret := m.ctrl.Call(m, "Meta", arg0, arg1)
@@ -91,6 +141,19 @@ func (mr *MockStoreMockRecorder) Meta(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Meta", reflect.TypeOf((*MockStore)(nil).Meta), arg0, arg1)
 }
 
+// Move mocks base method
+func (m *MockStore) Move(arg0 context.Context, arg1, arg2 paths.Path, arg3 []byte) (Meta, error) {
+	ret := m.ctrl.Call(m, "Move", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Move indicates an expected call of Move
+func (mr *MockStoreMockRecorder) Move(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockStore)(nil).Move), arg0, arg1, arg2, arg3)
+}
+
 // Put mocks base method
 func (m *MockStore) Put(arg0 context.Context, arg1 io.Reader, arg2 time.Time, arg3 func() (paths.Path, []byte, error)) (Meta, error) {
 	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2, arg3)
@@ -103,3 +166,42 @@ func (m *MockStore) Put(arg0 context.Context, arg1 io.Reader, arg2 time.Time, ar
 func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2, arg3)
 }
+
+// PutContent mocks base method
+func (m *MockStore) PutContent(arg0 context.Context, arg1 string, arg2 io.Reader, arg3 time.Time, arg4 func() (paths.Path, []byte, error)) (Meta, error) {
+	ret := m.ctrl.Call(m, "PutContent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutContent indicates an expected call of PutContent
+func (mr *MockStoreMockRecorder) PutContent(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutContent", reflect.TypeOf((*MockStore)(nil).PutContent), arg0, arg1, arg2, arg3, arg4)
+}
+
+// Relocate mocks base method
+func (m *MockStore) Relocate(arg0 context.Context, arg1 []Relocation, arg2 []paths.Path, arg3 bool) ([]Meta, error) {
+	ret := m.ctrl.Call(m, "Relocate", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Relocate indicates an expected call of Relocate
+func (mr *MockStoreMockRecorder) Relocate(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Relocate", reflect.TypeOf((*MockStore)(nil).Relocate), arg0, arg1, arg2, arg3)
+}
+
+// Upload mocks base method
+func (m *MockStore) Upload(arg0 context.Context, arg1 io.Reader, arg2 time.Time, arg3 func() (paths.Path, []byte, error)) (Uploaded, error) {
+	ret := m.ctrl.Call(m, "Upload", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(Uploaded)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload
+func (mr *MockStoreMockRecorder) Upload(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockStore)(nil).Upload), arg0, arg1, arg2, arg3)
+}
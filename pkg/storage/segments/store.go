@@ -24,6 +24,7 @@ import (
 	"storj.io/storj/pkg/pointerdb/pdbclient"
 	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/storage"
 )
 
 var (
@@ -45,13 +46,35 @@ type ListItem struct {
 	IsPrefix bool
 }
 
+// Uploaded is a segment whose data is uploaded, but whose pointer is not
+// stored yet, see Store.Commit
+type Uploaded struct {
+	Path    paths.Path
+	Pointer *pb.Pointer
+}
+
+// Relocation is a move or copy of a segment to a new path with new metadata
+type Relocation struct {
+	SrcPath  paths.Path
+	DstPath  paths.Path
+	Metadata []byte
+}
+
 // Store for segments
 type Store interface {
 	Meta(ctx context.Context, path paths.Path) (meta Meta, err error)
 	Get(ctx context.Context, path paths.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (meta Meta, err error)
+	PutContent(ctx context.Context, contentID string, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (meta Meta, err error)
+	Upload(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segment Uploaded, err error)
+	Commit(ctx context.Context, segments []Uploaded) (metas []Meta, err error)
 	Delete(ctx context.Context, path paths.Path) (err error)
+	DeleteAll(ctx context.Context, paths []paths.Path) (err error)
+	Copy(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (meta Meta, err error)
+	Move(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (meta Meta, err error)
+	Relocate(ctx context.Context, relocations []Relocation, deletePaths []paths.Path, copy bool) (metas []Meta, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListStream(ctx context.Context, prefix, startAfter paths.Path, recursive bool, metaFlags uint32, fn func(item ListItem) error) (err error)
 }
 
 type segmentStore struct {
@@ -73,7 +96,7 @@ func (s *segmentStore) Meta(ctx context.Context, path paths.Path) (meta Meta,
 	err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	pr, err := s.pdb.Get(ctx, path)
+	pr, err := s.pdb.Meta(ctx, path)
 	if err != nil {
 		return Meta{}, Error.Wrap(err)
 	}
@@ -85,23 +108,143 @@ func (s *segmentStore) Meta(ctx context.Context, path paths.Path) (meta Meta,
 func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	peekReader := NewPeekThresholdReader(data)
+	remoteSized, err := peekReader.IsLargerThan(s.thresholdSize)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return s.put(ctx, "", peekReader, remoteSized, expiration, segmentInfo)
+}
+
+// PutContent stores a segment whose content is identified by contentID. If a
+// remote segment with the same content ID was uploaded with the same API key
+// before and is still stored, the new segment references its pieces instead
+// of uploading the data again. Otherwise the segment is uploaded like with
+// Put and recorded under contentID.
+func (s *segmentStore) PutContent(ctx context.Context, contentID string, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peekReader := NewPeekThresholdReader(data)
+	remoteSized, err := peekReader.IsLargerThan(s.thresholdSize)
+	if err != nil {
+		return Meta{}, err
+	}
+	if !remoteSized {
+		// inline segments are stored in the pointer, nothing to deduplicate
+		return s.put(ctx, "", peekReader, remoteSized, expiration, segmentInfo)
+	}
+
 	exp, err := ptypes.TimestampProto(expiration)
 	if err != nil {
 		return Meta{}, Error.Wrap(err)
 	}
+	path, metadata, err := segmentInfo()
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	pr, err := s.pdb.Reference(ctx, contentID, path, metadata, exp)
+	if err == nil {
+		return convertMeta(pr), nil
+	}
+	if !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return s.put(ctx, contentID, peekReader, remoteSized, expiration, func() (paths.Path, []byte, error) {
+		return path, metadata, nil
+	})
+}
+
+// Upload uploads a segment like Put, but does not store its pointer, so the
+// pointers of several segments can be stored together with Commit
+func (s *segmentStore) Upload(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segment Uploaded, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	peekReader := NewPeekThresholdReader(data)
 	remoteSized, err := peekReader.IsLargerThan(s.thresholdSize)
+	if err != nil {
+		return Uploaded{}, err
+	}
+
+	return s.upload(ctx, peekReader, remoteSized, expiration, segmentInfo)
+}
+
+// Commit stores the pointers of the uploaded segments with a single request
+// to pointerdb, in their order. Either all or none of them are stored.
+func (s *segmentStore) Commit(ctx context.Context, segments []Uploaded) (metas []Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(segments) == 0 {
+		return nil, nil
+	}
+
+	segmentPaths := make([]paths.Path, len(segments))
+	pointers := make([]*pb.Pointer, len(segments))
+	for i, segment := range segments {
+		segmentPaths[i] = segment.Path
+		pointers[i] = segment.Pointer
+	}
+	if _, err = s.pdb.BatchPut(ctx, segmentPaths, pointers, true); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	// get the metadata for the newly stored segments
+	stored, errs, err := s.pdb.BatchGet(ctx, segmentPaths, true)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	metas = make([]Meta, len(segments))
+	for i, pr := range stored {
+		if errs[i] != nil {
+			return nil, Error.Wrap(errs[i])
+		}
+		metas[i] = convertMeta(pr)
+	}
+	return metas, nil
+}
+
+// put uploads a segment whose size was peeked already and records it under
+// contentID, if it is not empty
+func (s *segmentStore) put(ctx context.Context, contentID string, peekReader *PeekThresholdReader, remoteSized bool, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (meta Meta, err error) {
+	segment, err := s.upload(ctx, peekReader, remoteSized, expiration, segmentInfo)
 	if err != nil {
 		return Meta{}, err
 	}
 
+	// puts pointer to pointerDB
+	if contentID != "" {
+		err = s.pdb.PutWithContentID(ctx, segment.Path, segment.Pointer, contentID)
+	} else {
+		err = s.pdb.Put(ctx, segment.Path, segment.Pointer)
+	}
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	// get the metadata for the newly uploaded segment
+	m, err := s.Meta(ctx, segment.Path)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+	return m, nil
+}
+
+// upload uploads a segment whose size was peeked already and returns its
+// pointer
+func (s *segmentStore) upload(ctx context.Context, peekReader *PeekThresholdReader, remoteSized bool, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segment Uploaded, err error) {
+	exp, err := ptypes.TimestampProto(expiration)
+	if err != nil {
+		return Uploaded{}, Error.Wrap(err)
+	}
+
 	var path paths.Path
 	var pointer *pb.Pointer
 	if !remoteSized {
 		p, metadata, err := segmentInfo()
 		if err != nil {
-			return Meta{}, Error.Wrap(err)
+			return Uploaded{}, Error.Wrap(err)
 		}
 		path = p
 
@@ -116,7 +259,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		// uses overlay client to request a list of nodes
 		nodes, err := s.oc.Choose(ctx, s.rs.TotalCount(), 0)
 		if err != nil {
-			return Meta{}, Error.Wrap(err)
+			return Uploaded{}, Error.Wrap(err)
 		}
 		pieceID := client.NewPieceID()
 		sizedReader := SizeReader(peekReader)
@@ -124,33 +267,22 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		// puts file to ecclient
 		successfulNodes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration)
 		if err != nil {
-			return Meta{}, Error.Wrap(err)
+			return Uploaded{}, Error.Wrap(err)
 		}
 
 		p, metadata, err := segmentInfo()
 		if err != nil {
-			return Meta{}, Error.Wrap(err)
+			return Uploaded{}, Error.Wrap(err)
 		}
 		path = p
 
 		pointer, err = s.makeRemotePointer(successfulNodes, pieceID, sizedReader.Size(), exp, metadata)
 		if err != nil {
-			return Meta{}, err
+			return Uploaded{}, err
 		}
 	}
 
-	// puts pointer to pointerDB
-	err = s.pdb.Put(ctx, path, pointer)
-	if err != nil {
-		return Meta{}, Error.Wrap(err)
-	}
-
-	// get the metadata for the newly uploaded segment
-	m, err := s.Meta(ctx, path)
-	if err != nil {
-		return Meta{}, Error.Wrap(err)
-	}
-	return m, nil
+	return Uploaded{Path: path, Pointer: pointer}, nil
 }
 
 // makeRemotePointer creates a pointer of type remote
@@ -231,32 +363,91 @@ func makeErasureScheme(rs *pb.RedundancyScheme) (eestream.ErasureScheme, error)
 	return es, nil
 }
 
-// Delete tells piece stores to delete a segment and deletes pointer from pointerdb
+// Delete deletes pointer from pointerdb. Pointerdb queues the deletion of
+// the pieces of the segment from the piece stores, unless they are still
+// referenced by a copy of it, so offline nodes do not slow down or fail the
+// delete.
 func (s *segmentStore) Delete(ctx context.Context, path paths.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	pr, err := s.pdb.Get(ctx, path)
+	_, err = s.pdb.Delete(ctx, path)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
-	if pr.GetType() == pb.Pointer_REMOTE {
-		seg := pr.GetRemote()
-		pid := client.PieceID(seg.PieceId)
-		nodes, err := s.lookupNodes(ctx, seg)
-		if err != nil {
-			return Error.Wrap(err)
-		}
+	return nil
+}
 
-		// ecclient sends delete request
-		err = s.ec.Delete(ctx, nodes, pid)
-		if err != nil {
+// DeleteAll deletes the pointers of the segments at the paths with a single
+// request to pointerdb, in their order, like Delete. Paths without a segment
+// are skipped.
+func (s *segmentStore) DeleteAll(ctx context.Context, paths []paths.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(paths) == 0 {
+		return nil
+	}
+
+	errs, err := s.pdb.BatchDelete(ctx, paths, false)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, err := range errs {
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
 			return Error.Wrap(err)
 		}
 	}
+	return nil
+}
+
+// Copy duplicates the segment at srcPath to dstPath with the given metadata.
+// The data is not transferred, the pieces of a remote segment are shared by
+// both segments.
+func (s *segmentStore) Copy(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	// deletes pointer from pointerdb
-	return s.pdb.Delete(ctx, path)
+	pr, err := s.pdb.Copy(ctx, srcPath, dstPath, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pr), nil
+}
+
+// Move moves the segment at srcPath to dstPath with the given metadata,
+// without transferring its data
+func (s *segmentStore) Move(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pr, err := s.pdb.Move(ctx, srcPath, dstPath, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return convertMeta(pr), nil
+}
+
+// Relocate moves, or copies if copy is true, the segments of the relocations
+// after deleting the segments at deletePaths, all at once or not at all.
+// Like with Copy, copied segments share their pieces.
+func (s *segmentStore) Relocate(ctx context.Context, relocations []Relocation, deletePaths []paths.Path, copy bool) (metas []Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pdbRelocations := make([]pdbclient.Relocation, len(relocations))
+	for i, relocation := range relocations {
+		pdbRelocations[i] = pdbclient.Relocation(relocation)
+	}
+
+	pointers, err := s.pdb.BatchMove(ctx, pdbRelocations, deletePaths, copy)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	metas = make([]Meta, len(pointers))
+	for i, pr := range pointers {
+		metas[i] = convertMeta(pr)
+	}
+	return metas, nil
 }
 
 // lookupNodes calls Lookup to get node addresses from the overlay
@@ -303,6 +494,21 @@ func (s *segmentStore) List(ctx context.Context, prefix, startAfter,
 	return items, more, nil
 }
 
+// ListStream calls fn with every segment below prefix after startAfter, like
+// List, but without paging. The listing stops at the first error of fn.
+func (s *segmentStore) ListStream(ctx context.Context, prefix, startAfter paths.Path,
+	recursive bool, metaFlags uint32, fn func(item ListItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.pdb.ListStream(ctx, prefix, startAfter, recursive, metaFlags, func(itm pdbclient.ListItem) error {
+		return fn(ListItem{
+			Path:     itm.Path,
+			Meta:     convertMeta(itm.Pointer),
+			IsPrefix: itm.IsPrefix,
+		})
+	})
+}
+
 // convertMeta converts pointer to segment metadata
 func convertMeta(pr *pb.Pointer) Meta {
 	return Meta{
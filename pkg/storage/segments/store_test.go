@@ -21,6 +21,7 @@ import (
 	pdb "storj.io/storj/pkg/pointerdb/pdbclient"
 	mock_pointerdb "storj.io/storj/pkg/pointerdb/pdbclient/mocks"
 	mock_ecclient "storj.io/storj/pkg/storage/ec/mocks"
+	"storj.io/storj/storage"
 )
 
 var (
@@ -70,7 +71,7 @@ func TestSegmentStoreMeta(t *testing.T) {
 		p := paths.New(tt.pathInput)
 
 		calls := []*gomock.Call{
-			mockPDB.EXPECT().Get(
+			mockPDB.EXPECT().Meta(
 				gomock.Any(), gomock.Any(),
 			).Return(tt.returnPointer, nil),
 		}
@@ -126,7 +127,7 @@ func TestSegmentStorePutRemote(t *testing.T) {
 			mockPDB.EXPECT().Put(
 				gomock.Any(), gomock.Any(), gomock.Any(),
 			).Return(nil),
-			mockPDB.EXPECT().Get(
+			mockPDB.EXPECT().Meta(
 				gomock.Any(), gomock.Any(),
 			),
 		}
@@ -139,6 +140,77 @@ func TestSegmentStorePutRemote(t *testing.T) {
 	}
 }
 
+func TestSegmentStorePutContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	for _, tt := range []struct {
+		name          string
+		referenceErr  error
+		readerContent string
+	}{
+		{"test referenced put", nil, "readerreaderreader"},
+		{"test uploaded put", storage.ErrKeyNotFound.New("content"), "readerreaderreader"},
+		{"test inline put", nil, "r"},
+	} {
+		mockOC := mock_overlay.NewMockClient(ctrl)
+		mockEC := mock_ecclient.NewMockClient(ctrl)
+		mockPDB := mock_pointerdb.NewMockClient(ctrl)
+		mockES := mock_eestream.NewMockErasureScheme(ctrl)
+		rs := eestream.RedundancyStrategy{
+			ErasureScheme: mockES,
+		}
+
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, 2}
+		p := paths.New("path/1")
+		r := strings.NewReader(tt.readerContent)
+
+		var calls []*gomock.Call
+		if len(tt.readerContent) <= 2 {
+			calls = append(calls,
+				mockPDB.EXPECT().Put(
+					gomock.Any(), p, gomock.Any(),
+				).Return(nil),
+				mockPDB.EXPECT().Meta(
+					gomock.Any(), p,
+				),
+			)
+		} else {
+			calls = append(calls, mockPDB.EXPECT().Reference(
+				gomock.Any(), "content", p, []byte("metadata"), gomock.Any(),
+			).Return(&pb.Pointer{Metadata: []byte("metadata")}, tt.referenceErr))
+		}
+		if tt.referenceErr != nil {
+			calls = append(calls,
+				mockES.EXPECT().TotalCount().Return(1),
+				mockOC.EXPECT().Choose(
+					gomock.Any(), gomock.Any(), gomock.Any(),
+				).Return([]*pb.Node{
+					{Id: "im-a-node"},
+				}, nil),
+				mockEC.EXPECT().Put(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				),
+				mockES.EXPECT().RequiredCount().Return(1),
+				mockES.EXPECT().TotalCount().Return(1),
+				mockES.EXPECT().ErasureShareSize().Return(1),
+				mockPDB.EXPECT().PutWithContentID(
+					gomock.Any(), p, gomock.Any(), "content",
+				).Return(nil),
+				mockPDB.EXPECT().Meta(
+					gomock.Any(), p,
+				),
+			)
+		}
+		gomock.InOrder(calls...)
+
+		_, err := ss.PutContent(ctx, "content", r, time.Unix(0, 0).UTC(), func() (paths.Path, []byte, error) {
+			return p, []byte("metadata"), nil
+		})
+		assert.NoError(t, err, tt.name)
+	}
+}
+
 func TestSegmentStorePutInline(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -171,7 +243,7 @@ func TestSegmentStorePutInline(t *testing.T) {
 			mockPDB.EXPECT().Put(
 				gomock.Any(), gomock.Any(), gomock.Any(),
 			).Return(nil),
-			mockPDB.EXPECT().Get(
+			mockPDB.EXPECT().Meta(
 				gomock.Any(), gomock.Any(),
 			),
 		}
@@ -248,8 +320,10 @@ func TestSegmentStoreGetRemote(t *testing.T) {
 		pointerType   pb.Pointer_DataType
 		size          int64
 		metadata      []byte
+		piecesInUse   bool
 	}{
-		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata")},
+		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata"), false},
+		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata"), true},
 	} {
 		mockOC := mock_overlay.NewMockClient(ctrl)
 		mockEC := mock_ecclient.NewMockClient(ctrl)
@@ -297,23 +371,18 @@ func TestSegmentStoreGetRemote(t *testing.T) {
 	}
 }
 
-func TestSegmentStoreDeleteInline(t *testing.T) {
+func TestSegmentStoreDelete(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	ti := time.Unix(0, 0).UTC()
-	someTime, err := ptypes.TimestampProto(ti)
-	assert.NoError(t, err)
-
 	for _, tt := range []struct {
-		pathInput     string
-		thresholdSize int
-		pointerType   pb.Pointer_DataType
-		inlineContent []byte
-		size          int64
-		metadata      []byte
+		pathInput   string
+		piecesInUse bool
+		err         error
 	}{
-		{"path/1/2/3", 10, pb.Pointer_INLINE, []byte("000"), int64(3), []byte("metadata")},
+		{"path/1/2/3", false, nil},
+		{"path/1/2/3", true, nil},
+		{"path/1/2/3", false, storage.ErrKeyNotFound.New("path/1/2/3")},
 	} {
 		mockOC := mock_overlay.NewMockClient(ctrl)
 		mockEC := mock_ecclient.NewMockClient(ctrl)
@@ -323,34 +392,27 @@ func TestSegmentStoreDeleteInline(t *testing.T) {
 			ErasureScheme: mockES,
 		}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
+		ss := segmentStore{mockOC, mockEC, mockPDB, rs, 10}
 		assert.NotNil(t, ss)
 
 		p := paths.New(tt.pathInput)
 
-		calls := []*gomock.Call{
-			mockPDB.EXPECT().Get(
-				gomock.Any(), gomock.Any(),
-			).Return(&pb.Pointer{
-				Type:           tt.pointerType,
-				InlineSegment:  tt.inlineContent,
-				CreationDate:   someTime,
-				ExpirationDate: someTime,
-				Size:           tt.size,
-				Metadata:       tt.metadata,
-			}, nil),
-			mockPDB.EXPECT().Delete(
-				gomock.Any(), gomock.Any(),
-			),
-		}
-		gomock.InOrder(calls...)
+		// the pieces are deleted asynchronously by pointerdb, so neither
+		// the overlay nor the piece stores are called
+		mockPDB.EXPECT().Delete(
+			gomock.Any(), p,
+		).Return(tt.piecesInUse, tt.err)
 
 		err := ss.Delete(ctx, p)
-		assert.NoError(t, err)
+		if tt.err != nil {
+			assert.True(t, storage.ErrKeyNotFound.Has(err))
+		} else {
+			assert.NoError(t, err)
+		}
 	}
 }
 
-func TestSegmentStoreDeleteRemote(t *testing.T) {
+func TestSegmentStoreCopy(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
@@ -358,61 +420,77 @@ func TestSegmentStoreDeleteRemote(t *testing.T) {
 	someTime, err := ptypes.TimestampProto(ti)
 	assert.NoError(t, err)
 
-	for _, tt := range []struct {
-		pathInput     string
-		thresholdSize int
-		pointerType   pb.Pointer_DataType
-		size          int64
-		metadata      []byte
-	}{
-		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata")},
-	} {
-		mockOC := mock_overlay.NewMockClient(ctrl)
-		mockEC := mock_ecclient.NewMockClient(ctrl)
-		mockPDB := mock_pointerdb.NewMockClient(ctrl)
-		mockES := mock_eestream.NewMockErasureScheme(ctrl)
-		rs := eestream.RedundancyStrategy{
-			ErasureScheme: mockES,
-		}
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+	rs := eestream.RedundancyStrategy{
+		ErasureScheme: mock_eestream.NewMockErasureScheme(ctrl),
+	}
 
-		ss := segmentStore{mockOC, mockEC, mockPDB, rs, tt.thresholdSize}
-		assert.NotNil(t, ss)
+	ss := segmentStore{mockOC, mockEC, mockPDB, rs, 10}
 
-		p := paths.New(tt.pathInput)
+	src := paths.New("path/1/2/3")
+	dst := paths.New("path/4/5/6")
+
+	mockPDB.EXPECT().Copy(
+		gomock.Any(), src, dst, []byte("metadata"),
+	).Return(&pb.Pointer{
+		Type:           pb.Pointer_REMOTE,
+		CreationDate:   someTime,
+		ExpirationDate: someTime,
+		Size:           10,
+		Metadata:       []byte("metadata"),
+	}, nil)
+
+	m, err := ss.Copy(ctx, src, dst, []byte("metadata"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, Meta{
+			Modified:   ti,
+			Expiration: ti,
+			Size:       10,
+			Data:       []byte("metadata"),
+		}, m)
+	}
+}
 
-		calls := []*gomock.Call{
-			mockPDB.EXPECT().Get(
-				gomock.Any(), gomock.Any(),
-			).Return(&pb.Pointer{
-				Type: tt.pointerType,
-				Remote: &pb.RemoteSegment{
-					Redundancy: &pb.RedundancyScheme{
-						Type:             pb.RedundancyScheme_RS,
-						MinReq:           1,
-						Total:            2,
-						RepairThreshold:  1,
-						SuccessThreshold: 2,
-					},
-					PieceId:      "here's my piece id",
-					RemotePieces: []*pb.RemotePiece{},
-				},
-				CreationDate:   someTime,
-				ExpirationDate: someTime,
-				Size:           tt.size,
-				Metadata:       tt.metadata,
-			}, nil),
-			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
-			mockEC.EXPECT().Delete(
-				gomock.Any(), gomock.Any(), gomock.Any(),
-			),
-			mockPDB.EXPECT().Delete(
-				gomock.Any(), gomock.Any(),
-			),
-		}
-		gomock.InOrder(calls...)
+func TestSegmentStoreMove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-		err := ss.Delete(ctx, p)
-		assert.NoError(t, err)
+	ti := time.Unix(0, 0).UTC()
+	someTime, err := ptypes.TimestampProto(ti)
+	assert.NoError(t, err)
+
+	mockOC := mock_overlay.NewMockClient(ctrl)
+	mockEC := mock_ecclient.NewMockClient(ctrl)
+	mockPDB := mock_pointerdb.NewMockClient(ctrl)
+	rs := eestream.RedundancyStrategy{
+		ErasureScheme: mock_eestream.NewMockErasureScheme(ctrl),
+	}
+
+	ss := segmentStore{mockOC, mockEC, mockPDB, rs, 10}
+
+	src := paths.New("path/1/2/3")
+	dst := paths.New("path/4/5/6")
+
+	mockPDB.EXPECT().Move(
+		gomock.Any(), src, dst, []byte("metadata"),
+	).Return(&pb.Pointer{
+		Type:           pb.Pointer_REMOTE,
+		CreationDate:   someTime,
+		ExpirationDate: someTime,
+		Size:           10,
+		Metadata:       []byte("metadata"),
+	}, nil)
+
+	m, err := ss.Move(ctx, src, dst, []byte("metadata"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, Meta{
+			Modified:   ti,
+			Expiration: ti,
+			Size:       10,
+			Data:       []byte("metadata"),
+		}, m)
 	}
 }
 
@@ -0,0 +1,197 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding"
+	"hash"
+	"io"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	ranger "storj.io/storj/pkg/ranger"
+)
+
+// deriveStreamKey derives a key for encrypting stream level metadata from the
+// content key of the path and the encryption key of the last segment. The
+// label separates the keys for different kinds of metadata.
+func deriveStreamKey(derivedKey *eestream.Key, lastSegmentKey *eestream.Key, label string) *eestream.Key {
+	mac := hmac.New(sha512.New, derivedKey[:])
+	_, _ = mac.Write(lastSegmentKey[:])
+	_, _ = mac.Write([]byte(label))
+
+	key := new(eestream.Key)
+	copy(key[:], mac.Sum(nil))
+	return key
+}
+
+// encryptStreamData encrypts stream level metadata with the key and prefixes
+// it with a random nonce. The key stays the same when a stream is copied onto
+// itself, so a fixed nonce would be reused for different data.
+func encryptStreamData(data []byte, cipher eestream.Cipher, key *eestream.Key) ([]byte, error) {
+	var nonce eestream.Nonce
+	_, err := rand.Read(nonce[:])
+	if err != nil {
+		return nil, err
+	}
+	encrypted, err := cipher.Encrypt(data, key, &nonce)
+	if err != nil {
+		return nil, err
+	}
+	return append(nonce[:], encrypted...), nil
+}
+
+// decryptStreamData decrypts stream level metadata encrypted by
+// encryptStreamData. Streams stored before the nonces were random use a zero
+// nonce that is not prefixed.
+func decryptStreamData(encrypted []byte, cipher eestream.Cipher, key *eestream.Key, randomNonce bool) ([]byte, error) {
+	var nonce eestream.Nonce
+	if randomNonce {
+		if len(encrypted) < eestream.NonceSize {
+			return nil, Error.New("invalid encrypted stream data")
+		}
+		copy(nonce[:], encrypted)
+		encrypted = encrypted[eestream.NonceSize:]
+	}
+	return cipher.Decrypt(encrypted, key, &nonce)
+}
+
+// encryptChecksum encrypts the content hash of a stream with a key derived
+// from the path and the last segment key
+func encryptChecksum(checksum []byte, cipher eestream.Cipher, derivedKey *eestream.Key, lastSegmentKey *eestream.Key) ([]byte, error) {
+	return encryptStreamData(checksum, cipher, deriveStreamKey(derivedKey, lastSegmentKey, "checksum"))
+}
+
+// decryptChecksum returns the content hash stored in the stream info, or nil
+// if the stream was uploaded without one
+func decryptChecksum(msi *pb.MetaStreamInfo, derivedKey *eestream.Key) ([]byte, error) {
+	if len(msi.EncryptedChecksum) == 0 {
+		return nil, nil
+	}
+
+	cipher := eestream.Cipher(msi.EncryptionType)
+	lastSegmentKey, err := decryptLastSegmentKey(msi, derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptStreamData(msi.EncryptedChecksum, cipher, deriveStreamKey(derivedKey, lastSegmentKey, "checksum"), msi.RandomNonces)
+}
+
+// decryptLastSegmentKey decrypts the encryption key of the last segment
+func decryptLastSegmentKey(msi *pb.MetaStreamInfo, derivedKey *eestream.Key) (*eestream.Key, error) {
+	var nonce eestream.Nonce
+	_, err := nonce.Increment(msi.NumberOfSegments - 1)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := eestream.Cipher(msi.EncryptionType).Decrypt(msi.LastSegmentEncryptionKey, derivedKey, &nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	key := new(eestream.Key)
+	copy(key[:], e)
+	return key, nil
+}
+
+// newChecksumHash returns the hash used for the end-to-end content checksum.
+// MD5 is used so the checksum can be served as an S3 compatible ETag.
+func newChecksumHash() hash.Hash {
+	return md5.New()
+}
+
+// marshalChecksum returns the state of the checksum hash after the data
+// written to it so far
+func marshalChecksum(checksum hash.Hash) ([]byte, error) {
+	marshaler, ok := checksum.(encoding.BinaryMarshaler)
+	if !ok {
+		return nil, Error.New("checksum state cannot be saved")
+	}
+	return marshaler.MarshalBinary()
+}
+
+// encryptChecksumState encrypts the checksum state of a pending upload with a
+// key derived from the path. The key is the same for every upload of the
+// path, so the state is prefixed with a random nonce.
+func encryptChecksumState(checksumState []byte, cipher eestream.Cipher, derivedKey *eestream.Key) ([]byte, error) {
+	return encryptStreamData(checksumState, cipher, deriveStreamKey(derivedKey, &eestream.Key{}, "checksum state"))
+}
+
+// decryptChecksumState returns the checksum hash restored from the encrypted
+// state of a pending upload
+func decryptChecksumState(encryptedState []byte, cipher eestream.Cipher, derivedKey *eestream.Key) (hash.Hash, error) {
+	checksumState, err := decryptStreamData(encryptedState, cipher, deriveStreamKey(derivedKey, &eestream.Key{}, "checksum state"), true)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := newChecksumHash()
+	unmarshaler, ok := checksum.(encoding.BinaryUnmarshaler)
+	if !ok {
+		return nil, Error.New("checksum state cannot be restored")
+	}
+	err = unmarshaler.UnmarshalBinary(checksumState)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return checksum, nil
+}
+
+// WithoutChecksumVerification returns a copy of the stream store that does
+// not verify the checksum of the streams read in full, so the readers that
+// check the data otherwise do not pay for hashing it
+func WithoutChecksumVerification(store Store) (Store, error) {
+	s, ok := store.(*streamStore)
+	if !ok {
+		return nil, Error.New("unsupported stream store")
+	}
+
+	unverified := *s
+	unverified.skipChecksum = true
+	return &unverified, nil
+}
+
+// checksumRanger verifies the content hash of the stream when it is read in
+// full. Partial reads are passed through unverified.
+type checksumRanger struct {
+	ranger.Ranger
+	checksum []byte
+}
+
+// Range implements Ranger.Range
+func (rr *checksumRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	r, err := rr.Ranger.Range(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+	if offset != 0 || length != rr.Size() {
+		return r, nil
+	}
+	return &checksumReader{ReadCloser: r, hash: newChecksumHash(), checksum: rr.checksum}, nil
+}
+
+// checksumReader hashes everything read through it and fails with an error
+// instead of io.EOF if the hash does not match the expected checksum
+type checksumReader struct {
+	io.ReadCloser
+	hash     hash.Hash
+	checksum []byte
+}
+
+func (r *checksumReader) Read(p []byte) (n int, err error) {
+	n, err = r.ReadCloser.Read(p)
+	_, _ = r.hash.Write(p[:n])
+	if err == io.EOF && !bytes.Equal(r.hash.Sum(nil), r.checksum) {
+		return n, Error.New("checksum mismatch")
+	}
+	return n, err
+}
@@ -0,0 +1,11 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"github.com/zeebo/errs"
+)
+
+// Error is the errs class of standard stream errors
+var Error = errs.Class("stream error")
@@ -0,0 +1,55 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"storj.io/storj/pkg/eestream"
+)
+
+// WithConvergentEncryption returns a copy of the stream store that encrypts
+// every segment with a key derived from its content and secret instead of a
+// random key. Segments with the same content are then encrypted to the same
+// data, so a segment uploaded before under the same API key is referenced
+// instead of uploaded again.
+//
+// Whoever knows secret can tell whether a segment holds some given content,
+// so it should be kept like an encryption key. The segment keys are still
+// encrypted with the keys derived from the path, like with random keys.
+func WithConvergentEncryption(store Store, secret []byte) (Store, error) {
+	s, ok := store.(*streamStore)
+	if !ok {
+		return nil, Error.New("unsupported stream store")
+	}
+	if len(secret) == 0 {
+		return nil, Error.New("convergence secret must not be empty")
+	}
+
+	convergent := *s
+	convergent.convergenceSecret = secret
+	return &convergent, nil
+}
+
+// convergentKey returns the encryption key of a segment with the given
+// plaintext and the content ID it is deduplicated by. Both depend on the
+// position of the segment and the encryption parameters, because they
+// determine the encrypted data too.
+func (s *streamStore) convergentKey(plaintext []byte, segmentIndex int64) (key eestream.Key, contentID string) {
+	hash := sha256.Sum256(plaintext)
+	params := fmt.Sprintf("%d/%d/%d/", segmentIndex, s.encType, s.encBlockSize)
+
+	mac := hmac.New(sha256.New, s.convergenceSecret)
+	_, _ = mac.Write([]byte("key/" + params))
+	_, _ = mac.Write(hash[:])
+	copy(key[:], mac.Sum(nil))
+
+	mac = hmac.New(sha256.New, s.convergenceSecret)
+	_, _ = mac.Write([]byte("id/" + params))
+	_, _ = mac.Write(hash[:])
+	return key, hex.EncodeToString(mac.Sum(nil))
+}
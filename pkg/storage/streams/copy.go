@@ -0,0 +1,224 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
+)
+
+// Copy creates a copy of the stream at srcPath at dstPath with the given
+// metadata, without transferring its data. The segments are duplicated in
+// pointerdb and only their encryption keys are re-encrypted with the key
+// derived from the new path.
+func (s *streamStore) Copy(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.relocate(ctx, srcPath, dstPath, func(msi *pb.MetaStreamInfo) {
+		msi.Metadata = metadata
+	}, false)
+}
+
+// Move moves the stream at srcPath to dstPath, without transferring its data.
+// Only the encryption keys of the segments are re-encrypted with the key
+// derived from the new path. The stream appears at dstPath and disappears at
+// srcPath at once.
+func (s *streamStore) Move(ctx context.Context, srcPath, dstPath paths.Path) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if srcPath.String() == dstPath.String() {
+		return s.Meta(ctx, srcPath)
+	}
+
+	return s.relocate(ctx, srcPath, dstPath, nil, true)
+}
+
+// relocate copies or moves the stream at srcPath to dstPath. All segments
+// are relocated in a single batch together with the deletion of the stream
+// and the abandoned upload previously at dstPath, so the stream is either
+// completely at dstPath or not at all. After a move the pieces stay with the
+// stream at dstPath.
+func (s *streamStore) relocate(ctx context.Context, srcPath, dstPath paths.Path, updateInfo func(msi *pb.MetaStreamInfo), move bool) (m Meta, err error) {
+	lastSegmentMeta, err := s.segments.Meta(ctx, srcPath.Prepend("l"))
+	if err != nil {
+		return Meta{}, err
+	}
+
+	msi := pb.MetaStreamInfo{}
+	err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	srcKey, err := s.deriveContentKey(srcPath)
+	if err != nil {
+		return Meta{}, err
+	}
+	dstKey, err := s.deriveContentKey(dstPath)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	samePath := srcPath.String() == dstPath.String()
+	var deletePaths []paths.Path
+	if !samePath {
+		deletePaths, err = s.replacedPaths(ctx, dstPath)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	var relocations []segments.Relocation
+	cipher := eestream.Cipher(msi.EncryptionType)
+	if !samePath {
+		for i := int64(0); i < msi.NumberOfSegments-1; i++ {
+			segmentMeta, err := s.segments.Meta(ctx, getSegmentPath(srcPath, i))
+			if err != nil {
+				return Meta{}, err
+			}
+
+			encryptedEncKey, err := reencryptKey(cipher, segmentMeta.Data, (*eestream.Key)(srcKey), (*eestream.Key)(dstKey), i)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			relocations = append(relocations, segments.Relocation{
+				SrcPath:  getSegmentPath(srcPath, i),
+				DstPath:  getSegmentPath(dstPath, i),
+				Metadata: encryptedEncKey,
+			})
+		}
+	}
+
+	checksum, err := decryptChecksum(&msi, (*eestream.Key)(srcKey))
+	if err != nil {
+		return Meta{}, err
+	}
+	msi.Metadata, err = decryptMetadata(&msi, (*eestream.Key)(srcKey))
+	if err != nil {
+		return Meta{}, err
+	}
+	lastSegmentKey, err := decryptLastSegmentKey(&msi, (*eestream.Key)(srcKey))
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if updateInfo != nil {
+		updateInfo(&msi)
+	}
+	if !move {
+		// a copy is a new version of the stream at dstPath
+		msi.VersionId, err = newVersionID()
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+	msi.LastSegmentEncryptionKey, err = reencryptKey(cipher, msi.LastSegmentEncryptionKey,
+		(*eestream.Key)(srcKey), (*eestream.Key)(dstKey), msi.NumberOfSegments-1)
+	if err != nil {
+		return Meta{}, err
+	}
+	if checksum != nil {
+		msi.EncryptedChecksum, err = encryptChecksum(checksum, cipher, (*eestream.Key)(dstKey), lastSegmentKey)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+	// unencrypted metadata of old streams gets encrypted too
+	metadata := msi.Metadata
+	msi.EncryptedMetadata, err = encryptMetadata(metadata, cipher, (*eestream.Key)(dstKey), lastSegmentKey)
+	if err != nil {
+		return Meta{}, err
+	}
+	msi.Metadata = nil
+	msi.KeyId = keyID((*eestream.Key)(dstKey))
+	msi.RandomNonces = true
+
+	lastSegmentMetaData, err := proto.Marshal(&msi)
+	if err != nil {
+		return Meta{}, err
+	}
+	relocations = append(relocations, segments.Relocation{
+		SrcPath:  srcPath.Prepend("l"),
+		DstPath:  dstPath.Prepend("l"),
+		Metadata: lastSegmentMetaData,
+	})
+
+	metas, err := s.segments.Relocate(ctx, relocations, deletePaths, !move)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta, err := convertMeta(metas[len(metas)-1])
+	if err != nil {
+		return Meta{}, err
+	}
+	streamMeta.Checksum = checksum
+	streamMeta.Data = metadata
+
+	return streamMeta, nil
+}
+
+// replacedPaths returns the paths of the segments of the stream and of the
+// abandoned upload at path, which are replaced by a stream relocated there
+func (s *streamStore) replacedPaths(ctx context.Context, path paths.Path) (replaced []paths.Path, err error) {
+	lastSegmentMeta, err := s.segments.Meta(ctx, path.Prepend("l"))
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return nil, err
+	}
+	if err == nil {
+		msi := pb.MetaStreamInfo{}
+		err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+		if err != nil {
+			return nil, err
+		}
+		for i := int64(0); i < msi.NumberOfSegments-1; i++ {
+			replaced = append(replaced, getSegmentPath(path, i))
+		}
+		replaced = append(replaced, path.Prepend("l"))
+	}
+
+	pending, err := s.getPendingUpload(ctx, path)
+	if storage.ErrKeyNotFound.Has(err) {
+		return replaced, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	completed, following, err := s.uploadedSegments(ctx, path, pending)
+	if err != nil {
+		return nil, err
+	}
+	for i := int64(0); i < completed; i++ {
+		replaced = append(replaced, getSegmentPath(path, i))
+	}
+	for _, i := range following {
+		replaced = append(replaced, getSegmentPath(path, i))
+	}
+	return append(replaced, path.Prepend("p")), nil
+}
+
+// reencryptKey decrypts the encryption key of a segment with the key derived
+// from the old path and encrypts it with the key derived from the new path
+func reencryptKey(cipher eestream.Cipher, encryptedEncKey []byte, srcKey, dstKey *eestream.Key, segmentIndex int64) ([]byte, error) {
+	var nonce eestream.Nonce
+	_, err := nonce.Increment(segmentIndex)
+	if err != nil {
+		return nil, err
+	}
+
+	encKey, err := cipher.Decrypt(encryptedEncKey, srcKey, &nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.Encrypt(encKey, dstKey, &nonce)
+}
@@ -0,0 +1,323 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	ranger "storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
+)
+
+// memorySegments is an in-memory segments.Store for testing the stream store
+// end to end without the network
+type memorySegments struct {
+	mu       sync.Mutex
+	segments map[string]memorySegment
+	// failPut fails the upload of a segment, or the relocation of a segment
+	// to the path, if it returns an error
+	failPut func(path paths.Path) error
+	// contents maps the content IDs to the paths of the uploaded segments
+	contents map[string]string
+	// references counts the segments stored by referencing a previous upload
+	references int
+	// commits counts the requests storing uploaded segments
+	commits int
+	// relocations counts the requests moving or copying segments
+	relocations int
+}
+
+type memorySegment struct {
+	data []byte
+	meta segments.Meta
+}
+
+func newMemorySegments() *memorySegments {
+	return &memorySegments{segments: map[string]memorySegment{}, contents: map[string]string{}}
+}
+
+func (m *memorySegments) Meta(ctx context.Context, path paths.Path) (segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seg, ok := m.segments[path.String()]
+	if !ok {
+		return segments.Meta{}, storage.ErrKeyNotFound.New("%s", path)
+	}
+	return seg.meta, nil
+}
+
+func (m *memorySegments) Get(ctx context.Context, path paths.Path) (ranger.Ranger, segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seg, ok := m.segments[path.String()]
+	if !ok {
+		return nil, segments.Meta{}, storage.ErrKeyNotFound.New("%s", path)
+	}
+	return ranger.ByteRanger(seg.data), seg.meta, nil
+}
+
+func (m *memorySegments) Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segments.Meta, error) {
+	buf, err := ioutil.ReadAll(data)
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	path, metadata, err := segmentInfo()
+	if err != nil {
+		return segments.Meta{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.failPut != nil {
+		if err := m.failPut(path); err != nil {
+			return segments.Meta{}, err
+		}
+	}
+
+	meta := segments.Meta{
+		Modified:   time.Now(),
+		Expiration: expiration,
+		Size:       int64(len(buf)),
+		Data:       metadata,
+	}
+	m.segments[path.String()] = memorySegment{data: buf, meta: meta}
+	return meta, nil
+}
+
+func (m *memorySegments) Upload(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segments.Uploaded, error) {
+	buf, err := ioutil.ReadAll(data)
+	if err != nil {
+		return segments.Uploaded{}, err
+	}
+	path, metadata, err := segmentInfo()
+	if err != nil {
+		return segments.Uploaded{}, err
+	}
+
+	if m.failPut != nil {
+		if err := m.failPut(path); err != nil {
+			return segments.Uploaded{}, err
+		}
+	}
+
+	exp, err := ptypes.TimestampProto(expiration)
+	if err != nil {
+		return segments.Uploaded{}, err
+	}
+	pointer := &pb.Pointer{
+		Type:           pb.Pointer_INLINE,
+		InlineSegment:  buf,
+		ExpirationDate: exp,
+		Metadata:       metadata,
+	}
+	return segments.Uploaded{Path: path, Pointer: pointer}, nil
+}
+
+func (m *memorySegments) Commit(ctx context.Context, uploaded []segments.Uploaded) ([]segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.commits++
+	metas := make([]segments.Meta, len(uploaded))
+	for i, segment := range uploaded {
+		expiration, err := ptypes.Timestamp(segment.Pointer.ExpirationDate)
+		if err != nil {
+			return nil, err
+		}
+		metas[i] = segments.Meta{
+			Modified:   time.Now(),
+			Expiration: expiration,
+			Size:       int64(len(segment.Pointer.InlineSegment)),
+			Data:       segment.Pointer.Metadata,
+		}
+		m.segments[segment.Path.String()] = memorySegment{data: segment.Pointer.InlineSegment, meta: metas[i]}
+	}
+	return metas, nil
+}
+
+func (m *memorySegments) PutContent(ctx context.Context, contentID string, data io.Reader, expiration time.Time, segmentInfo func() (paths.Path, []byte, error)) (segments.Meta, error) {
+	m.mu.Lock()
+	src, ok := m.segments[m.contents[contentID]]
+	m.mu.Unlock()
+	if ok {
+		path, metadata, err := segmentInfo()
+		if err != nil {
+			return segments.Meta{}, err
+		}
+
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		m.references++
+		meta := segments.Meta{
+			Modified:   time.Now(),
+			Expiration: expiration,
+			Size:       src.meta.Size,
+			Data:       metadata,
+		}
+		m.segments[path.String()] = memorySegment{data: src.data, meta: meta}
+		return meta, nil
+	}
+
+	var path paths.Path
+	meta, err := m.Put(ctx, data, expiration, func() (paths.Path, []byte, error) {
+		var metadata []byte
+		var err error
+		path, metadata, err = segmentInfo()
+		return path, metadata, err
+	})
+	if err != nil {
+		return segments.Meta{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.contents[contentID] = path.String()
+	return meta, nil
+}
+
+func (m *memorySegments) Delete(ctx context.Context, path paths.Path) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.segments[path.String()]; !ok {
+		return storage.ErrKeyNotFound.New("%s", path)
+	}
+	delete(m.segments, path.String())
+	return nil
+}
+
+func (m *memorySegments) DeleteAll(ctx context.Context, paths []paths.Path) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, path := range paths {
+		delete(m.segments, path.String())
+	}
+	return nil
+}
+
+func (m *memorySegments) Copy(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seg, ok := m.segments[srcPath.String()]
+	if !ok {
+		return segments.Meta{}, storage.ErrKeyNotFound.New("%s", srcPath)
+	}
+	seg.meta.Modified = time.Now()
+	seg.meta.Data = metadata
+	m.segments[dstPath.String()] = seg
+	return seg.meta, nil
+}
+
+func (m *memorySegments) Move(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seg, ok := m.segments[srcPath.String()]
+	if !ok {
+		return segments.Meta{}, storage.ErrKeyNotFound.New("%s", srcPath)
+	}
+	seg.meta.Data = metadata
+	delete(m.segments, srcPath.String())
+	m.segments[dstPath.String()] = seg
+	return seg.meta, nil
+}
+
+func (m *memorySegments) Relocate(ctx context.Context, relocations []segments.Relocation, deletePaths []paths.Path, copy bool) ([]segments.Meta, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.relocations++
+
+	// the changes are applied to a copy, so none of them is applied if any
+	// relocation fails
+	changed := make(map[string]memorySegment, len(m.segments))
+	for key, seg := range m.segments {
+		changed[key] = seg
+	}
+	for _, path := range deletePaths {
+		delete(changed, path.String())
+	}
+
+	metas := make([]segments.Meta, len(relocations))
+	for i, relocation := range relocations {
+		seg, ok := changed[relocation.SrcPath.String()]
+		if !ok {
+			return nil, storage.ErrKeyNotFound.New("%s", relocation.SrcPath)
+		}
+		if m.failPut != nil {
+			if err := m.failPut(relocation.DstPath); err != nil {
+				return nil, err
+			}
+		}
+		seg.meta.Data = relocation.Metadata
+		if copy {
+			seg.meta.Modified = time.Now()
+		} else {
+			delete(changed, relocation.SrcPath.String())
+		}
+		changed[relocation.DstPath.String()] = seg
+		metas[i] = seg.meta
+	}
+
+	m.segments = changed
+	return metas, nil
+}
+
+func (m *memorySegments) List(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int, metaFlags uint32) ([]segments.ListItem, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var items []segments.ListItem
+	for key, seg := range m.segments {
+		path := paths.New(key)
+		if !path.HasPrefix(prefix) || len(path) == len(prefix) {
+			continue
+		}
+		items = append(items, segments.ListItem{Path: path[len(prefix):], Meta: seg.meta})
+	}
+	sort.Slice(items, func(i, k int) bool {
+		return strings.Compare(items[i].Path.String(), items[k].Path.String()) < 0
+	})
+	return items, false, nil
+}
+
+func (m *memorySegments) ListStream(ctx context.Context, prefix, startAfter paths.Path, recursive bool, metaFlags uint32, fn func(item segments.ListItem) error) error {
+	items, _, err := m.List(ctx, prefix, startAfter, nil, recursive, 0, metaFlags)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// corrupt flips a byte in the stored data of the segment at path
+func (m *memorySegments) corrupt(path paths.Path) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	seg := m.segments[path.String()]
+	seg.data[0] ^= 0xff
+}
@@ -0,0 +1,55 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	proto "github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/segments"
+)
+
+// encryptMetadata encrypts the user-defined metadata of a stream with a key
+// derived from the path and the last segment key
+func encryptMetadata(metadata []byte, cipher eestream.Cipher, derivedKey *eestream.Key, lastSegmentKey *eestream.Key) ([]byte, error) {
+	if len(metadata) == 0 {
+		return nil, nil
+	}
+	return encryptStreamData(metadata, cipher, deriveStreamKey(derivedKey, lastSegmentKey, "metadata"))
+}
+
+// decryptMetadata returns the user-defined metadata stored in the stream
+// info. Streams uploaded before the metadata was encrypted keep it in plain.
+func decryptMetadata(msi *pb.MetaStreamInfo, derivedKey *eestream.Key) ([]byte, error) {
+	if len(msi.EncryptedMetadata) == 0 {
+		return msi.Metadata, nil
+	}
+
+	cipher := eestream.Cipher(msi.EncryptionType)
+	lastSegmentKey, err := decryptLastSegmentKey(msi, derivedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptStreamData(msi.EncryptedMetadata, cipher, deriveStreamKey(derivedKey, lastSegmentKey, "metadata"), msi.RandomNonces)
+}
+
+// getMetadata decrypts the user-defined metadata of the stream at the given
+// path from the metadata of its last segment
+func (s *streamStore) getMetadata(path paths.Path, lastSegmentMeta segments.Meta) ([]byte, error) {
+	msi := pb.MetaStreamInfo{}
+	err := proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := s.deriveContentKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptMetadata(&msi, (*eestream.Key)(derivedKey))
+}
@@ -0,0 +1,187 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+
+	ranger "storj.io/storj/pkg/ranger"
+)
+
+// prefetchWindow returns how many segments of a stream with the given segment
+// size may be buffered at the same time while downloading. A window of one or
+// less disables the prefetching.
+func (s *streamStore) prefetchWindow(segmentSize int64) int {
+	if segmentSize <= 0 {
+		return 0
+	}
+	n := int64(s.prefetchSegments) + 1
+	if limit := int64(s.maxBufferMem) / segmentSize; n > limit {
+		n = limit
+	}
+	return int(n)
+}
+
+// prefetchRanger concatenates segment rangers like ranger.Concat, but its
+// readers fetch up to window segments in parallel ahead of the reader.
+type prefetchRanger struct {
+	rangers []ranger.Ranger
+	size    int64
+	window  int
+}
+
+// newPrefetchRanger returns a ranger over the concatenated rangers that keeps
+// up to window segments buffered or in flight while being read
+func newPrefetchRanger(window int, rangers ...ranger.Ranger) ranger.Ranger {
+	if window <= 1 || len(rangers) <= 1 {
+		return ranger.Concat(rangers...)
+	}
+
+	var size int64
+	for _, rr := range rangers {
+		size += rr.Size()
+	}
+	return &prefetchRanger{rangers: rangers, size: size, window: window}
+}
+
+// Size implements Ranger.Size
+func (pr *prefetchRanger) Size() int64 {
+	return pr.size
+}
+
+// Range implements Ranger.Range
+func (pr *prefetchRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, Error.New("negative offset")
+	}
+	if length < 0 {
+		return nil, Error.New("negative length")
+	}
+	if offset+length > pr.size {
+		return nil, Error.New("range beyond end of stream")
+	}
+
+	var parts []prefetchPart
+	for _, rr := range pr.rangers {
+		if length <= 0 {
+			break
+		}
+		size := rr.Size()
+		if offset >= size {
+			offset -= size
+			continue
+		}
+		partLength := size - offset
+		if partLength > length {
+			partLength = length
+		}
+		parts = append(parts, prefetchPart{ranger: rr, offset: offset, length: partLength})
+		offset = 0
+		length -= partLength
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &prefetchReader{
+		ctx:    ctx,
+		cancel: cancel,
+		parts:  parts,
+		window: pr.window,
+		next:   bytes.NewReader(nil),
+	}, nil
+}
+
+// prefetchPart is the range of a single segment that is read
+type prefetchPart struct {
+	ranger         ranger.Ranger
+	offset, length int64
+
+	done chan struct{}
+	data []byte
+	err  error
+}
+
+// fetch downloads the whole part into memory
+func (p *prefetchPart) fetch(ctx context.Context) {
+	defer close(p.done)
+
+	r, err := p.ranger.Range(ctx, p.offset, p.length)
+	if err != nil {
+		p.err = err
+		return
+	}
+	defer func() {
+		if err := r.Close(); err != nil && p.err == nil {
+			p.err = err
+		}
+	}()
+
+	p.data = make([]byte, p.length)
+	_, p.err = io.ReadFull(r, p.data)
+}
+
+// prefetchReader reads the parts in order, while keeping the following parts
+// downloading in the background
+type prefetchReader struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	parts   []prefetchPart
+	window  int
+	started int // number of parts that were started to download
+	current int // index of the part being read
+	next    *bytes.Reader
+}
+
+// startFetches keeps the window of parts after the current one downloading
+func (r *prefetchReader) startFetches() {
+	for r.started < len(r.parts) && r.started < r.current+r.window {
+		part := &r.parts[r.started]
+		part.done = make(chan struct{})
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			part.fetch(r.ctx)
+		}()
+		r.started++
+	}
+}
+
+// Read implements io.Reader
+func (r *prefetchReader) Read(p []byte) (n int, err error) {
+	for r.next.Len() == 0 {
+		if r.current > 0 {
+			// release the buffer of the part that was read
+			r.parts[r.current-1].data = nil
+		}
+		if r.current >= len(r.parts) {
+			return 0, io.EOF
+		}
+
+		r.startFetches()
+		part := &r.parts[r.current]
+		select {
+		case <-part.done:
+		case <-r.ctx.Done():
+			return 0, r.ctx.Err()
+		}
+		if part.err != nil {
+			return 0, part.err
+		}
+
+		r.next = bytes.NewReader(part.data)
+		r.current++
+	}
+	return r.next.Read(p)
+}
+
+// Close implements io.Closer. It stops all downloads in the background.
+func (r *prefetchReader) Close() error {
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
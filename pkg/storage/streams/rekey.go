@@ -0,0 +1,232 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
+)
+
+// Rekey re-encrypts all versions of the stream at srcPath, stored with the
+// keys derived from the root key of src, with the keys derived from the root
+// key of dst and moves them to dstPath. Only the segment keys, the checksum
+// and the metadata are re-encrypted, the pieces of the segments stay where
+// they are. Both stores must use the same segments. Each version is moved in
+// a single batch together with the ID of its new key, and versions that
+// already carry the key ID of dst are skipped, so an interrupted rotation can
+// be run again.
+func Rekey(ctx context.Context, src, dst Store, srcPath, dstPath paths.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s, ok := src.(*streamStore)
+	if !ok {
+		return Error.New("unsupported source stream store")
+	}
+	d, ok := dst.(*streamStore)
+	if !ok {
+		return Error.New("unsupported destination stream store")
+	}
+
+	srcKey, err := s.deriveContentKey(srcPath)
+	if err != nil {
+		return err
+	}
+	dstKey, err := d.deriveContentKey(dstPath)
+	if err != nil {
+		return err
+	}
+
+	versions, err := s.listVersionIDs(ctx, srcPath)
+	if err != nil {
+		return err
+	}
+	for _, version := range versions {
+		err = s.rekey(ctx, segmentPaths{path: srcPath, version: version}, segmentPaths{path: dstPath, version: version},
+			(*eestream.Key)(srcKey), (*eestream.Key)(dstKey))
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.rekey(ctx, segmentPaths{path: srcPath}, segmentPaths{path: dstPath}, (*eestream.Key)(srcKey), (*eestream.Key)(dstKey))
+	if storage.ErrKeyNotFound.Has(err) && len(versions) > 0 {
+		// only the older versions of the stream are left
+		return nil
+	}
+	return err
+}
+
+// listVersionIDs returns the IDs of the older versions of the stream at path
+func (s *streamStore) listVersionIDs(ctx context.Context, path paths.Path) (versions []string, err error) {
+	var startAfter paths.Path
+	for {
+		items, more, err := s.segments.List(ctx, path.Prepend("vl"), startAfter, nil, true, 0, meta.None)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			// the versions of the streams below path are listed too
+			if len(item.Path) == 1 {
+				versions = append(versions, item.Path[0])
+			}
+		}
+		if !more || len(items) == 0 {
+			return versions, nil
+		}
+		startAfter = items[len(items)-1].Path
+	}
+}
+
+// Rekeyed reports whether all versions of the stream at path carry the ID of
+// the key the store s derives for path, so they were stored or rekeyed by s
+func Rekeyed(ctx context.Context, s Store, path paths.Path) (rekeyed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	d, ok := s.(*streamStore)
+	if !ok {
+		return false, Error.New("unsupported stream store")
+	}
+
+	key, err := d.deriveContentKey(path)
+	if err != nil {
+		return false, err
+	}
+	versions, err := d.listVersionIDs(ctx, path)
+	if err != nil {
+		return false, err
+	}
+
+	sps := []segmentPaths{{path: path}}
+	for _, version := range versions {
+		sps = append(sps, segmentPaths{path: path, version: version})
+	}
+	for _, sp := range sps {
+		lastSegmentMeta, err := d.segments.Meta(ctx, sp.last())
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) && sp.version == "" {
+				// only the older versions of the stream are left
+				continue
+			}
+			return false, err
+		}
+
+		msi := pb.MetaStreamInfo{}
+		err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+		if err != nil {
+			return false, err
+		}
+		if !bytes.Equal(msi.KeyId, keyID((*eestream.Key)(key))) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// rekey re-encrypts a single version of a stream with dstKey and moves all
+// its segments from src to dst at once. The version keeps its ID.
+func (s *streamStore) rekey(ctx context.Context, src, dst segmentPaths, srcKey, dstKey *eestream.Key) error {
+	lastSegmentMeta, err := s.segments.Meta(ctx, src.last())
+	if err != nil {
+		return err
+	}
+
+	msi := pb.MetaStreamInfo{}
+	err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case bytes.Equal(msi.KeyId, keyID(dstKey)):
+		// rekeyed by an interrupted rotation
+		return nil
+	case len(msi.KeyId) > 0 && !bytes.Equal(msi.KeyId, keyID(srcKey)):
+		return Error.New("%s is encrypted with an unknown key", src.last())
+	}
+
+	lastSegmentKey, err := decryptLastSegmentKey(&msi, srcKey)
+	if err != nil {
+		return err
+	}
+
+	var relocations []segments.Relocation
+	cipher := eestream.Cipher(msi.EncryptionType)
+	for i := int64(0); i < msi.NumberOfSegments-1; i++ {
+		segmentMeta, err := s.segments.Meta(ctx, src.segment(i))
+		if err != nil {
+			return err
+		}
+
+		encryptedEncKey, err := reencryptKey(cipher, segmentMeta.Data, srcKey, dstKey, i)
+		if err != nil {
+			return err
+		}
+
+		relocations = append(relocations, segments.Relocation{
+			SrcPath:  src.segment(i),
+			DstPath:  dst.segment(i),
+			Metadata: encryptedEncKey,
+		})
+	}
+
+	checksum, err := decryptChecksum(&msi, srcKey)
+	if err != nil {
+		return err
+	}
+	metadata, err := decryptMetadata(&msi, srcKey)
+	if err != nil {
+		return err
+	}
+
+	msi.LastSegmentEncryptionKey, err = reencryptKey(cipher, msi.LastSegmentEncryptionKey, srcKey, dstKey, msi.NumberOfSegments-1)
+	if err != nil {
+		return err
+	}
+	if checksum != nil {
+		msi.EncryptedChecksum, err = encryptChecksum(checksum, cipher, dstKey, lastSegmentKey)
+		if err != nil {
+			return err
+		}
+	}
+	msi.EncryptedMetadata, err = encryptMetadata(metadata, cipher, dstKey, lastSegmentKey)
+	if err != nil {
+		return err
+	}
+	msi.Metadata = nil
+	msi.KeyId = keyID(dstKey)
+	msi.RandomNonces = true
+
+	lastSegmentMetaData, err := proto.Marshal(&msi)
+	if err != nil {
+		return err
+	}
+	relocations = append(relocations, segments.Relocation{
+		SrcPath:  src.last(),
+		DstPath:  dst.last(),
+		Metadata: lastSegmentMetaData,
+	})
+
+	_, err = s.segments.Relocate(ctx, relocations, nil, false)
+	return err
+}
+
+// keyID returns the ID of a content key, which is stored with the versions
+// whose segment keys are encrypted with it. The ID is a MAC of a constant
+// with the key, so it does not reveal the key.
+func keyID(key *eestream.Key) []byte {
+	mac := hmac.New(sha256.New, key[:])
+	_, _ = mac.Write([]byte("key id"))
+	return mac.Sum(nil)[:16]
+}
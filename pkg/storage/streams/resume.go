@@ -0,0 +1,291 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/storage"
+)
+
+// Resume continues the pending upload of the stream at path. The data must be
+// the whole stream again, and the upload continues from the first missing
+// segment. The data of the segments stored as of the last checkpoint of the
+// upload is skipped, seeking if data is an io.Seeker, as the checksum state
+// after them is restored from the pending record. The data of the segments
+// stored after the checkpoint is only read to compute the checksum.
+func (s *streamStore) Resume(ctx context.Context, path paths.Path, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.checkScope(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	pending, err := s.getPendingUpload(ctx, path)
+	if err != nil {
+		return Meta{}, err
+	}
+	if pending.SegmentsSize != s.segmentSize ||
+		eestream.Cipher(pending.EncryptionType) != s.encType ||
+		int(pending.EncryptionBlockSize) != s.encBlockSize {
+		return Meta{}, Error.New("upload of %s was started with different segment or encryption settings", path)
+	}
+
+	// the last segment was uploaded, but the pending record was not removed
+	_, err = s.segments.Meta(ctx, path.Prepend("l"))
+	if err == nil {
+		err = s.segments.Delete(ctx, path.Prepend("p"))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return Meta{}, err
+		}
+		return s.Meta(ctx, path)
+	}
+	if !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	completed, following, err := s.uploadedSegments(ctx, path, pending)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	// segments after the first missing one were uploaded in parallel. They
+	// are uploaded again, so delete them not to leak them.
+	err = s.deleteSegments(ctx, path, following)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	derivedKey, err := s.deriveContentKey(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	checkpoint := pending.CommittedSegments
+	checksum := newChecksumHash()
+	if checkpoint > 0 {
+		checksum, err = decryptChecksumState(pending.EncryptedChecksumState, s.encType, (*eestream.Key)(derivedKey))
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	err = skipData(data, checkpoint*s.segmentSize)
+	if err == nil {
+		_, err = io.CopyN(checksum, data, (completed-checkpoint)*s.segmentSize)
+	}
+	if err == io.EOF {
+		return Meta{}, Error.New("data is shorter than the uploaded segments of %s", path)
+	}
+	if err != nil {
+		return Meta{}, err
+	}
+	offset := completed * s.segmentSize
+
+	return s.upload(ctx, path, data, metadata, expiration, checksum, completed, offset)
+}
+
+// skipData skips the next n bytes of data, or returns io.EOF if it is
+// shorter. Data that cannot seek, like a pipe, is read instead.
+func skipData(data io.Reader, n int64) error {
+	seeker, ok := data.(io.Seeker)
+	var current int64
+	var err error
+	if ok {
+		current, err = seeker.Seek(0, io.SeekCurrent)
+	}
+	if !ok || err != nil {
+		_, err = io.CopyN(ioutil.Discard, data, n)
+		return err
+	}
+	end, err := seeker.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if end-current < n {
+		return io.EOF
+	}
+	_, err = seeker.Seek(current+n, io.SeekStart)
+	return err
+}
+
+// Abort deletes the pending upload at path together with its uploaded
+// segments
+func (s *streamStore) Abort(ctx context.Context, path paths.Path) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.checkScope(path)
+	if err != nil {
+		return err
+	}
+
+	pending, err := s.getPendingUpload(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	// a completed stream owns its segments, only the record is left over
+	_, err = s.segments.Meta(ctx, path.Prepend("l"))
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return err
+	}
+	if err != nil {
+		completed, following, err := s.uploadedSegments(ctx, path, pending)
+		if err != nil {
+			return err
+		}
+
+		for i := int64(0); i < completed; i++ {
+			following = append(following, i)
+		}
+		err = s.deleteSegments(ctx, path, following)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.segments.Delete(ctx, path.Prepend("p"))
+}
+
+// ListPending lists the paths of the pending uploads inside p/, stripping off
+// the p/ prefix. The modification time of an item is the time the upload was
+// started.
+func (s *streamStore) ListPending(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	segments, more, err := s.segments.List(ctx, prefix.Prepend("p"), startAfter, endBefore, recursive, limit, meta.Modified|meta.Expiration)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items = make([]ListItem, len(segments))
+	for i, item := range segments {
+		items[i] = ListItem{
+			Path: item.Path,
+			Meta: Meta{
+				Modified:   item.Meta.Modified,
+				Expiration: item.Meta.Expiration,
+			},
+			IsPrefix: item.IsPrefix,
+		}
+	}
+
+	return items, more, nil
+}
+
+// putPendingUpload stores the pending upload record at p/<path> with the
+// settings needed to resume the upload, and the checkpoint of the committed
+// segments and the checksum state after them
+func (s *streamStore) putPendingUpload(ctx context.Context, path paths.Path, expiration time.Time, committed int64, checksumState []byte) error {
+	pending := &pb.PendingUpload{
+		SegmentsSize:        s.segmentSize,
+		EncryptionType:      int32(s.encType),
+		EncryptionBlockSize: int32(s.encBlockSize),
+		ParallelSegments:    int32(s.parallelUploads()),
+	}
+	if committed > 0 {
+		derivedKey, err := s.deriveContentKey(path)
+		if err != nil {
+			return err
+		}
+		pending.CommittedSegments = committed
+		pending.EncryptedChecksumState, err = encryptChecksumState(checksumState, s.encType, (*eestream.Key)(derivedKey))
+		if err != nil {
+			return err
+		}
+	}
+
+	record, err := proto.Marshal(pending)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.segments.Put(ctx, bytes.NewReader(nil), expiration, func() (paths.Path, []byte, error) {
+		return path.Prepend("p"), record, nil
+	})
+	return err
+}
+
+// getPendingUpload returns the pending upload record at p/<path>
+func (s *streamStore) getPendingUpload(ctx context.Context, path paths.Path) (*pb.PendingUpload, error) {
+	recordMeta, err := s.segments.Meta(ctx, path.Prepend("p"))
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &pb.PendingUpload{}
+	err = proto.Unmarshal(recordMeta.Data, pending)
+	if err != nil {
+		return nil, err
+	}
+	return pending, nil
+}
+
+// deletePendingUpload removes the pending upload record at p/<path>
+func (s *streamStore) deletePendingUpload(ctx context.Context, path paths.Path) {
+	err := s.segments.Delete(ctx, path.Prepend("p"))
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		zap.S().Warnf("Failed deleting a pending upload %v %v", path, err)
+	}
+}
+
+// uploadedSegments returns the number of segments of a pending upload that
+// were uploaded without a gap, and the indexes of the segments uploaded after
+// the first missing one. As a failed upload leaves at most as many segments
+// missing as were uploaded in parallel, the search ends after that many
+// consecutive missing segments.
+func (s *streamStore) uploadedSegments(ctx context.Context, path paths.Path, pending *pb.PendingUpload) (completed int64, following []int64, err error) {
+	// the segments before the checkpoint are known to be stored
+	first := pending.CommittedSegments
+	maxMissing := int64(pending.ParallelSegments)
+	if maxMissing < 1 {
+		maxMissing = 1
+	}
+
+	completed = -1
+	var missing int64
+	for i := first; missing < maxMissing; i++ {
+		_, err := s.segments.Meta(ctx, getSegmentPath(path, i))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return 0, nil, err
+		}
+		if err != nil {
+			if completed < 0 {
+				completed = i
+			}
+			missing++
+			continue
+		}
+		if completed >= 0 {
+			following = append(following, i)
+		}
+		missing = 0
+	}
+	return completed, following, nil
+}
+
+// deleteSegments deletes the segments of the stream with the given indexes
+// that exist
+func (s *streamStore) deleteSegments(ctx context.Context, path paths.Path, indexes []int64) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+	segmentPaths := make([]paths.Path, len(indexes))
+	for k, i := range indexes {
+		segmentPaths[k] = getSegmentPath(path, i)
+	}
+	return s.segments.DeleteAll(ctx, segmentPaths)
+}
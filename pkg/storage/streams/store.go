@@ -8,6 +8,7 @@ import (
 	"context"
 	"crypto/rand"
 	"fmt"
+	"hash"
 	"io"
 	"io/ioutil"
 	"time"
@@ -34,9 +35,12 @@ type Meta struct {
 	Expiration time.Time
 	Size       int64
 	Data       []byte
+	Checksum   []byte
+	Version    string
 }
 
-// convertMeta converts segment metadata to stream metadata
+// convertMeta converts segment metadata to stream metadata. The user-defined
+// metadata is encrypted with the path, so it is decrypted by getMetadata.
 func convertMeta(lastSegmentMeta segments.Meta) (Meta, error) {
 	msi := pb.MetaStreamInfo{}
 	err := proto.Unmarshal(lastSegmentMeta.Data, &msi)
@@ -44,12 +48,13 @@ func convertMeta(lastSegmentMeta segments.Meta) (Meta, error) {
 		return Meta{}, err
 	}
 
-	return Meta{
+	m := Meta{
 		Modified:   lastSegmentMeta.Modified,
 		Expiration: lastSegmentMeta.Expiration,
 		Size:       ((msi.NumberOfSegments - 1) * msi.SegmentsSize) + msi.LastSegmentSize,
-		Data:       msi.Metadata,
-	}, nil
+	}
+	m.Version = streamVersionID(&msi, m.Modified)
+	return m, nil
 }
 
 // Store interface methods for streams to satisfy to be a store
@@ -57,47 +62,117 @@ type Store interface {
 	Meta(ctx context.Context, path paths.Path) (Meta, error)
 	Get(ctx context.Context, path paths.Path) (ranger.Ranger, Meta, error)
 	Put(ctx context.Context, path paths.Path, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	Resume(ctx context.Context, path paths.Path, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	ListPending(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int) (items []ListItem, more bool, err error)
+	Abort(ctx context.Context, path paths.Path) error
+	Copy(ctx context.Context, srcPath, dstPath paths.Path, metadata []byte) (Meta, error)
+	Move(ctx context.Context, srcPath, dstPath paths.Path) (Meta, error)
 	Delete(ctx context.Context, path paths.Path) error
 	List(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListStream(ctx context.Context, prefix, startAfter paths.Path, recursive bool, metaFlags uint32, fn func(item ListItem) error) error
+	Archive(ctx context.Context, path paths.Path) (Meta, error)
+	GetVersion(ctx context.Context, path paths.Path, version string) (ranger.Ranger, Meta, error)
+	MetaVersion(ctx context.Context, path paths.Path, version string) (Meta, error)
+	DeleteVersion(ctx context.Context, path paths.Path, version string) error
+	ListVersions(ctx context.Context, prefix, startAfter, endBefore paths.Path, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
 // streamStore is a store for streams
 type streamStore struct {
-	segments     segments.Store
-	segmentSize  int64
-	rootKey      []byte
-	encBlockSize int
-	encType      eestream.Cipher
+	segments         segments.Store
+	segmentSize      int64
+	keyPrefix        paths.Path
+	rootKey          []byte
+	encBlockSize     int
+	encType          eestream.Cipher
+	uploadSegments   int
+	prefetchSegments int
+	maxBufferMem     int
+	// convergenceSecret enables convergent encryption if it is not empty
+	convergenceSecret []byte
+	// skipChecksum disables the verification of the checksum on Get
+	skipChecksum bool
+}
+
+// NewStreamStore creates a new stream store. Up to uploadSegments segments of
+// a stream are uploaded in parallel and up to prefetchSegments segments are
+// downloaded ahead of the reader, as long as their buffers fit in maxBufferMem
+// bytes.
+func NewStreamStore(segments segments.Store, segmentSize int64, rootKey string, encBlockSize int, encType int,
+	uploadSegments int, prefetchSegments int, maxBufferMem int) (Store, error) {
+	return NewScopedStreamStore(segments, segmentSize, nil, []byte(rootKey), encBlockSize, encType,
+		uploadSegments, prefetchSegments, maxBufferMem)
 }
 
-// NewStreamStore stuff
-func NewStreamStore(segments segments.Store, segmentSize int64, rootKey string, encBlockSize int, encType int) (Store, error) {
+// NewScopedStreamStore creates a new stream store that can only access the
+// streams below keyPrefix. The keys of the streams are derived from
+// prefixKey, the key derived from the root key for keyPrefix.
+func NewScopedStreamStore(segments segments.Store, segmentSize int64, keyPrefix paths.Path, prefixKey []byte, encBlockSize int, encType int,
+	uploadSegments int, prefetchSegments int, maxBufferMem int) (Store, error) {
 	if segmentSize <= 0 {
 		return nil, errs.New("segment size must be larger than 0")
 	}
-	if rootKey == "" {
+	if len(prefixKey) == 0 {
 		return nil, errs.New("encryption key must not be empty")
 	}
 	if encBlockSize <= 0 {
 		return nil, errs.New("encryption block size must be larger than 0")
 	}
+	if uploadSegments < 0 {
+		return nil, errs.New("number of parallel segment uploads must not be negative")
+	}
+	if prefetchSegments < 0 {
+		return nil, errs.New("number of prefetched segments must not be negative")
+	}
+	if maxBufferMem < 0 {
+		return nil, errs.New("maximum buffer memory must not be negative")
+	}
 
 	return &streamStore{
-		segments:     segments,
-		segmentSize:  segmentSize,
-		rootKey:      []byte(rootKey),
-		encBlockSize: encBlockSize,
-		encType:      eestream.Cipher(encType),
+		segments:         segments,
+		segmentSize:      segmentSize,
+		keyPrefix:        keyPrefix,
+		rootKey:          prefixKey,
+		encBlockSize:     encBlockSize,
+		encType:          eestream.Cipher(encType),
+		uploadSegments:   uploadSegments,
+		prefetchSegments: prefetchSegments,
+		maxBufferMem:     maxBufferMem,
 	}, nil
 }
 
+// checkScope checks that the stream at path is below the prefix of the store
+func (s *streamStore) checkScope(path paths.Path) error {
+	if len(path) <= len(s.keyPrefix) || !path.HasPrefix(s.keyPrefix) {
+		return Error.New("no access to %s", path)
+	}
+	return nil
+}
+
+// deriveContentKey derives the key of the content of the stream at path. The
+// keys of a scoped store can be derived only for the streams below its prefix.
+func (s *streamStore) deriveContentKey(path paths.Path) (*[32]byte, error) {
+	err := s.checkScope(path)
+	if err != nil {
+		return nil, err
+	}
+	return path[len(s.keyPrefix):].DeriveContentKey(s.rootKey)
+}
+
 // Put breaks up data as it comes in into s.segmentSize length pieces, then
 // store the first piece at s0/<path>, second piece at s1/<path>, and the
 // *last* piece at l/<path>. Store the given metadata, along with the number
-// of segments, in a new protobuf, in the metadata of l/<path>.
+// of segments, in a new protobuf, in the metadata of l/<path>. While the
+// upload is in progress a pending upload record is kept at p/<path>, so a
+// failed upload can be continued with Resume or cleaned up with Abort.
 func (s *streamStore) Put(ctx context.Context, path paths.Path, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	err = s.checkScope(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
 	// previously file uploaded?
 	err = s.Delete(ctx, path)
 	if err != nil && !storage.ErrKeyNotFound.Has(err) {
@@ -106,114 +181,256 @@ func (s *streamStore) Put(ctx context.Context, path paths.Path, data io.Reader,
 		return Meta{}, err
 	}
 
-	var currentSegment int64
-	var streamSize int64
-	var putMeta segments.Meta
+	// previously abandoned upload?
+	err = s.Abort(ctx, path)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	err = s.putPendingUpload(ctx, path, expiration, 0, nil)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return s.upload(ctx, path, data, metadata, expiration, newChecksumHash(), 0, 0)
+}
+
+// upload stores the data as the segments of the stream starting at
+// firstSegment. The checksum and the offset must already include the data of
+// all segments before. If the upload fails, the segments uploaded so far are
+// kept, so it can be resumed, unless the context was canceled.
+func (s *streamStore) upload(ctx context.Context, path paths.Path, data io.Reader, metadata []byte, expiration time.Time,
+	checksum hash.Hash, firstSegment int64, offset int64) (m Meta, err error) {
+	currentSegment := firstSegment
+	pending := newPendingSegments(s.segments, s.parallelUploads(), checksum, firstSegment)
 
 	defer func() {
 		select {
 		case <-ctx.Done():
+			// the uploaded segments are committed first, so their pieces
+			// are deleted with them
+			if err := pending.commit(context.Background()); err != nil {
+				zap.S().Warnf("Failed committing the segments of %v %v", path, err)
+			}
 			s.cancelHandler(context.Background(), currentSegment, path)
+			s.deletePendingUpload(context.Background(), path)
 		default:
+			if err == nil {
+				return
+			}
+			// keep the segments uploaded so far, so the upload can be resumed
+			if err := pending.commit(ctx); err != nil {
+				zap.S().Warnf("Failed committing the segments of %v %v", path, err)
+			}
 		}
 	}()
 
-	derivedKey, err := path.DeriveContentKey(s.rootKey)
+	derivedKey, err := s.deriveContentKey(path)
 	if err != nil {
 		return Meta{}, err
 	}
 
-	cipher := s.encType
+	version, err := newVersionID()
+	if err != nil {
+		return Meta{}, err
+	}
 
-	eofReader := NewEOFReader(data)
+	pending.checkpoint = func(ctx context.Context, committed int64, checksumState []byte) error {
+		return s.putPendingUpload(ctx, path, expiration, committed, checksumState)
+	}
 
-	for !eofReader.isEOF() && !eofReader.hasError() {
-		var encKey eestream.Key
-		_, err = rand.Read(encKey[:])
-		if err != nil {
-			return Meta{}, err
-		}
+	eofReader := NewEOFReader(io.TeeReader(data, checksum))
 
-		var nonce eestream.Nonce
-		_, err := nonce.Increment(currentSegment)
+	lastSegmentInfo := func(segmentIndex, segmentSize int64, encKey *eestream.Key, encryptedEncKey []byte) ([]byte, error) {
+		encryptedChecksum, err := encryptChecksum(checksum.Sum(nil), s.encType, (*eestream.Key)(derivedKey), encKey)
 		if err != nil {
-			return Meta{}, err
+			return nil, err
 		}
-
-		encrypter, err := cipher.NewEncrypter(&encKey, &nonce, s.encBlockSize)
+		encryptedMetadata, err := encryptMetadata(metadata, s.encType, (*eestream.Key)(derivedKey), encKey)
 		if err != nil {
-			return Meta{}, err
+			return nil, err
 		}
 
-		encryptedEncKey, err := cipher.Encrypt(encKey[:], (*eestream.Key)(derivedKey), &nonce)
-		if err != nil {
-			return Meta{}, err
+		msi := pb.MetaStreamInfo{
+			NumberOfSegments:         segmentIndex + 1,
+			SegmentsSize:             s.segmentSize,
+			LastSegmentSize:          segmentSize,
+			EncryptionType:           int32(s.encType),
+			EncryptionBlockSize:      int32(s.encBlockSize),
+			LastSegmentEncryptionKey: encryptedEncKey,
+			EncryptedChecksum:        encryptedChecksum,
+			EncryptedMetadata:        encryptedMetadata,
+			VersionId:                version,
+			KeyId:                    keyID((*eestream.Key)(derivedKey)),
+			RandomNonces:             true,
 		}
+		return proto.Marshal(&msi)
+	}
 
+	var putMeta segments.Meta
+	var streamSize int64
+	if s.parallelUploads() > 1 {
+		putMeta, streamSize, err = s.putParallel(ctx, path, eofReader, expiration, (*eestream.Key)(derivedKey), &currentSegment, lastSegmentInfo, pending)
+	} else {
+		putMeta, streamSize, err = s.putSequential(ctx, path, eofReader, expiration, (*eestream.Key)(derivedKey), &currentSegment, lastSegmentInfo, pending)
+	}
+	if err != nil {
+		return Meta{}, err
+	}
+
+	// the last segment is committed together with the segments before it
+	err = pending.finish(ctx)
+	if err != nil {
+		return Meta{}, err
+	}
+	if pending.committed {
+		putMeta = pending.last
+	}
+
+	// the stream is complete, so the upload is no longer pending
+	err = s.segments.Delete(ctx, path.Prepend("p"))
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	resultMeta := Meta{
+		Modified:   putMeta.Modified,
+		Expiration: expiration,
+		Size:       offset + streamSize,
+		Data:       metadata,
+		Checksum:   checksum.Sum(nil),
+		Version:    version,
+	}
+
+	return resultMeta, nil
+}
+
+// lastSegmentInfoFunc returns the serialized stream info to store with the
+// last segment of a stream
+type lastSegmentInfoFunc func(segmentIndex, segmentSize int64, encKey *eestream.Key, encryptedEncKey []byte) ([]byte, error)
+
+// putSequential uploads the segments of the stream one after another while
+// streaming them from the reader
+func (s *streamStore) putSequential(ctx context.Context, path paths.Path, eofReader *EOFReader, expiration time.Time,
+	derivedKey *eestream.Key, currentSegment *int64, lastSegmentInfo lastSegmentInfoFunc, pending *pendingSegments) (putMeta segments.Meta, streamSize int64, err error) {
+	for !eofReader.isEOF() && !eofReader.hasError() {
 		sizeReader := NewSizeReader(eofReader)
 		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
-		peekReader := segments.NewPeekThresholdReader(segmentReader)
-		largeData, err := peekReader.IsLargerThan(encrypter.InBlockSize())
+
+		putMeta, err = s.putSegment(ctx, path, *currentSegment, segmentReader, expiration, derivedKey, eofReader.isEOF, sizeReader.Size, lastSegmentInfo, pending.checksumState, pending)
 		if err != nil {
-			return Meta{}, err
-		}
-		var transformedReader io.Reader
-		if largeData {
-			paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), encrypter.InBlockSize())
-			transformedReader = eestream.TransformReader(paddedReader, encrypter, 0)
-		} else {
-			data, err := ioutil.ReadAll(peekReader)
-			if err != nil {
-				return Meta{}, err
-			}
-			cipherData, err := cipher.Encrypt(data, &encKey, &nonce)
-			if err != nil {
-				return Meta{}, err
-			}
-			transformedReader = bytes.NewReader(cipherData)
+			return segments.Meta{}, 0, err
 		}
 
-		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (paths.Path, []byte, error) {
-			if !eofReader.isEOF() {
-				segmentPath := getSegmentPath(path, currentSegment)
-				return segmentPath, encryptedEncKey, nil
-			}
+		*currentSegment++
+		streamSize += sizeReader.Size()
+	}
+	if eofReader.hasError() {
+		return segments.Meta{}, 0, eofReader.err
+	}
 
-			lastSegmentPath := path.Prepend("l")
-			msi := pb.MetaStreamInfo{
-				NumberOfSegments:         currentSegment + 1,
-				SegmentsSize:             s.segmentSize,
-				LastSegmentSize:          sizeReader.Size(),
-				Metadata:                 metadata,
-				EncryptionType:           int32(s.encType),
-				EncryptionBlockSize:      int32(s.encBlockSize),
-				LastSegmentEncryptionKey: encryptedEncKey,
-			}
-			lastSegmentMeta, err := proto.Marshal(&msi)
-			if err != nil {
-				return nil, nil, err
-			}
-			return lastSegmentPath, lastSegmentMeta, nil
-		})
+	return putMeta, streamSize, nil
+}
+
+// putSegment encrypts the data of a single segment with a new random key, or
+// the convergent key of its content, and uploads it. The segment is stored as the last one of the stream if isLast
+// reports true once the data has been read, and readState returns the state
+// of the stream checksum after its data. Unless the segment is stored by
+// its content, its pointer is added to pending instead of being stored right
+// away, and the returned metadata is empty.
+func (s *streamStore) putSegment(ctx context.Context, path paths.Path, segmentIndex int64, data io.Reader, expiration time.Time,
+	derivedKey *eestream.Key, isLast func() bool, segmentSize func() int64, lastSegmentInfo lastSegmentInfoFunc,
+	readState func() ([]byte, error), pending *pendingSegments) (putMeta segments.Meta, err error) {
+	cipher := s.encType
+
+	var encKey eestream.Key
+	var contentID string
+	if len(s.convergenceSecret) > 0 {
+		plaintext, err := ioutil.ReadAll(data)
+		if err != nil {
+			return segments.Meta{}, err
+		}
+		data = bytes.NewReader(plaintext)
+		encKey, contentID = s.convergentKey(plaintext, segmentIndex)
+	} else {
+		_, err = rand.Read(encKey[:])
 		if err != nil {
-			return Meta{}, err
+			return segments.Meta{}, err
 		}
+	}
 
-		currentSegment++
-		streamSize += sizeReader.Size()
+	var nonce eestream.Nonce
+	_, err = nonce.Increment(segmentIndex)
+	if err != nil {
+		return segments.Meta{}, err
 	}
-	if eofReader.hasError() {
-		return Meta{}, eofReader.err
+
+	encrypter, err := cipher.NewEncrypter(&encKey, &nonce, s.encBlockSize)
+	if err != nil {
+		return segments.Meta{}, err
 	}
 
-	resultMeta := Meta{
-		Modified:   putMeta.Modified,
-		Expiration: expiration,
-		Size:       streamSize,
-		Data:       metadata,
+	encryptedEncKey, err := cipher.Encrypt(encKey[:], derivedKey, &nonce)
+	if err != nil {
+		return segments.Meta{}, err
 	}
 
-	return resultMeta, nil
+	peekReader := segments.NewPeekThresholdReader(data)
+	largeData, err := peekReader.IsLargerThan(encrypter.InBlockSize())
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	var transformedReader io.Reader
+	if largeData {
+		paddedReader := eestream.PadReader(ioutil.NopCloser(peekReader), encrypter.InBlockSize())
+		transformedReader = eestream.TransformReader(paddedReader, encrypter, 0)
+	} else {
+		data, err := ioutil.ReadAll(peekReader)
+		if err != nil {
+			return segments.Meta{}, err
+		}
+		cipherData, err := cipher.Encrypt(data, &encKey, &nonce)
+		if err != nil {
+			return segments.Meta{}, err
+		}
+		transformedReader = bytes.NewReader(cipherData)
+	}
+
+	segmentInfo := func() (paths.Path, []byte, error) {
+		if !isLast() {
+			segmentPath := getSegmentPath(path, segmentIndex)
+			return segmentPath, encryptedEncKey, nil
+		}
+
+		lastSegmentPath := path.Prepend("l")
+		lastSegmentMeta, err := lastSegmentInfo(segmentIndex, segmentSize(), &encKey, encryptedEncKey)
+		if err != nil {
+			return nil, nil, err
+		}
+		return lastSegmentPath, lastSegmentMeta, nil
+	}
+
+	if contentID != "" {
+		putMeta, err = s.segments.PutContent(ctx, contentID, transformedReader, expiration, segmentInfo)
+		if err != nil {
+			return segments.Meta{}, err
+		}
+		checksumState, err := readState()
+		if err != nil {
+			return segments.Meta{}, err
+		}
+		pending.store(segmentIndex, checksumState)
+		return putMeta, nil
+	}
+	uploaded, err := s.segments.Upload(ctx, transformedReader, expiration, segmentInfo)
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	checksumState, err := readState()
+	if err != nil {
+		return segments.Meta{}, err
+	}
+	return segments.Meta{}, pending.add(ctx, segmentIndex, checksumState, uploaded, isLast())
 }
 
 // getSegmentPath returns the unique path for a particular segment
@@ -223,11 +440,20 @@ func getSegmentPath(p paths.Path, segNum int64) paths.Path {
 
 // Get returns a ranger that knows what the overall size is (from l/<path>)
 // and then returns the appropriate data from segments s0/<path>, s1/<path>,
-// ..., l/<path>.
+// ..., l/<path>. If the stream was uploaded with a checksum, reading the
+// whole range verifies it, unless the store was created with
+// WithoutChecksumVerification.
 func (s *streamStore) Get(ctx context.Context, path paths.Path) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, path.Prepend("l"))
+	return s.get(ctx, segmentPaths{path: path})
+}
+
+// get returns a ranger of the version of the stream stored at sp
+func (s *streamStore) get(ctx context.Context, sp segmentPaths) (rr ranger.Ranger, meta Meta, err error) {
+	path := sp.path
+
+	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, sp.last())
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -243,14 +469,24 @@ func (s *streamStore) Get(ctx context.Context, path paths.Path) (rr ranger.Range
 		return nil, Meta{}, err
 	}
 
-	derivedKey, err := path.DeriveContentKey(s.rootKey)
+	derivedKey, err := s.deriveContentKey(path)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	streamMeta.Checksum, err = decryptChecksum(&msi, (*eestream.Key)(derivedKey))
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	streamMeta.Data, err = decryptMetadata(&msi, (*eestream.Key)(derivedKey))
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
 	var rangers []ranger.Ranger
 	for i := int64(0); i < msi.NumberOfSegments-1; i++ {
-		currentPath := getSegmentPath(path, i)
+		currentPath := sp.segment(i)
 		size := msi.SegmentsSize
 		var nonce eestream.Nonce
 		_, err := nonce.Increment(i)
@@ -289,7 +525,10 @@ func (s *streamStore) Get(ctx context.Context, path paths.Path) (rr ranger.Range
 	}
 	rangers = append(rangers, decryptedLastSegmentRanger)
 
-	catRangers := ranger.Concat(rangers...)
+	catRangers := newPrefetchRanger(s.prefetchWindow(msi.SegmentsSize), rangers...)
+	if streamMeta.Checksum != nil && !s.skipChecksum {
+		catRangers = &checksumRanger{Ranger: catRangers, checksum: streamMeta.Checksum}
+	}
 
 	return catRangers, streamMeta, nil
 }
@@ -306,33 +545,75 @@ func (s *streamStore) Meta(ctx context.Context, path paths.Path) (Meta, error) {
 		return Meta{}, err
 	}
 
+	streamMeta.Checksum, err = s.getChecksum(path, lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta.Data, err = s.getMetadata(path, lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
 	return streamMeta, nil
 }
 
+// getChecksum decrypts the checksum of the stream at the given path from the
+// metadata of its last segment
+func (s *streamStore) getChecksum(path paths.Path, lastSegmentMeta segments.Meta) ([]byte, error) {
+	msi := pb.MetaStreamInfo{}
+	err := proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := s.deriveContentKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return decryptChecksum(&msi, (*eestream.Key)(derivedKey))
+}
+
 // Delete all the segments, with the last one last
 func (s *streamStore) Delete(ctx context.Context, path paths.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	lastSegmentMeta, err := s.segments.Meta(ctx, path.Prepend("l"))
+	err = s.checkScope(path)
 	if err != nil {
 		return err
 	}
 
+	sp := segmentPaths{path: path}
+	lastSegmentMeta, err := s.segments.Meta(ctx, sp.last())
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, sp, lastSegmentMeta)
+}
+
+// delete deletes the segments of the version of the stream stored at sp,
+// with the last one last
+func (s *streamStore) delete(ctx context.Context, sp segmentPaths, lastSegmentMeta segments.Meta) error {
 	msi := pb.MetaStreamInfo{}
-	err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	err := proto.Unmarshal(lastSegmentMeta.Data, &msi)
 	if err != nil {
 		return err
 	}
 
+	var segmentPaths []paths.Path
 	for i := 0; i < int(msi.NumberOfSegments-1); i++ {
-		currentPath := getSegmentPath(path, int64(i))
-		err := s.segments.Delete(ctx, currentPath)
+		segmentPaths = append(segmentPaths, sp.segment(int64(i)))
+	}
+	if len(segmentPaths) > 0 {
+		err = s.segments.DeleteAll(ctx, segmentPaths)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.segments.Delete(ctx, path.Prepend("l"))
+	return s.segments.Delete(ctx, sp.last())
 }
 
 // ListItem is a single item in a listing
@@ -346,29 +627,71 @@ type ListItem struct {
 func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore paths.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if metaFlags&meta.Size != 0 {
-		// Calculating the stream's size require also the user-defined metadata,
-		// where stream store keeps info about the number of segments and their size.
-		metaFlags |= meta.UserDefined
-	}
-
-	segments, more, err := s.segments.List(ctx, prefix.Prepend("l"), startAfter, endBefore, recursive, limit, metaFlags)
+	segments, more, err := s.segments.List(ctx, prefix.Prepend("l"), startAfter, endBefore, recursive, limit, segmentMetaFlags(metaFlags))
 	if err != nil {
 		return nil, false, err
 	}
 
 	items = make([]ListItem, len(segments))
 	for i, item := range segments {
-		newMeta, err := convertMeta(item.Meta)
+		items[i], err = s.convertListItem(prefix, item, metaFlags)
 		if err != nil {
 			return nil, false, err
 		}
-		items[i] = ListItem{Path: item.Path, Meta: newMeta, IsPrefix: item.IsPrefix}
 	}
 
 	return items, more, nil
 }
 
+// ListStream calls fn with every stream below prefix after startAfter, like
+// List, but without paging. The listing stops at the first error of fn.
+func (s *streamStore) ListStream(ctx context.Context, prefix, startAfter paths.Path, recursive bool, metaFlags uint32,
+	fn func(item ListItem) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return s.segments.ListStream(ctx, prefix.Prepend("l"), startAfter, recursive, segmentMetaFlags(metaFlags), func(item segments.ListItem) error {
+		streamItem, err := s.convertListItem(prefix, item, metaFlags)
+		if err != nil {
+			return err
+		}
+		return fn(streamItem)
+	})
+}
+
+// segmentMetaFlags returns the meta flags to list the last segments of
+// streams with to get the requested meta flags of the streams
+func segmentMetaFlags(metaFlags uint32) uint32 {
+	if metaFlags&(meta.Size|meta.Checksum) != 0 {
+		// Calculating the stream's size or checksum require also the
+		// user-defined metadata, where stream store keeps info about the
+		// number of segments, their size and the encrypted checksum.
+		metaFlags |= meta.UserDefined
+	}
+	return metaFlags
+}
+
+// convertListItem converts an item of a listing of the last segments below
+// prefix to the item of its stream with the requested meta flags
+func (s *streamStore) convertListItem(prefix paths.Path, item segments.ListItem, metaFlags uint32) (ListItem, error) {
+	newMeta, err := convertMeta(item.Meta)
+	if err != nil {
+		return ListItem{}, err
+	}
+	if metaFlags&meta.Checksum != 0 && !item.IsPrefix {
+		newMeta.Checksum, err = s.getChecksum(prefix.Append(item.Path...), item.Meta)
+		if err != nil {
+			return ListItem{}, err
+		}
+	}
+	if metaFlags&meta.UserDefined != 0 && !item.IsPrefix {
+		newMeta.Data, err = s.getMetadata(prefix.Append(item.Path...), item.Meta)
+		if err != nil {
+			return ListItem{}, err
+		}
+	}
+	return ListItem{Path: item.Path, Meta: newMeta, IsPrefix: item.IsPrefix}, nil
+}
+
 type lazySegmentRanger struct {
 	ranger        ranger.Ranger
 	segments      segments.Store
@@ -439,11 +762,15 @@ func decryptRanger(ctx context.Context, rr ranger.Ranger, decryptedSize int64, c
 
 // CancelHandler handles clean up of segments on receiving CTRL+C
 func (s *streamStore) cancelHandler(ctx context.Context, totalSegments int64, path paths.Path) {
-	for i := int64(0); i < totalSegments; i++ {
-		currentPath := getSegmentPath(path, i)
-		err := s.segments.Delete(ctx, currentPath)
-		if err != nil {
-			zap.S().Warnf("Failed deleting a segment %v %v", currentPath, err)
-		}
+	if totalSegments <= 0 {
+		return
+	}
+	segmentPaths := make([]paths.Path, totalSegments)
+	for i := range segmentPaths {
+		segmentPaths[i] = getSegmentPath(path, int64(i))
+	}
+	err := s.segments.DeleteAll(ctx, segmentPaths)
+	if err != nil {
+		zap.S().Warnf("Failed deleting the segments of %v %v", path, err)
 	}
 }
@@ -4,20 +4,28 @@
 package streams
 
 import (
+	"bytes"
 	"context"
+	"crypto/md5"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	proto "github.com/gogo/protobuf/proto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/zeebo/errs"
+	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/paths"
 	"storj.io/storj/pkg/pb"
 	ranger "storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
 )
 
 var (
@@ -71,7 +79,7 @@ func TestStreamStoreMeta(t *testing.T) {
 			Meta(gomock.Any(), gomock.Any()).
 			Return(test.segmentMeta, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0, 1, 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -99,11 +107,13 @@ func TestStreamStorePut(t *testing.T) {
 		Data:       []byte{},
 	}
 
+	checksum := md5.Sum([]byte("data"))
 	streamMeta := Meta{
 		Modified:   segmentMeta.Modified,
 		Expiration: segmentMeta.Expiration,
 		Size:       4,
 		Data:       []byte("metadata"),
+		Checksum:   checksum[:],
 	}
 
 	for i, test := range []struct {
@@ -135,15 +145,36 @@ func TestStreamStorePut(t *testing.T) {
 					}
 				}
 			})
+		mockSegmentStore.EXPECT().
+			Upload(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(segments.Uploaded{}, test.segmentError).
+			Do(func(ctx context.Context, data io.Reader, expiration time.Time, info func() (paths.Path, []byte, error)) {
+				for {
+					buf := make([]byte, 4)
+					_, err := data.Read(buf)
+					if err == io.EOF {
+						break
+					}
+				}
+			})
+		mockSegmentStore.EXPECT().
+			Commit(gomock.Any(), gomock.Any()).
+			Return([]segments.Meta{test.segmentMeta}, test.segmentError)
 
 		mockSegmentStore.EXPECT().
-			Meta(gomock.Any(), gomock.Any()).
+			Meta(gomock.Any(), paths.New(test.path).Prepend("l")).
 			Return(test.segmentMeta, test.segmentError)
 		mockSegmentStore.EXPECT().
-			Delete(gomock.Any(), gomock.Any()).
+			Delete(gomock.Any(), paths.New(test.path).Prepend("l")).
 			Return(test.segmentError)
+		mockSegmentStore.EXPECT().
+			Meta(gomock.Any(), paths.New(test.path).Prepend("p")).
+			Return(segments.Meta{}, storage.ErrKeyNotFound.New("%s", test.path))
+		mockSegmentStore.EXPECT().
+			Delete(gomock.Any(), paths.New(test.path).Prepend("p")).
+			Return(nil)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0, 1, 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -153,6 +184,9 @@ func TestStreamStorePut(t *testing.T) {
 			t.Fatal(err)
 		}
 
+		// every upload gets a new random version ID
+		assert.Len(t, meta.Version, 32, errTag)
+		meta.Version = ""
 		assert.Equal(t, test.streamMeta, meta, errTag)
 	}
 }
@@ -211,6 +245,7 @@ func TestStreamStoreGet(t *testing.T) {
 		Expiration: staticTime,
 		Size:       0,
 		Data:       nil,
+		Version:    versionID(staticTime),
 	}
 
 	for i, test := range []struct {
@@ -237,7 +272,7 @@ func TestStreamStoreGet(t *testing.T) {
 
 		gomock.InOrder(calls...)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0, 1, 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -286,7 +321,7 @@ func TestStreamStoreDelete(t *testing.T) {
 			Delete(gomock.Any(), gomock.Any()).
 			Return(test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0, 1, 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -330,7 +365,7 @@ func TestStreamStoreList(t *testing.T) {
 			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(test.segments, test.segmentMore, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, "key", 10, 0, 1, 0, 0)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -344,3 +379,973 @@ func TestStreamStoreList(t *testing.T) {
 		assert.Equal(t, test.streamMore, more, errTag)
 	}
 }
+
+func TestStreamStoreChecksum(t *testing.T) {
+	data := []byte(strings.Repeat("storj", 100))
+	checksum := md5.Sum(data)
+
+	// no encryption, so that the corrupted data is caught by the checksum
+	mem := newMemorySegments()
+	streamStore, err := NewStreamStore(mem, 64, "key", 16, 0, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := paths.New("bucket", "object")
+	putMeta, err := streamStore.Put(ctx, path, bytes.NewReader(data), nil, time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, checksum[:], putMeta.Checksum)
+
+	streamMeta, err := streamStore.Meta(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, checksum[:], streamMeta.Checksum)
+	}
+
+	items, _, err := streamStore.List(ctx, paths.New("bucket"), nil, nil, true, 0, meta.Checksum)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, checksum[:], items[0].Meta.Checksum)
+	}
+
+	rr, _, err := streamStore.Get(ctx, path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	r, err := rr.Range(ctx, 0, rr.Size())
+	if assert.NoError(t, err) {
+		downloaded, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+	}
+
+	mem.corrupt(getSegmentPath(path, 0))
+
+	rr, _, err = streamStore.Get(ctx, path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	r, err = rr.Range(ctx, 0, rr.Size())
+	if assert.NoError(t, err) {
+		_, err = ioutil.ReadAll(r)
+		assert.Error(t, err)
+	}
+
+	// the verification can be turned off
+	unverified, err := WithoutChecksumVerification(streamStore)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rr, _, err = unverified.Get(ctx, path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	r, err = rr.Range(ctx, 0, rr.Size())
+	if assert.NoError(t, err) {
+		downloaded, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.NotEqual(t, data, downloaded)
+	}
+}
+
+func TestStreamStoreMetadata(t *testing.T) {
+	data := []byte(strings.Repeat("storj", 100))
+	metadata := []byte("user-defined metadata")
+
+	mem := newMemorySegments()
+	streamStore, err := NewStreamStore(mem, 64, "key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := paths.New("bucket", "object")
+	putMeta, err := streamStore.Put(ctx, path, bytes.NewReader(data), metadata, time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, metadata, putMeta.Data)
+
+	// the metadata is not stored in plain
+	lastSegmentMeta, err := mem.Meta(ctx, path.Prepend("l"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.False(t, bytes.Contains(lastSegmentMeta.Data, metadata))
+
+	streamMeta, err := streamStore.Meta(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, metadata, streamMeta.Data)
+	}
+
+	_, streamMeta, err = streamStore.Get(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, metadata, streamMeta.Data)
+	}
+
+	items, _, err := streamStore.List(ctx, paths.New("bucket"), nil, nil, true, 0, meta.UserDefined)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, metadata, items[0].Meta.Data)
+	}
+
+	items, _, err = streamStore.List(ctx, paths.New("bucket"), nil, nil, true, 0, meta.Size)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Nil(t, items[0].Meta.Data)
+	}
+
+	var streamed []ListItem
+	err = streamStore.ListStream(ctx, paths.New("bucket"), nil, true, meta.UserDefined|meta.Size, func(item ListItem) error {
+		streamed = append(streamed, item)
+		return nil
+	})
+	if assert.NoError(t, err) && assert.Len(t, streamed, 1) {
+		assert.Equal(t, paths.New("object"), streamed[0].Path)
+		assert.Equal(t, metadata, streamed[0].Meta.Data)
+		assert.Equal(t, int64(len(data)), streamed[0].Meta.Size)
+	}
+
+	// streams uploaded before the metadata was encrypted are still readable
+	msi := pb.MetaStreamInfo{}
+	assert.NoError(t, proto.Unmarshal(lastSegmentMeta.Data, &msi))
+	msi.Metadata, msi.EncryptedMetadata = []byte("old metadata"), nil
+	legacyData, err := proto.Marshal(&msi)
+	assert.NoError(t, err)
+	_, err = mem.Copy(ctx, path.Prepend("l"), path.Prepend("l"), legacyData)
+	assert.NoError(t, err)
+
+	streamMeta, err = streamStore.Meta(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("old metadata"), streamMeta.Data)
+	}
+
+	// copying a stream onto itself encrypts the same metadata with the same
+	// key again, but with a new nonce
+	encryptedMetadata := func() []byte {
+		lastSegmentMeta, err := mem.Meta(ctx, path.Prepend("l"))
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		msi := pb.MetaStreamInfo{}
+		assert.NoError(t, proto.Unmarshal(lastSegmentMeta.Data, &msi))
+		assert.True(t, msi.RandomNonces)
+		return msi.EncryptedMetadata
+	}
+	_, err = streamStore.Copy(ctx, path, path, metadata)
+	assert.NoError(t, err)
+	first := encryptedMetadata()
+	_, err = streamStore.Copy(ctx, path, path, metadata)
+	assert.NoError(t, err)
+	second := encryptedMetadata()
+	assert.NotEqual(t, first[:eestream.NonceSize], second[:eestream.NonceSize])
+
+	// streams encrypted with a zero nonce are still readable
+	derivedKey, err := path.DeriveContentKey([]byte("key"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	lastSegmentMeta, err = mem.Meta(ctx, path.Prepend("l"))
+	assert.NoError(t, err)
+	msi = pb.MetaStreamInfo{}
+	assert.NoError(t, proto.Unmarshal(lastSegmentMeta.Data, &msi))
+	lastSegmentKey, err := decryptLastSegmentKey(&msi, (*eestream.Key)(derivedKey))
+	assert.NoError(t, err)
+	cipher := eestream.Cipher(msi.EncryptionType)
+	msi.EncryptedMetadata, err = cipher.Encrypt([]byte("zero nonce"), deriveStreamKey((*eestream.Key)(derivedKey), lastSegmentKey, "metadata"), &eestream.Nonce{})
+	assert.NoError(t, err)
+	msi.EncryptedChecksum = nil
+	msi.RandomNonces = false
+	legacyData, err = proto.Marshal(&msi)
+	assert.NoError(t, err)
+	_, err = mem.Copy(ctx, path.Prepend("l"), path.Prepend("l"), legacyData)
+	assert.NoError(t, err)
+
+	streamMeta, err = streamStore.Meta(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("zero nonce"), streamMeta.Data)
+	}
+}
+
+func TestStreamStoreParallelPut(t *testing.T) {
+	data := []byte(strings.Repeat("parallel", 100))
+
+	for i, test := range []struct {
+		uploadSegments int
+		maxBufferMem   int
+		parallel       int
+	}{
+		{1, 0, 0},
+		{4, 1024, 4},
+		{4, 128, 2},
+		{8, 1 << 20, 8},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		mem := newMemorySegments()
+		store, err := NewStreamStore(mem, 64, "key", 32, 1, test.uploadSegments, 0, test.maxBufferMem)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, test.parallel, store.(*streamStore).parallelUploads(), errTag)
+
+		path := paths.New("bucket", "object")
+		putMeta, err := store.Put(ctx, path, bytes.NewReader(data), []byte("metadata"), time.Time{})
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, int64(len(data)), putMeta.Size, errTag)
+		// 12 full segments of 64 bytes plus the last one with 32 bytes
+		assert.Len(t, mem.segments, 13, errTag)
+
+		rr, streamMeta, err := store.Get(ctx, path)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, []byte("metadata"), streamMeta.Data, errTag)
+
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if assert.NoError(t, err, errTag) {
+			downloaded, err := ioutil.ReadAll(r)
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, data, downloaded, errTag)
+		}
+	}
+}
+
+func TestStreamStoreBatchedCommits(t *testing.T) {
+	for i, uploadSegments := range []int{1, 4, 8} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		// 2.5 times as many segments as are uploaded in parallel and
+		// committed with a single request, with a shorter last segment
+		segmentCount := (uploadSegments*5 + 1) / 2
+		data := []byte(strings.Repeat("batched segments", segmentCount))
+		data = data[:len(data)-8]
+
+		mem := newMemorySegments()
+		store, err := NewStreamStore(mem, 16, "key", 32, 1, uploadSegments, 0, 1<<20)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		path := paths.New("bucket", "object")
+		_, err = store.Put(ctx, path, bytes.NewReader(data), nil, time.Time{})
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, (segmentCount+uploadSegments-1)/uploadSegments, mem.commits, errTag)
+		assert.Equal(t, segmentCount, len(mem.segments), errTag)
+
+		rr, _, err := store.Get(ctx, path)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if assert.NoError(t, err, errTag) {
+			downloaded, err := ioutil.ReadAll(r)
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, data, downloaded, errTag)
+		}
+
+		assert.NoError(t, store.Delete(ctx, path), errTag)
+		assert.Equal(t, 0, len(mem.segments), errTag)
+	}
+}
+
+// cancelingReader cancels the context once more than limit bytes were read
+type cancelingReader struct {
+	io.Reader
+	read   int
+	limit  int
+	cancel context.CancelFunc
+}
+
+func (r *cancelingReader) Read(p []byte) (n int, err error) {
+	n, err = r.Reader.Read(p)
+	r.read += n
+	if r.read > r.limit {
+		r.cancel()
+	}
+	return n, err
+}
+
+func TestStreamStoreParallelPutCancel(t *testing.T) {
+	mem := newMemorySegments()
+	store, err := NewStreamStore(mem, 64, "key", 32, 1, 4, 0, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	data := &cancelingReader{
+		Reader: bytes.NewReader(make([]byte, 1000)),
+		limit:  300,
+		cancel: cancel,
+	}
+	_, err = store.Put(ctx, paths.New("bucket", "object"), iotest.OneByteReader(data), nil, time.Time{})
+	assert.Error(t, err)
+	assert.Empty(t, mem.segments)
+}
+
+func TestStreamStorePrefetchGet(t *testing.T) {
+	data := []byte(strings.Repeat("prefetch", 100))
+
+	for i, test := range []struct {
+		prefetchSegments int
+		maxBufferMem     int
+		window           int
+	}{
+		{0, 0, 0},
+		{0, 1024, 1},
+		{2, 1024, 3},
+		{4, 128, 2},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		mem := newMemorySegments()
+		store, err := NewStreamStore(mem, 64, "key", 32, 1, 1, test.prefetchSegments, test.maxBufferMem)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, test.window, store.(*streamStore).prefetchWindow(64), errTag)
+
+		path := paths.New("bucket", "object")
+		_, err = store.Put(ctx, path, bytes.NewReader(data), nil, time.Time{})
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		rr, _, err := store.Get(ctx, path)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		for _, r := range []struct{ offset, length int64 }{
+			{0, int64(len(data))},
+			{0, 0},
+			{10, 64},
+			{64, 128},
+			{100, 500},
+			{int64(len(data)) - 1, 1},
+		} {
+			reader, err := rr.Range(ctx, r.offset, r.length)
+			if !assert.NoError(t, err, errTag) {
+				continue
+			}
+			downloaded, err := ioutil.ReadAll(reader)
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, data[r.offset:r.offset+r.length], downloaded, errTag)
+			assert.NoError(t, reader.Close(), errTag)
+		}
+
+		if test.window > 1 {
+			_, err = rr.Range(ctx, 1, int64(len(data)))
+			assert.Error(t, err, errTag)
+		}
+
+		// a missing segment fails the download
+		assert.NoError(t, mem.Delete(ctx, getSegmentPath(path, 5)), errTag)
+		rr, _, err = store.Get(ctx, path)
+		if assert.NoError(t, err, errTag) {
+			reader, err := rr.Range(ctx, 0, rr.Size())
+			if assert.NoError(t, err, errTag) {
+				_, err = ioutil.ReadAll(reader)
+				assert.Error(t, err, errTag)
+				assert.NoError(t, reader.Close(), errTag)
+			}
+		}
+	}
+}
+
+func TestStreamStoreResume(t *testing.T) {
+	data := []byte(strings.Repeat("resuming", 100))
+	checksum := md5.Sum(data)
+	path := paths.New("bucket", "object")
+	failedPath := getSegmentPath(path, 5)
+
+	for i, uploadSegments := range []int{1, 4} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+
+		mem := newMemorySegments()
+		mem.failPut = func(p paths.Path) error {
+			if p.String() == failedPath.String() {
+				return errs.New("upload failed")
+			}
+			return nil
+		}
+		store, err := NewStreamStore(mem, 64, "key", 32, 1, uploadSegments, 0, 1024)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+
+		_, err = store.Put(ctx, path, bytes.NewReader(data), []byte("metadata"), time.Time{})
+		assert.Error(t, err, errTag)
+
+		items, more, err := store.ListPending(ctx, paths.New("bucket"), nil, nil, false, 0)
+		if assert.NoError(t, err, errTag) {
+			assert.False(t, more, errTag)
+			if assert.Len(t, items, 1, errTag) {
+				assert.Equal(t, paths.New("object"), items[0].Path, errTag)
+			}
+		}
+
+		_, err = store.Resume(ctx, path, bytes.NewReader(data[:64*4]), []byte("metadata"), time.Time{})
+		assert.Error(t, err, errTag)
+
+		// at least the first batch of segments was checkpointed, so their
+		// data is skipped and not hashed again
+		pending, err := store.(*streamStore).getPendingUpload(ctx, path)
+		if assert.NoError(t, err, errTag) {
+			assert.True(t, pending.CommittedSegments >= 4, errTag)
+		}
+		resumed := append(make([]byte, 64*4), data[64*4:]...)
+
+		mem.failPut = nil
+		streamMeta, err := store.Resume(ctx, path, bytes.NewReader(resumed), []byte("metadata"), time.Time{})
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		assert.Equal(t, int64(len(data)), streamMeta.Size, errTag)
+		assert.Equal(t, checksum[:], streamMeta.Checksum, errTag)
+		// 12 full segments of 64 bytes plus the last one with 32 bytes
+		assert.Len(t, mem.segments, 13, errTag)
+
+		items, _, err = store.ListPending(ctx, paths.New("bucket"), nil, nil, false, 0)
+		assert.NoError(t, err, errTag)
+		assert.Empty(t, items, errTag)
+
+		rr, _, err := store.Get(ctx, path)
+		if !assert.NoError(t, err, errTag) {
+			continue
+		}
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if assert.NoError(t, err, errTag) {
+			downloaded, err := ioutil.ReadAll(r)
+			assert.NoError(t, err, errTag)
+			assert.Equal(t, data, downloaded, errTag)
+		}
+
+		_, err = store.Resume(ctx, path, bytes.NewReader(data), []byte("metadata"), time.Time{})
+		assert.True(t, storage.ErrKeyNotFound.Has(err), errTag)
+	}
+}
+
+func TestStreamStoreAbort(t *testing.T) {
+	data := []byte(strings.Repeat("aborting", 100))
+	path := paths.New("bucket", "object")
+
+	mem := newMemorySegments()
+	mem.failPut = func(p paths.Path) error {
+		if p.String() == getSegmentPath(path, 5).String() {
+			return errs.New("upload failed")
+		}
+		return nil
+	}
+	store, err := NewStreamStore(mem, 64, "key", 32, 1, 4, 0, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Put(ctx, path, bytes.NewReader(data), nil, time.Time{})
+	assert.Error(t, err)
+	assert.NotEmpty(t, mem.segments)
+
+	assert.NoError(t, store.Abort(ctx, path))
+	assert.Empty(t, mem.segments)
+	assert.True(t, storage.ErrKeyNotFound.Has(store.Abort(ctx, path)))
+
+	// a new upload cleans up the segments of an abandoned one
+	_, err = store.Put(ctx, path, bytes.NewReader(data), nil, time.Time{})
+	assert.Error(t, err)
+
+	mem.failPut = nil
+	_, err = store.Put(ctx, path, bytes.NewReader(data[:100]), nil, time.Time{})
+	assert.NoError(t, err)
+	assert.Len(t, mem.segments, 2)
+}
+
+func TestStreamStoreCopy(t *testing.T) {
+	data := []byte(strings.Repeat("copying", 100))
+	checksum := md5.Sum(data)
+	src := paths.New("bucket", "source")
+	dst := paths.New("other", "destination")
+
+	mem := newMemorySegments()
+	store, err := NewStreamStore(mem, 64, "key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Put(ctx, src, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Copy(ctx, paths.New("bucket", "missing"), dst, nil)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	streamMeta, err := store.Copy(ctx, src, dst, []byte("copied"))
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(len(data)), streamMeta.Size)
+	assert.Equal(t, checksum[:], streamMeta.Checksum)
+	assert.Equal(t, []byte("copied"), streamMeta.Data)
+
+	// the segment keys are encrypted with the key of the new directory
+	srcSegment, err := mem.Meta(ctx, getSegmentPath(src, 0))
+	assert.NoError(t, err)
+	dstSegment, err := mem.Meta(ctx, getSegmentPath(dst, 0))
+	assert.NoError(t, err)
+	assert.NotEqual(t, srcSegment.Data, dstSegment.Data)
+
+	assert.NoError(t, store.Delete(ctx, src))
+
+	// copying onto itself only replaces the metadata
+	_, err = store.Copy(ctx, dst, dst, []byte("replaced"))
+	assert.NoError(t, err)
+
+	rr, streamMeta, err := store.Get(ctx, dst)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []byte("replaced"), streamMeta.Data)
+	assert.Equal(t, checksum[:], streamMeta.Checksum)
+
+	r, err := rr.Range(ctx, 0, rr.Size())
+	if assert.NoError(t, err) {
+		downloaded, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+	}
+}
+
+func TestStreamStoreMove(t *testing.T) {
+	data := []byte(strings.Repeat("moving", 100))
+	checksum := md5.Sum(data)
+	src := paths.New("bucket", "source")
+	dst := paths.New("other", "destination")
+
+	mem := newMemorySegments()
+	store, err := NewStreamStore(mem, 64, "key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Put(ctx, src, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a longer stream at the destination is replaced
+	srcSegments := len(mem.segments)
+	_, err = store.Put(ctx, dst, bytes.NewReader(bytes.Repeat(data, 2)), nil, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allSegments := len(mem.segments)
+
+	_, err = store.Move(ctx, paths.New("bucket", "missing"), dst)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	// a failed move changes neither stream
+	mem.failPut = func(p paths.Path) error {
+		if p.String() == dst.Prepend("l").String() {
+			return errs.New("move failed")
+		}
+		return nil
+	}
+	_, err = store.Move(ctx, src, dst)
+	assert.Error(t, err)
+	assert.Len(t, mem.segments, allSegments)
+	_, err = mem.Meta(ctx, getSegmentPath(dst, 0))
+	assert.NoError(t, err)
+	_, _, err = store.Get(ctx, src)
+	assert.NoError(t, err)
+	mem.failPut = nil
+
+	relocations := mem.relocations
+	streamMeta, err := store.Move(ctx, src, dst)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// all segments are moved by a single request
+	assert.Equal(t, relocations+1, mem.relocations)
+	assert.Len(t, mem.segments, srcSegments)
+	assert.Equal(t, int64(len(data)), streamMeta.Size)
+	assert.Equal(t, checksum[:], streamMeta.Checksum)
+	assert.Equal(t, []byte("metadata"), streamMeta.Data)
+
+	// no segment is left at the source path
+	_, _, err = store.Get(ctx, src)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+	_, err = mem.Meta(ctx, getSegmentPath(src, 0))
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	rr, streamMeta, err := store.Get(ctx, dst)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, checksum[:], streamMeta.Checksum)
+
+	r, err := rr.Range(ctx, 0, rr.Size())
+	if assert.NoError(t, err) {
+		downloaded, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, data, downloaded)
+	}
+}
+
+func TestStreamStoreVersions(t *testing.T) {
+	path := paths.New("bucket", "versioned")
+	older := []byte(strings.Repeat("older", 30))
+	newer := []byte(strings.Repeat("newer", 10))
+
+	mem := newMemorySegments()
+	store, err := NewStreamStore(mem, 64, "key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(rr ranger.Ranger) []byte {
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		data, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		return data
+	}
+
+	_, err = store.Archive(ctx, path)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	olderMeta, err := store.Put(ctx, path, bytes.NewReader(older), []byte("older"), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEmpty(t, olderMeta.Version)
+
+	relocations := mem.relocations
+	archivedMeta, err := store.Archive(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, olderMeta.Version, archivedMeta.Version)
+	}
+	// all segments are archived by a single request
+	assert.Equal(t, relocations+1, mem.relocations)
+	_, err = store.Meta(ctx, path)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	newerMeta, err := store.Put(ctx, path, bytes.NewReader(newer), []byte("newer"), time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotEqual(t, olderMeta.Version, newerMeta.Version)
+
+	// both the archived and the current version can be read by their ID
+	rr, m, err := store.GetVersion(ctx, path, olderMeta.Version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, older, read(rr))
+		assert.Equal(t, []byte("older"), m.Data)
+	}
+	rr, _, err = store.GetVersion(ctx, path, newerMeta.Version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, newer, read(rr))
+	}
+	rr, _, err = store.GetVersion(ctx, path, "")
+	if assert.NoError(t, err) {
+		assert.Equal(t, newer, read(rr))
+	}
+
+	m, err = store.MetaVersion(ctx, path, olderMeta.Version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(len(older)), m.Size)
+		assert.Equal(t, olderMeta.Checksum, m.Checksum)
+	}
+	_, err = store.MetaVersion(ctx, path, "missing")
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	items, _, err := store.ListVersions(ctx, paths.New("bucket"), nil, nil, 0, meta.All)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, paths.New("versioned"), items[0].Path)
+		assert.Equal(t, olderMeta.Version, items[0].Meta.Version)
+		assert.Equal(t, int64(len(older)), items[0].Meta.Size)
+	}
+
+	// the current listing does not include the older versions
+	items, _, err = store.List(ctx, paths.New("bucket"), nil, nil, true, 0, meta.All)
+	if assert.NoError(t, err) {
+		assert.Len(t, items, 1)
+	}
+
+	assert.NoError(t, store.DeleteVersion(ctx, path, olderMeta.Version))
+	_, _, err = store.GetVersion(ctx, path, olderMeta.Version)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	for key := range mem.segments {
+		assert.False(t, strings.HasPrefix(key, "v"), key)
+	}
+
+	// streams stored without a version ID derive it from their modification
+	// date
+	legacy := paths.New("bucket", "legacy")
+	_, err = store.Put(ctx, legacy, bytes.NewReader(older), nil, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seg := mem.segments[legacy.Prepend("l").String()]
+	msi := pb.MetaStreamInfo{}
+	if err := proto.Unmarshal(seg.meta.Data, &msi); err != nil {
+		t.Fatal(err)
+	}
+	msi.VersionId = ""
+	if seg.meta.Data, err = proto.Marshal(&msi); err != nil {
+		t.Fatal(err)
+	}
+	mem.segments[legacy.Prepend("l").String()] = seg
+
+	legacyVersion := versionID(seg.meta.Modified)
+	m, err = store.MetaVersion(ctx, legacy, legacyVersion)
+	if assert.NoError(t, err) {
+		assert.Equal(t, legacyVersion, m.Version)
+	}
+	_, err = store.Archive(ctx, legacy)
+	assert.NoError(t, err)
+	rr, _, err = store.GetVersion(ctx, legacy, legacyVersion)
+	if assert.NoError(t, err) {
+		assert.Equal(t, older, read(rr))
+	}
+}
+
+func TestScopedStreamStore(t *testing.T) {
+	data := []byte(strings.Repeat("scoped", 100))
+
+	mem := newMemorySegments()
+	streamStore, err := NewStreamStore(mem, 64, "key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := paths.New("bucket", "dir", "object")
+	other := paths.New("bucket", "other", "object")
+	for _, p := range []paths.Path{path, other} {
+		_, err = streamStore.Put(ctx, p, bytes.NewReader(data), []byte("metadata"), time.Time{})
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	prefix := paths.New("bucket", "dir")
+	prefixKey, err := prefix.DeriveKey([]byte("key"), len(prefix))
+	if !assert.NoError(t, err) {
+		return
+	}
+	scoped, err := NewScopedStreamStore(mem, 64, prefix, prefixKey, 32, 1, 1, 0, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// the streams below the prefix are readable with the derived key
+	rr, streamMeta, err := scoped.Get(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("metadata"), streamMeta.Data)
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if assert.NoError(t, err) {
+			downloaded, err := ioutil.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, data, downloaded)
+			assert.NoError(t, r.Close())
+		}
+	}
+
+	// but the keys of the other streams cannot be derived
+	_, err = scoped.Meta(ctx, other)
+	assert.True(t, Error.Has(err))
+	_, _, err = scoped.Get(ctx, other)
+	assert.True(t, Error.Has(err))
+	for _, p := range []paths.Path{other, prefix, paths.New("bucket")} {
+		_, err = scoped.Put(ctx, p, bytes.NewReader(data), nil, time.Time{})
+		assert.True(t, Error.Has(err), p.String())
+	}
+	assert.True(t, Error.Has(scoped.Delete(ctx, other)))
+
+	// and are left untouched
+	_, err = streamStore.Meta(ctx, other)
+	assert.NoError(t, err)
+}
+
+func TestStreamStoreRekey(t *testing.T) {
+	path := paths.New("bucket", "object")
+	older := []byte(strings.Repeat("older", 30))
+	newer := []byte(strings.Repeat("newer", 30))
+
+	mem := newMemorySegments()
+	oldStore, err := NewStreamStore(mem, 64, "old key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newStore, err := NewStreamStore(mem, 64, "new key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	read := func(rr ranger.Ranger) []byte {
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		data, err := ioutil.ReadAll(r)
+		assert.NoError(t, err)
+		return data
+	}
+
+	olderMeta, err := oldStore.Put(ctx, path, bytes.NewReader(older), []byte("older"), time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	_, err = oldStore.Archive(ctx, path)
+	assert.NoError(t, err)
+	newerMeta, err := oldStore.Put(ctx, path, bytes.NewReader(newer), []byte("newer"), time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	pieces := len(mem.segments)
+
+	rekeyed, err := Rekeyed(ctx, oldStore, path)
+	if assert.NoError(t, err) {
+		assert.True(t, rekeyed)
+	}
+
+	// an interrupted rotation leaves every version either rekeyed or not
+	mem.failPut = func(p paths.Path) error {
+		if p.String() == path.Prepend("l").String() {
+			return errs.New("rekey failed")
+		}
+		return nil
+	}
+	assert.Error(t, Rekey(ctx, oldStore, newStore, path, path))
+	mem.failPut = nil
+	_, _, err = oldStore.Get(ctx, path)
+	assert.NoError(t, err)
+	rekeyed, err = Rekeyed(ctx, newStore, path)
+	if assert.NoError(t, err) {
+		assert.False(t, rekeyed)
+	}
+
+	relocations := mem.relocations
+	err = Rekey(ctx, oldStore, newStore, path, path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	// no segment was added or removed, and only the current version was left
+	assert.Len(t, mem.segments, pieces)
+	assert.Equal(t, relocations+1, mem.relocations)
+	rekeyed, err = Rekeyed(ctx, newStore, path)
+	if assert.NoError(t, err) {
+		assert.True(t, rekeyed)
+	}
+
+	// all versions are readable with the new key only, and keep their IDs
+	rr, m, err := newStore.Get(ctx, path)
+	if assert.NoError(t, err) {
+		assert.Equal(t, newer, read(rr))
+		assert.Equal(t, []byte("newer"), m.Data)
+		assert.Equal(t, newerMeta.Checksum, m.Checksum)
+		assert.Equal(t, newerMeta.Version, m.Version)
+	}
+	rr, m, err = newStore.GetVersion(ctx, path, olderMeta.Version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, older, read(rr))
+		assert.Equal(t, []byte("older"), m.Data)
+	}
+	_, err = oldStore.Meta(ctx, path)
+	assert.Error(t, err)
+
+	// rekeying again is harmless
+	relocations = mem.relocations
+	assert.NoError(t, Rekey(ctx, oldStore, newStore, path, path))
+	assert.Equal(t, relocations, mem.relocations)
+
+	// versions encrypted with another key are not touched
+	otherStore, err := NewStreamStore(mem, 64, "other key", 32, 1, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Error(t, Rekey(ctx, otherStore, oldStore, path, path))
+
+	// and the stream can be moved while it is rekeyed
+	moved := paths.New("bucket", "moved")
+	err = Rekey(ctx, newStore, oldStore, path, moved)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rr, _, err = oldStore.Get(ctx, moved)
+	if assert.NoError(t, err) {
+		assert.Equal(t, newer, read(rr))
+	}
+	rr, _, err = oldStore.GetVersion(ctx, moved, olderMeta.Version)
+	if assert.NoError(t, err) {
+		assert.Equal(t, older, read(rr))
+	}
+	_, err = newStore.Meta(ctx, path)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+	assert.Len(t, mem.segments, pieces)
+}
+
+func TestStreamStoreConvergentEncryption(t *testing.T) {
+	data := []byte(strings.Repeat("converging", 100))
+	numSegments := (len(data) + 63) / 64
+
+	mem := newMemorySegments()
+	newStore := func(rootKey, secret string) Store {
+		store, err := NewStreamStore(mem, 64, rootKey, 32, 1, 1, 0, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if secret == "" {
+			return store
+		}
+		store, err = WithConvergentEncryption(store, []byte(secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return store
+	}
+
+	_, err := WithConvergentEncryption(newStore("key", ""), nil)
+	assert.Error(t, err)
+
+	first := newStore("key", "secret")
+	_, err = first.Put(ctx, paths.New("bucket", "first"), bytes.NewReader(data), nil, time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 0, mem.references)
+
+	// the segments uploaded with the same secret are referenced, even with
+	// another root key
+	second := newStore("other key", "secret")
+	_, err = second.Put(ctx, paths.New("bucket", "second"), bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, numSegments, mem.references)
+
+	rr, streamMeta, err := second.Get(ctx, paths.New("bucket", "second"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("metadata"), streamMeta.Data)
+		r, err := rr.Range(ctx, 0, rr.Size())
+		if assert.NoError(t, err) {
+			downloaded, err := ioutil.ReadAll(r)
+			assert.NoError(t, err)
+			assert.Equal(t, data, downloaded)
+		}
+	}
+
+	// other secrets and random keys do not deduplicate
+	_, err = newStore("key", "other secret").Put(ctx, paths.New("bucket", "third"), bytes.NewReader(data), nil, time.Time{})
+	assert.NoError(t, err)
+	_, err = newStore("key", "").Put(ctx, paths.New("bucket", "fourth"), bytes.NewReader(data), nil, time.Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, numSegments, mem.references)
+}
@@ -0,0 +1,237 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"bytes"
+	"context"
+	"hash"
+	"io"
+	"sync"
+	"time"
+
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/storage/segments"
+)
+
+// pendingSegments collects the uploaded segments of a stream whose pointers
+// are not stored yet, so they can be stored in batches instead of one request
+// per segment. A batch has as many segments as are uploaded in parallel, so
+// a failed upload leaves no more uploaded segments without pointers, whose
+// pieces leak, than without batching, and Resume finds all stored segments
+// by searching as many missing segments in a row.
+type pendingSegments struct {
+	segments  segments.Store
+	batchSize int
+	// checksum is the hash of the stream data read so far
+	checksum hash.Hash
+	// checkpoint, if not nil, is called after a commit with the number of
+	// segments stored without a gap and the state of the checksum after
+	// them, so a resumed upload does not hash their data again
+	checkpoint func(ctx context.Context, committed int64, checksumState []byte) error
+
+	mu       sync.Mutex
+	uploaded []segments.Uploaded
+	// indexes and checksumStates belong to the uploaded segments
+	indexes        []int64
+	checksumStates [][]byte
+	// next is the index of the first segment not stored yet, and stored has
+	// the checksum states after the segments stored past it
+	next   int64
+	stored map[int64][]byte
+	// last is the metadata of the last segment committed so far
+	last      segments.Meta
+	committed bool
+}
+
+func newPendingSegments(store segments.Store, batchSize int, checksum hash.Hash, firstSegment int64) *pendingSegments {
+	return &pendingSegments{
+		segments:  store,
+		batchSize: batchSize,
+		checksum:  checksum,
+		next:      firstSegment,
+		stored:    map[int64][]byte{},
+	}
+}
+
+// checksumState returns the current state of the checksum
+func (p *pendingSegments) checksumState() ([]byte, error) {
+	return marshalChecksum(p.checksum)
+}
+
+// add adds an uploaded segment with the checksum state after its data, and
+// commits the collected segments once there are batchSize of them. The last
+// segment of the stream is left for finish.
+func (p *pendingSegments) add(ctx context.Context, index int64, checksumState []byte, segment segments.Uploaded, last bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.uploaded = append(p.uploaded, segment)
+	p.indexes = append(p.indexes, index)
+	p.checksumStates = append(p.checksumStates, checksumState)
+	if last || len(p.uploaded) < p.batchSize {
+		return nil
+	}
+	return p.commitLocked(ctx)
+}
+
+// store records a segment whose pointer was stored without being collected
+func (p *pendingSegments) store(index int64, checksumState []byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.stored[index] = checksumState
+}
+
+// commit stores the pointers of all collected segments
+func (p *pendingSegments) commit(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.commitLocked(ctx)
+}
+
+// finish stores the pointers of all collected segments of a complete stream,
+// which needs no checkpoint
+func (p *pendingSegments) finish(ctx context.Context) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.checkpoint = nil
+	return p.commitLocked(ctx)
+}
+
+func (p *pendingSegments) commitLocked(ctx context.Context) error {
+	if len(p.uploaded) > 0 {
+		metas, err := p.segments.Commit(ctx, p.uploaded)
+		if err != nil {
+			return err
+		}
+		for i, index := range p.indexes {
+			p.stored[index] = p.checksumStates[i]
+		}
+		p.uploaded, p.indexes, p.checksumStates = nil, nil, nil
+		p.last = metas[len(metas)-1]
+		p.committed = true
+	}
+
+	var checksumState []byte
+	for {
+		state, ok := p.stored[p.next]
+		if !ok {
+			break
+		}
+		delete(p.stored, p.next)
+		checksumState = state
+		p.next++
+	}
+	if checksumState == nil || p.checkpoint == nil {
+		return nil
+	}
+	return p.checkpoint(ctx, p.next, checksumState)
+}
+
+// parallelUploads returns how many segments of a stream can be uploaded at
+// the same time without their buffers exceeding the memory budget
+func (s *streamStore) parallelUploads() int {
+	n := int64(s.uploadSegments)
+	if limit := int64(s.maxBufferMem) / s.segmentSize; n > limit {
+		n = limit
+	}
+	return int(n)
+}
+
+// putParallel reads the stream into segment sized buffers and uploads up to
+// parallelUploads() of them at the same time. The last segment is uploaded
+// only after all other segments are stored, so the stream becomes visible
+// only when it is complete.
+func (s *streamStore) putParallel(ctx context.Context, path paths.Path, eofReader *EOFReader, expiration time.Time,
+	derivedKey *eestream.Key, currentSegment *int64, lastSegmentInfo lastSegmentInfoFunc, pending *pendingSegments) (putMeta segments.Meta, streamSize int64, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	// wait returns the first error of the uploads after all of them finished
+	wait := func() error {
+		wg.Wait()
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			return ctx.Err()
+		}
+		return firstErr
+	}
+
+	slots := make(chan struct{}, s.parallelUploads())
+	for {
+		// wait for a free upload slot before buffering the next segment
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			return segments.Meta{}, 0, wait()
+		}
+		if ctx.Err() != nil {
+			return segments.Meta{}, 0, wait()
+		}
+
+		buf := make([]byte, s.segmentSize)
+		n, err := io.ReadFull(eofReader, buf)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			fail(err)
+			return segments.Meta{}, 0, wait()
+		}
+		data := buf[:n]
+		checksumState, err := pending.checksumState()
+		if err != nil {
+			fail(err)
+			return segments.Meta{}, 0, wait()
+		}
+		readState := func() ([]byte, error) { return checksumState, nil }
+
+		segmentIndex := *currentSegment
+		*currentSegment++
+		streamSize += int64(n)
+
+		if eofReader.isEOF() {
+			err = wait()
+			if err != nil {
+				return segments.Meta{}, 0, err
+			}
+
+			isLast := func() bool { return true }
+			size := func() int64 { return int64(len(data)) }
+			putMeta, err = s.putSegment(ctx, path, segmentIndex, bytes.NewReader(data), expiration, derivedKey, isLast, size, lastSegmentInfo, readState, pending)
+			if err != nil {
+				return segments.Meta{}, 0, err
+			}
+			return putMeta, streamSize, nil
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			isLast := func() bool { return false }
+			size := func() int64 { return int64(len(data)) }
+			_, err := s.putSegment(ctx, path, segmentIndex, bytes.NewReader(data), expiration, derivedKey, isLast, size, lastSegmentInfo, readState, pending)
+			if err != nil {
+				fail(err)
+			}
+		}()
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"time"
+
+	proto "github.com/gogo/protobuf/proto"
+
+	"storj.io/storj/pkg/paths"
+	"storj.io/storj/pkg/pb"
+	ranger "storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/storage"
+)
+
+// versionID returns the ID of the version of a stream whose last segment was
+// stored at the given time. The IDs of newer versions sort before the IDs of
+// older ones. Streams stored before the version IDs were stored with them
+// use it as their ID.
+func versionID(modified time.Time) string {
+	return fmt.Sprintf("%016x", uint64(math.MaxInt64-modified.UnixNano()))
+}
+
+// newVersionID returns a new unique ID for a version of a stream stored now.
+// It sorts like the ID derived from the time, while the random suffix keeps
+// versions stored at the same time apart.
+func newVersionID() (string, error) {
+	var suffix [8]byte
+	_, err := rand.Read(suffix[:])
+	if err != nil {
+		return "", err
+	}
+	return versionID(time.Now()) + hex.EncodeToString(suffix[:]), nil
+}
+
+// streamVersionID returns the ID of the version of a stream with the given
+// stream info and modification date of its last segment
+func streamVersionID(msi *pb.MetaStreamInfo, modified time.Time) string {
+	if msi.VersionId != "" {
+		return msi.VersionId
+	}
+	if modified.IsZero() {
+		return ""
+	}
+	return versionID(modified)
+}
+
+// segmentPaths tells where the segments of a version of a stream are stored.
+// The current version is stored at s<N>/<path> and l/<path>, older versions
+// at vs<N>/<path>/<version> and vl/<path>/<version>. The segment keys of all
+// versions are encrypted with the key derived from the path of the stream.
+type segmentPaths struct {
+	path    paths.Path
+	version string
+}
+
+// segment returns the path of the segment with the given index
+func (sp segmentPaths) segment(segmentIndex int64) paths.Path {
+	if sp.version == "" {
+		return getSegmentPath(sp.path, segmentIndex)
+	}
+	return sp.path.Append(sp.version).Prepend(fmt.Sprintf("vs%d", segmentIndex))
+}
+
+// last returns the path of the last segment
+func (sp segmentPaths) last() paths.Path {
+	if sp.version == "" {
+		return sp.path.Prepend("l")
+	}
+	return sp.path.Append(sp.version).Prepend("vl")
+}
+
+// locate returns where the given version of the stream at path is stored
+// together with the metadata of its last segment. An empty version is the
+// current version.
+func (s *streamStore) locate(ctx context.Context, path paths.Path, version string) (segmentPaths, segments.Meta, error) {
+	if version != "" {
+		sp := segmentPaths{path: path, version: version}
+		lastSegmentMeta, err := s.segments.Meta(ctx, sp.last())
+		if err == nil || !storage.ErrKeyNotFound.Has(err) {
+			return sp, lastSegmentMeta, err
+		}
+	}
+
+	sp := segmentPaths{path: path}
+	lastSegmentMeta, err := s.segments.Meta(ctx, sp.last())
+	if err != nil {
+		return segmentPaths{}, segments.Meta{}, err
+	}
+	if version != "" {
+		msi := pb.MetaStreamInfo{}
+		err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+		if err != nil {
+			return segmentPaths{}, segments.Meta{}, err
+		}
+		if streamVersionID(&msi, lastSegmentMeta.Modified) != version {
+			return segmentPaths{}, segments.Meta{}, storage.ErrKeyNotFound.New("version %s of %s", version, path)
+		}
+	}
+	return sp, lastSegmentMeta, nil
+}
+
+// Archive keeps the current version of the stream at path as an older
+// version, so it is no longer at path, but can still be read with its
+// version ID. All segments are moved at once.
+func (s *streamStore) Archive(ctx context.Context, path paths.Path) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.checkScope(path)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	current := segmentPaths{path: path}
+	lastSegmentMeta, err := s.segments.Meta(ctx, current.last())
+	if err != nil {
+		return Meta{}, err
+	}
+
+	msi := pb.MetaStreamInfo{}
+	err = proto.Unmarshal(lastSegmentMeta.Data, &msi)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	archived := segmentPaths{path: path, version: streamVersionID(&msi, lastSegmentMeta.Modified)}
+	var relocations []segments.Relocation
+	for i := int64(0); i < msi.NumberOfSegments-1; i++ {
+		segmentMeta, err := s.segments.Meta(ctx, current.segment(i))
+		if err != nil {
+			return Meta{}, err
+		}
+		relocations = append(relocations, segments.Relocation{
+			SrcPath:  current.segment(i),
+			DstPath:  archived.segment(i),
+			Metadata: segmentMeta.Data,
+		})
+	}
+	relocations = append(relocations, segments.Relocation{
+		SrcPath:  current.last(),
+		DstPath:  archived.last(),
+		Metadata: lastSegmentMeta.Data,
+	})
+
+	metas, err := s.segments.Relocate(ctx, relocations, nil, false)
+	if err != nil {
+		return Meta{}, err
+	}
+	putMeta := metas[len(metas)-1]
+
+	streamMeta, err := convertMeta(putMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+	streamMeta.Checksum, err = s.getChecksum(path, putMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+	streamMeta.Data, err = s.getMetadata(path, putMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return streamMeta, nil
+}
+
+// GetVersion returns a ranger of the given version of the stream at path. An
+// empty version is the current version.
+func (s *streamStore) GetVersion(ctx context.Context, path paths.Path, version string) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	sp, _, err := s.locate(ctx, path, version)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	return s.get(ctx, sp)
+}
+
+// MetaVersion returns the metadata of the given version of the stream at
+// path. An empty version is the current version.
+func (s *streamStore) MetaVersion(ctx context.Context, path paths.Path, version string) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, lastSegmentMeta, err := s.locate(ctx, path, version)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta, err := convertMeta(lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta.Checksum, err = s.getChecksum(path, lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta.Data, err = s.getMetadata(path, lastSegmentMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return streamMeta, nil
+}
+
+// DeleteVersion deletes the given version of the stream at path for good. An
+// empty version is the current version.
+func (s *streamStore) DeleteVersion(ctx context.Context, path paths.Path, version string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.checkScope(path)
+	if err != nil {
+		return err
+	}
+
+	sp, lastSegmentMeta, err := s.locate(ctx, path, version)
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, sp, lastSegmentMeta)
+}
+
+// ListVersions lists the older versions of the streams inside vl/, stripping
+// off the vl/ prefix and the version ID, which is returned in the metadata.
+// The versions of a stream are listed from the newest to the oldest. The
+// listing is always recursive.
+func (s *streamStore) ListVersions(ctx context.Context, prefix, startAfter, endBefore paths.Path, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	requestedFlags := metaFlags
+	if metaFlags&(meta.Size|meta.Checksum) != 0 {
+		metaFlags |= meta.UserDefined
+	}
+
+	segments, more, err := s.segments.List(ctx, prefix.Prepend("vl"), startAfter, endBefore, true, limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	items = make([]ListItem, 0, len(segments))
+	for _, item := range segments {
+		if len(item.Path) == 0 {
+			continue
+		}
+		path := item.Path[:len(item.Path)-1]
+
+		newMeta, err := convertMeta(item.Meta)
+		if err != nil {
+			return nil, false, err
+		}
+		newMeta.Version = item.Path[len(item.Path)-1]
+		if metaFlags&meta.Checksum != 0 {
+			newMeta.Checksum, err = s.getChecksum(prefix.Append(path...), item.Meta)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		if requestedFlags&meta.UserDefined != 0 {
+			newMeta.Data, err = s.getMetadata(prefix.Append(path...), item.Meta)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		items = append(items, ListItem{Path: path, Meta: newMeta})
+	}
+
+	return items, more, nil
+}
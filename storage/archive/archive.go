@@ -0,0 +1,287 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package archive exports the items of a key/value store to a compressed,
+// checksummed archive and imports them into another store.
+//
+// An archive is a gzip stream of
+//
+//	magic, version
+//	key length, key, value length, value   (for every item, by key)
+//	0, item count
+//	sha256 of everything before it
+//
+// where the lengths and the count are uvarints. The end of the items is
+// marked by a zero key length, as the stores don't allow empty keys.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+	"io"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/storage"
+)
+
+// Error is the default archive errs class
+var Error = errs.Class("archive error")
+
+// Version is the version of the archives written by Export
+const Version = 1
+
+// magic starts every archive
+const magic = "storjkv"
+
+// maxItemSize limits the keys and values read from an archive, so that a
+// corrupted length does not exhaust the memory
+const maxItemSize = 64 << 20
+
+// importBatch is the number of items imported between checkpoints
+const importBatch = storage.LookupLimit
+
+// writer writes the uncompressed archive while hashing it
+type writer struct {
+	out  *bufio.Writer
+	hash hash.Hash
+	buf  [binary.MaxVarintLen64]byte
+}
+
+func (w *writer) write(data []byte) error {
+	_, _ = w.hash.Write(data)
+	_, err := w.out.Write(data)
+	return err
+}
+
+func (w *writer) writeUvarint(x uint64) error {
+	return w.write(w.buf[:binary.PutUvarint(w.buf[:], x)])
+}
+
+func (w *writer) writeBytes(data []byte) error {
+	if err := w.writeUvarint(uint64(len(data))); err != nil {
+		return err
+	}
+	return w.write(data)
+}
+
+// Export writes every item of the store to w and returns the number of
+// items. The store should not change during the export.
+func Export(ctx context.Context, store storage.KeyValueStore, w io.Writer) (count int64, err error) {
+	zw := gzip.NewWriter(w)
+	aw := &writer{out: bufio.NewWriter(zw), hash: sha256.New()}
+
+	if err = aw.write(append([]byte(magic), Version)); err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	err = store.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := aw.writeBytes(item.Key); err != nil {
+				return err
+			}
+			if err := aw.writeBytes(item.Value); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+
+	if err = aw.writeUvarint(0); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if err = aw.writeUvarint(uint64(count)); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if _, err = aw.out.Write(aw.hash.Sum(nil)); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if err = aw.out.Flush(); err != nil {
+		return 0, Error.Wrap(err)
+	}
+	return count, Error.Wrap(zw.Close())
+}
+
+// reader reads the uncompressed archive while hashing it
+type reader struct {
+	in   *bufio.Reader
+	hash hash.Hash
+}
+
+func newReader(r io.Reader) (*reader, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	ar := &reader{in: bufio.NewReader(zr), hash: sha256.New()}
+
+	header := make([]byte, len(magic)+1)
+	if err = ar.read(header); err != nil {
+		return nil, err
+	}
+	if string(header[:len(magic)]) != magic {
+		return nil, Error.New("not an archive")
+	}
+	if header[len(magic)] != Version {
+		return nil, Error.New("unsupported archive version %d", header[len(magic)])
+	}
+	return ar, nil
+}
+
+func (r *reader) read(data []byte) error {
+	if _, err := io.ReadFull(r.in, data); err != nil {
+		return Error.New("truncated archive: %v", err)
+	}
+	_, _ = r.hash.Write(data)
+	return nil
+}
+
+func (r *reader) ReadByte() (byte, error) {
+	b, err := r.in.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	_, _ = r.hash.Write([]byte{b})
+	return b, nil
+}
+
+func (r *reader) readUvarint() (uint64, error) {
+	x, err := binary.ReadUvarint(r)
+	if err != nil {
+		return 0, Error.New("truncated archive: %v", err)
+	}
+	return x, nil
+}
+
+func (r *reader) readBytes(length uint64) ([]byte, error) {
+	if length > maxItemSize {
+		return nil, Error.New("corrupted archive: item of %d bytes", length)
+	}
+	data := make([]byte, length)
+	return data, r.read(data)
+}
+
+// end checks the checksum of the archive
+func (r *reader) end() error {
+	sum := r.hash.Sum(nil)
+	expected := make([]byte, len(sum))
+	if _, err := io.ReadFull(r.in, expected); err != nil {
+		return Error.New("truncated archive: %v", err)
+	}
+	if !bytes.Equal(sum, expected) {
+		return Error.New("archive checksum mismatch")
+	}
+	if _, err := r.in.ReadByte(); err != io.EOF {
+		return Error.New("unexpected data after the archive")
+	}
+	return nil
+}
+
+// Verify reads the whole archive from r, checks its checksum and item count
+// and returns the number of items
+func Verify(ctx context.Context, r io.Reader) (count int64, err error) {
+	return Import(ctx, r, nil, nil, nil)
+}
+
+// Import puts the items of the archive from r into the store and returns the
+// number of items of the archive. The items up to and including the key
+// after are skipped, so that an interrupted import can be resumed with the
+// key of its last checkpoint. checkpoint is called, if it is not nil, after
+// every batch of items with the last imported key and the number of items
+// read so far. If store is nil, the archive is only verified.
+//
+// The archive is verified as it is imported, so the items of a corrupted
+// archive are imported up to the corruption.
+func Import(ctx context.Context, r io.Reader, store storage.KeyValueStore, after storage.Key, checkpoint func(last storage.Key, count int64) error) (count int64, err error) {
+	ar, err := newReader(r)
+	if err != nil {
+		return 0, err
+	}
+
+	var last storage.Key
+	for {
+		if err = ctx.Err(); err != nil {
+			return count, err
+		}
+
+		var length uint64
+		var key, value []byte
+		if length, err = ar.readUvarint(); err != nil {
+			return count, err
+		}
+		if length == 0 {
+			break
+		}
+		if key, err = ar.readBytes(length); err != nil {
+			return count, err
+		}
+		if length, err = ar.readUvarint(); err != nil {
+			return count, err
+		}
+		if value, err = ar.readBytes(length); err != nil {
+			return count, err
+		}
+		if last != nil && bytes.Compare(key, last) <= 0 {
+			return count, Error.New("corrupted archive: keys out of order")
+		}
+		last = key
+		count++
+
+		if store == nil || (after != nil && bytes.Compare(key, after) <= 0) {
+			continue
+		}
+		if err = store.Put(key, value); err != nil {
+			return count, Error.Wrap(err)
+		}
+		if checkpoint != nil && count%importBatch == 0 {
+			if err = checkpoint(key, count); err != nil {
+				return count, err
+			}
+		}
+	}
+
+	total, err := ar.readUvarint()
+	if err != nil {
+		return count, err
+	}
+	if err = ar.end(); err != nil {
+		return count, err
+	}
+	if int64(total) != count {
+		return count, Error.New("archive has %d items instead of %d", count, total)
+	}
+	if checkpoint != nil && store != nil {
+		if err = checkpoint(last, count); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// Count returns the number of items in the store
+func Count(ctx context.Context, store storage.KeyValueStore) (count int64, err error) {
+	err = store.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			count++
+		}
+		return nil
+	})
+	return count, Error.Wrap(err)
+}
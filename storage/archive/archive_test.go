@@ -0,0 +1,110 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+var ctx = context.Background()
+
+func TestExportImport(t *testing.T) {
+	src := teststore.New()
+	for i := 0; i < 2*importBatch+10; i++ {
+		key := storage.Key(fmt.Sprintf("path/%05d", i))
+		assert.NoError(t, src.Put(key, storage.Value(fmt.Sprintf("value %d", i))))
+	}
+	assert.NoError(t, src.Put(storage.Key("empty"), nil))
+
+	var archive bytes.Buffer
+	count, err := Export(ctx, src, &archive)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(2*importBatch+11), count)
+
+	count, err = Verify(ctx, bytes.NewReader(archive.Bytes()))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2*importBatch+11), count)
+
+	// interrupt the import at the first checkpoint and resume it after it
+	dst := teststore.New()
+	var checkpoint storage.Key
+	_, err = Import(ctx, bytes.NewReader(archive.Bytes()), dst, nil, func(last storage.Key, count int64) error {
+		checkpoint = last
+		return fmt.Errorf("interrupted")
+	})
+	assert.EqualError(t, err, "interrupted")
+	assert.Equal(t, storage.Key(fmt.Sprintf("path/%05d", importBatch-2)), checkpoint)
+
+	var checkpoints []int64
+	count, err = Import(ctx, bytes.NewReader(archive.Bytes()), dst, checkpoint, func(last storage.Key, count int64) error {
+		checkpoints = append(checkpoints, count)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2*importBatch+11), count)
+	assert.Equal(t, []int64{2 * importBatch, 2*importBatch + 11}, checkpoints)
+
+	imported, err := Count(ctx, dst)
+	assert.NoError(t, err)
+	assert.Equal(t, count, imported)
+	value, err := dst.Get(storage.Key("path/00007"))
+	assert.NoError(t, err)
+	assert.Equal(t, storage.Value("value 7"), value)
+}
+
+func TestCorruptedArchive(t *testing.T) {
+	src := teststore.New()
+	assert.NoError(t, src.Put(storage.Key("a"), storage.Value("1")))
+	assert.NoError(t, src.Put(storage.Key("b"), storage.Value("2")))
+
+	var archive bytes.Buffer
+	_, err := Export(ctx, src, &archive)
+	if !assert.NoError(t, err) {
+		return
+	}
+	zr, err := gzip.NewReader(&archive)
+	if !assert.NoError(t, err) {
+		return
+	}
+	data, err := ioutil.ReadAll(zr)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	compress := func(data []byte) *bytes.Buffer {
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		_, _ = zw.Write(data)
+		assert.NoError(t, zw.Close())
+		return &buf
+	}
+	corrupt := func(i int, b byte) []byte {
+		corrupted := append([]byte{}, data...)
+		corrupted[i] = b
+		return corrupted
+	}
+
+	_, err = Verify(ctx, compress(data))
+	assert.NoError(t, err)
+	_, err = Verify(ctx, compress(corrupt(len(magic), Version+1)))
+	assert.EqualError(t, err, "archive error: unsupported archive version 2")
+	_, err = Verify(ctx, compress(corrupt(len(magic)+2, 'A')))
+	assert.EqualError(t, err, "archive error: archive checksum mismatch")
+	_, err = Verify(ctx, compress(data[:len(data)-1]))
+	assert.Error(t, err)
+	_, err = Verify(ctx, bytes.NewReader(data))
+	assert.Error(t, err)
+}
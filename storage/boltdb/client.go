@@ -95,6 +95,34 @@ func (client *Client) Delete(key storage.Key) error {
 	})
 }
 
+// CompareAndSwap applies all changes in a single transaction if the checked
+// keys have their old values
+func (client *Client) CompareAndSwap(changes ...storage.Change) error {
+	return client.update(func(bucket *bolt.Bucket) error {
+		for i := range changes {
+			change := &changes[i]
+			if len(change.Key) == 0 {
+				return Error.New("invalid key")
+			}
+			if !change.Matches(bucket.Get(change.Key)) {
+				return storage.ErrValueChanged.New("%s", change.Key.String())
+			}
+		}
+		for _, change := range changes {
+			var err error
+			if change.Delete {
+				err = bucket.Delete(change.Key)
+			} else {
+				err = bucket.Put(change.Key, change.Value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 // List returns either a list of keys for which boltdb has values or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
@@ -22,6 +22,10 @@ var ErrEmptyKey = errors.New("empty key")
 // ErrLimitExceeded is returned when request limit is exceeded
 var ErrLimitExceeded = errors.New("limit exceeded")
 
+// ErrValueChanged is returned by CompareAndSwap when a checked key does not
+// have the expected value anymore
+var ErrValueChanged = errs.Class("value changed")
+
 // Key is the type for the keys in a `KeyValueStore`
 type Key []byte
 
@@ -63,10 +67,31 @@ type KeyValueStore interface {
 	ReverseList(Key, int) (Keys, error)
 	// Iterate iterates over items based on opts
 	Iterate(opts IterateOptions, fn func(Iterator) error) error
+	// CompareAndSwap applies all changes atomically, or none of them if a
+	// checked key does not have its old value
+	CompareAndSwap(changes ...Change) error
 	// Close closes the store
 	Close() error
 }
 
+// Change is a change of a single key applied by CompareAndSwap
+type Change struct {
+	Key Key
+	// Value is stored at the key unless Delete is set
+	Value  Value
+	Delete bool
+	// Check makes the changes conditional on the key having the value Old.
+	// A nil or empty Old value matches a key that does not exist.
+	Check bool
+	Old   Value
+}
+
+// Matches returns whether the current value of the key, nil if the key does
+// not exist, satisfies the check of the change
+func (change *Change) Matches(current Value) bool {
+	return !change.Check || bytes.Equal(current, change.Old)
+}
+
 // IterateOptions contains options for iterator
 type IterateOptions struct {
 	// Prefix ensure
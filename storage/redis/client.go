@@ -112,6 +112,55 @@ func (client *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap applies all changes in a transaction if the checked keys
+// have their old values. The checked keys are watched, so the transaction
+// fails when another client modifies them in the meantime.
+func (client *Client) CompareAndSwap(changes ...storage.Change) error {
+	var watched []string
+	for _, change := range changes {
+		if len(change.Key) == 0 {
+			return Error.New("invalid key")
+		}
+		if change.Check {
+			watched = append(watched, change.Key.String())
+		}
+	}
+
+	err := client.db.Watch(func(tx *redis.Tx) error {
+		for i := range changes {
+			change := &changes[i]
+			if !change.Check {
+				continue
+			}
+			current, err := tx.Get(change.Key.String()).Bytes()
+			if err != nil && err != redis.Nil {
+				return Error.New("get error: %v", err)
+			}
+			if !change.Matches(current) {
+				return storage.ErrValueChanged.New("%s", change.Key.String())
+			}
+		}
+		_, err := tx.Pipelined(func(pipe redis.Pipeliner) error {
+			for _, change := range changes {
+				if change.Delete {
+					pipe.Del(change.Key.String())
+				} else {
+					pipe.Set(change.Key.String(), []byte(change.Value), client.TTL)
+				}
+			}
+			return nil
+		})
+		return err
+	}, watched...)
+	if err == redis.TxFailedErr {
+		return storage.ErrValueChanged.New("transaction failed")
+	}
+	if err != nil && !storage.ErrValueChanged.Has(err) && !Error.Has(err) {
+		return Error.New("compare and swap error: %v", err)
+	}
+	return err
+}
+
 // Close closes a redis client
 func (client *Client) Close() error {
 	return client.db.Close()
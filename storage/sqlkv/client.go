@@ -0,0 +1,445 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqlkv
+
+import (
+	"bytes"
+	"database/sql"
+	"strconv"
+	"strings"
+
+	_ "github.com/lib/pq"           // register the postgres driver
+	_ "github.com/mattn/go-sqlite3" // register the sqlite3 driver
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/utils"
+	"storj.io/storj/storage"
+)
+
+// Error is the default sqlkv errs class
+var Error = errs.Class("sqlkv error")
+
+// batchSize is the number of rows an iterator loads at once
+const batchSize = 100
+
+// Client is a key/value store in a table of a SQL database. The keys are
+// ordered by their bytes like in the other stores.
+type Client struct {
+	db     *sql.DB
+	driver string
+	table  string
+}
+
+// New opens the SQL database with the driver, either postgres or sqlite3,
+// and creates the table for the store if it does not exist yet
+func New(driver, source, table string) (*Client, error) {
+	var keyType string
+	switch driver {
+	case "postgres":
+		keyType = "BYTEA"
+	case "sqlite3":
+		keyType = "BLOB"
+	default:
+		return nil, Error.New("unsupported driver: %s", driver)
+	}
+
+	db, err := sql.Open(driver, source)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if driver == "sqlite3" {
+		// sqlite allows a single writer, and every connection to an in-memory
+		// database opens a database of its own
+		db.SetMaxOpenConns(1)
+	}
+
+	client := &Client{db: db, driver: driver, table: quoteIdent(table)}
+	_, err = db.Exec(`CREATE TABLE IF NOT EXISTS ` + client.table + ` (
+		"key" ` + keyType + ` NOT NULL PRIMARY KEY,
+		"value" ` + keyType + ` NOT NULL
+	)`)
+	if err != nil {
+		return nil, utils.CombineErrors(Error.Wrap(err), db.Close())
+	}
+	return client, nil
+}
+
+// NewFromURL opens the store at a postgres:// or sqlite:// database URL
+func NewFromURL(databaseURL, table string) (*Client, error) {
+	switch {
+	case strings.HasPrefix(databaseURL, "postgres://") || strings.HasPrefix(databaseURL, "postgresql://"):
+		return New("postgres", databaseURL, table)
+	case strings.HasPrefix(databaseURL, "sqlite://"):
+		return New("sqlite3", strings.TrimPrefix(databaseURL, "sqlite://"), table)
+	default:
+		return nil, Error.New("unsupported database url: %s", databaseURL)
+	}
+}
+
+// Put adds a value to the provided key, replacing the previous value
+func (client *Client) Put(key storage.Key, value storage.Value) error {
+	if key.IsZero() {
+		return Error.New("invalid key")
+	}
+
+	var query string
+	if client.driver == "postgres" {
+		query = `INSERT INTO ` + client.table + ` ("key", "value") VALUES (?, ?)
+			ON CONFLICT ("key") DO UPDATE SET "value" = EXCLUDED."value"`
+	} else {
+		query = `INSERT OR REPLACE INTO ` + client.table + ` ("key", "value") VALUES (?, ?)`
+	}
+	_, err := client.db.Exec(client.rebind(query), []byte(key), nonNil(value))
+	return Error.Wrap(err)
+}
+
+// CompareAndSwap applies all changes in a transaction if the checked keys
+// have their old values. The checks are part of the statements that modify
+// the keys, so the changes are atomic even when several processes share the
+// database.
+func (client *Client) CompareAndSwap(changes ...storage.Change) (err error) {
+	for _, change := range changes {
+		if change.Key.IsZero() {
+			return Error.New("invalid key")
+		}
+	}
+
+	tx, err := client.db.Begin()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = utils.CombineErrors(err, Error.Wrap(tx.Rollback()))
+			return
+		}
+		err = Error.Wrap(tx.Commit())
+	}()
+
+	for i := range changes {
+		change := &changes[i]
+		ok, err := client.apply(tx, change)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return storage.ErrValueChanged.New("%s", change.Key.String())
+		}
+	}
+	return nil
+}
+
+// apply executes a single change in the transaction, and returns false if
+// its check failed
+func (client *Client) apply(tx *sql.Tx, change *storage.Change) (bool, error) {
+	key := []byte(change.Key)
+	var query string
+	var args []interface{}
+	switch {
+	case !change.Check && change.Delete:
+		query = `DELETE FROM ` + client.table + ` WHERE "key" = ?`
+		args = []interface{}{key}
+	case !change.Check:
+		query = client.upsert(``)
+		args = []interface{}{key, nonNil(change.Value)}
+	case len(change.Old) > 0 && change.Delete:
+		query = `DELETE FROM ` + client.table + ` WHERE "key" = ? AND "value" = ?`
+		args = []interface{}{key, []byte(change.Old)}
+	case len(change.Old) > 0:
+		query = `UPDATE ` + client.table + ` SET "value" = ? WHERE "key" = ? AND "value" = ?`
+		args = []interface{}{nonNil(change.Value), key, []byte(change.Old)}
+	case change.Delete:
+		// the key must not exist or be empty, and deleting it changes nothing
+		// that could be observed
+		var count int
+		err := tx.QueryRow(client.rebind(`SELECT COUNT(*) FROM `+client.table+` WHERE "key" = ? AND "value" <> ?`), key, []byte{}).Scan(&count)
+		return count == 0, Error.Wrap(err)
+	default:
+		// inserting a new key conflicts with concurrent inserts, which then
+		// only update the value if it is still empty
+		query = client.upsert(` WHERE ` + client.table + `."value" = ?`)
+		args = []interface{}{key, nonNil(change.Value), []byte{}}
+	}
+
+	result, err := tx.Exec(client.rebind(query), args...)
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	if !change.Check {
+		return true, nil
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, Error.Wrap(err)
+	}
+	return affected == 1, nil
+}
+
+// upsert returns the statement that inserts a key or updates its value if
+// the condition holds
+func (client *Client) upsert(condition string) string {
+	return `INSERT INTO ` + client.table + ` ("key", "value") VALUES (?, ?)
+		ON CONFLICT ("key") DO UPDATE SET "value" = EXCLUDED."value"` + condition
+}
+
+// Get looks up the value of the provided key
+func (client *Client) Get(key storage.Key) (storage.Value, error) {
+	var value []byte
+	err := client.db.QueryRow(client.rebind(`SELECT "value" FROM `+client.table+` WHERE "key" = ?`), []byte(key)).Scan(&value)
+	if err == sql.ErrNoRows {
+		return nil, storage.ErrKeyNotFound.New("%s", key.String())
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return storage.Value(value), nil
+}
+
+// GetAll finds all values for the provided keys up to storage.LookupLimit
+// keys. The values of missing keys are nil.
+func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
+	if len(keys) > storage.LookupLimit {
+		return nil, storage.ErrLimitExceeded
+	}
+
+	values := make(storage.Values, 0, len(keys))
+	for _, key := range keys {
+		value, err := client.Get(key)
+		if storage.ErrKeyNotFound.Has(err) {
+			values = append(values, nil)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// Delete deletes the value of the provided key
+func (client *Client) Delete(key storage.Key) error {
+	_, err := client.db.Exec(client.rebind(`DELETE FROM `+client.table+` WHERE "key" = ?`), []byte(key))
+	return Error.Wrap(err)
+}
+
+// List returns up to limit keys starting from first
+func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
+	return storage.ListKeys(client, first, limit)
+}
+
+// ReverseList returns up to limit keys starting from first and iterating
+// backwards
+func (client *Client) ReverseList(first storage.Key, limit int) (storage.Keys, error) {
+	return storage.ReverseListKeys(client, first, limit)
+}
+
+// Close closes the database
+func (client *Client) Close() error {
+	return Error.Wrap(client.db.Close())
+}
+
+// Iterate iterates over items based on opts. The rows are loaded in batches,
+// so the items are not a consistent snapshot of the store if it is modified
+// during the iteration.
+func (client *Client) Iterate(opts storage.IterateOptions, fn func(storage.Iterator) error) error {
+	cursor := &cursor{client: client, opts: opts}
+
+	start := true
+	lastPrefix := []byte{}
+	wasPrefix := false
+
+	err := fn(storage.IteratorFunc(func(item *storage.ListItem) bool {
+		var next storage.ListItem
+		var ok bool
+		if start {
+			next, ok = cursor.positionToFirst()
+			start = false
+		} else {
+			next, ok = cursor.advance()
+		}
+
+		if !opts.Recurse {
+			// when non-recursive skip all items that have the same prefix
+			if ok && wasPrefix && bytes.HasPrefix(next.Key, lastPrefix) {
+				next, ok = cursor.skipPrefix(lastPrefix)
+				wasPrefix = false
+			}
+		}
+
+		if !ok || !bytes.HasPrefix(next.Key, opts.Prefix) {
+			return false
+		}
+
+		if !opts.Recurse {
+			// check whether the entry is a proper prefix
+			if p := bytes.IndexByte(next.Key[len(opts.Prefix):], storage.Delimiter); p >= 0 {
+				lastPrefix = append(lastPrefix[:0], next.Key[:len(opts.Prefix)+p+1]...)
+
+				item.Key = append(item.Key[:0], storage.Key(lastPrefix)...)
+				item.Value = item.Value[:0]
+				item.IsPrefix = true
+
+				wasPrefix = true
+				return true
+			}
+		}
+
+		item.Key = append(item.Key[:0], next.Key...)
+		item.Value = append(item.Value[:0], next.Value...)
+		item.IsPrefix = false
+
+		return true
+	}))
+	return utils.CombineErrors(err, cursor.err)
+}
+
+// cursor walks over the rows of the store in key order, loading them in
+// batches
+type cursor struct {
+	client *Client
+	opts   storage.IterateOptions
+
+	batch storage.Items
+	last  storage.Key
+	more  bool
+	err   error
+}
+
+// positionToFirst loads the first row at or after the first key of the
+// iteration, or at or before it when iterating in reverse
+func (cursor *cursor) positionToFirst() (storage.ListItem, bool) {
+	first := cursor.opts.First
+	if !cursor.opts.Reverse && first.Less(cursor.opts.Prefix) {
+		first = cursor.opts.Prefix
+	}
+	return cursor.seek(first, true)
+}
+
+// advance returns the next row
+func (cursor *cursor) advance() (storage.ListItem, bool) {
+	if len(cursor.batch) == 0 {
+		if !cursor.more {
+			return storage.ListItem{}, false
+		}
+		return cursor.seek(cursor.last, false)
+	}
+	return cursor.pop()
+}
+
+// skipPrefix returns the next row that does not have the prefix
+func (cursor *cursor) skipPrefix(prefix storage.Key) (storage.ListItem, bool) {
+	for len(cursor.batch) > 0 && bytes.HasPrefix(cursor.batch[0].Key, prefix) {
+		cursor.batch = cursor.batch[1:]
+	}
+	if len(cursor.batch) > 0 {
+		return cursor.pop()
+	}
+	if !cursor.more {
+		return storage.ListItem{}, false
+	}
+	if !cursor.opts.Reverse {
+		return cursor.seek(storage.AfterPrefix(prefix), true)
+	}
+	// all keys with the prefix sort after the prefix itself
+	return cursor.seek(prefix, false)
+}
+
+func (cursor *cursor) pop() (storage.ListItem, bool) {
+	item := cursor.batch[0]
+	cursor.batch = cursor.batch[1:]
+	return item, true
+}
+
+// seek loads the next batch of rows starting from the key, and returns the
+// first of them
+func (cursor *cursor) seek(from storage.Key, inclusive bool) (storage.ListItem, bool) {
+	var conditions []string
+	var args []interface{}
+
+	if !from.IsZero() {
+		op := ">"
+		if cursor.opts.Reverse {
+			op = "<"
+		}
+		if inclusive {
+			op += "="
+		}
+		conditions = append(conditions, `"key" `+op+` ?`)
+		args = append(args, []byte(from))
+	}
+	if prefix := cursor.opts.Prefix; !prefix.IsZero() {
+		conditions = append(conditions, `"key" >= ?`, `"key" < ?`)
+		args = append(args, []byte(prefix), []byte(storage.AfterPrefix(prefix)))
+	}
+
+	query := `SELECT "key", "value" FROM ` + cursor.client.table
+	if len(conditions) > 0 {
+		query += ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	if !cursor.opts.Reverse {
+		query += ` ORDER BY "key" ASC`
+	} else {
+		query += ` ORDER BY "key" DESC`
+	}
+	query += ` LIMIT ` + strconv.Itoa(batchSize)
+
+	cursor.batch, cursor.err = cursor.client.query(query, args...)
+	if cursor.err != nil || len(cursor.batch) == 0 {
+		cursor.more = false
+		return storage.ListItem{}, false
+	}
+	cursor.more = len(cursor.batch) == batchSize
+	cursor.last = cursor.batch[len(cursor.batch)-1].Key
+	return cursor.pop()
+}
+
+func (client *Client) query(query string, args ...interface{}) (items storage.Items, err error) {
+	rows, err := client.db.Query(client.rebind(query), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = utils.CombineErrors(err, Error.Wrap(rows.Close())) }()
+
+	for rows.Next() {
+		var key, value []byte
+		if err := rows.Scan(&key, &value); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		items = append(items, storage.ListItem{Key: key, Value: value})
+	}
+	return items, Error.Wrap(rows.Err())
+}
+
+// rebind replaces the ? placeholders of the query with the placeholders of
+// the driver
+func (client *Client) rebind(query string) string {
+	if client.driver != "postgres" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func quoteIdent(name string) string {
+	return `"` + strings.Replace(name, `"`, `""`, -1) + `"`
+}
+
+// nonNil returns an empty value instead of nil, which would be stored as
+// NULL
+func nonNil(value storage.Value) []byte {
+	if value == nil {
+		return []byte{}
+	}
+	return value
+}
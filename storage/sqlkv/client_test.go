@@ -0,0 +1,139 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package sqlkv
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/testsuite"
+)
+
+var testPostgres = flag.String("postgres-test-db", os.Getenv("STORJ_POSTGRES_TEST"), "PostgreSQL test database connection string")
+
+func TestSuiteSqlite(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "storj-sqlkv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tempdir) }()
+
+	store, err := NewFromURL("sqlite://"+filepath.Join(tempdir, "kv.db"), "bucket")
+	if err != nil {
+		t.Fatalf("failed to create db: %v", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			t.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	testsuite.RunTests(t, store)
+}
+
+func TestSuitePostgres(t *testing.T) {
+	if *testPostgres == "" {
+		t.Skip("PostgreSQL flag missing")
+	}
+
+	store, err := NewFromURL(*testPostgres, "bucket")
+	if err != nil {
+		t.Fatalf("failed to create db: %v", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			t.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	testsuite.RunTests(t, store)
+}
+
+func TestSharedCompareAndSwap(t *testing.T) {
+	tempdir, err := ioutil.TempDir("", "storj-sqlkv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tempdir) }()
+
+	// two clients of the same database behave like two satellite processes
+	var stores []*Client
+	for i := 0; i < 2; i++ {
+		store, err := NewFromURL("sqlite://"+filepath.Join(tempdir, "kv.db"), "bucket")
+		if err != nil {
+			t.Fatalf("failed to create db: %v", err)
+		}
+		defer func() { _ = store.Close() }()
+		stores = append(stores, store)
+	}
+
+	const increments = 50
+	key := storage.Key("counter")
+
+	var group errgroup.Group
+	for _, store := range stores {
+		store := store
+		group.Go(func() error {
+			for i := 0; i < increments; {
+				old, err := store.Get(key)
+				if err != nil && !storage.ErrKeyNotFound.Has(err) {
+					return err
+				}
+				count, _ := strconv.Atoi(string(old))
+				err = store.CompareAndSwap(storage.Change{
+					Key:   key,
+					Value: storage.Value(strconv.Itoa(count + 1)),
+					Check: true,
+					Old:   old,
+				})
+				if storage.ErrValueChanged.Has(err) {
+					continue
+				}
+				if err != nil {
+					return err
+				}
+				i++
+			}
+			return nil
+		})
+	}
+	if err := group.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	value, err := stores[0].Get(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(value) != strconv.Itoa(2*increments) {
+		t.Fatalf("lost updates: got %s, want %d", value, 2*increments)
+	}
+}
+
+func BenchmarkSuiteSqlite(b *testing.B) {
+	tempdir, err := ioutil.TempDir("", "storj-sqlkv")
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tempdir) }()
+
+	store, err := NewFromURL("sqlite://"+filepath.Join(tempdir, "kv.db"), "bucket")
+	if err != nil {
+		b.Fatalf("failed to create db: %v", err)
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			b.Fatalf("failed to close db: %v", err)
+		}
+	}()
+
+	testsuite.RunBenchmarks(b, store)
+}
@@ -84,6 +84,16 @@ func (store *Logger) Iterate(opts storage.IterateOptions, fn func(storage.Iterat
 	})
 }
 
+// CompareAndSwap applies all changes if the checked keys have their old values
+func (store *Logger) CompareAndSwap(changes ...storage.Change) error {
+	keys := make([]string, 0, len(changes))
+	for _, change := range changes {
+		keys = append(keys, string(change.Key))
+	}
+	store.log.Debug("CompareAndSwap", zap.Strings("keys", keys))
+	return store.store.CompareAndSwap(changes...)
+}
+
 // Close closes the store
 func (store *Logger) Close() error {
 	store.log.Debug("Close")
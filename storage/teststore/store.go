@@ -27,6 +27,8 @@ type Client struct {
 		Delete      int
 		Close       int
 		Iterate     int
+
+		CompareAndSwap int
 	}
 
 	version int
@@ -67,11 +69,16 @@ func (store *Client) Put(key storage.Key, value storage.Value) error {
 		return storage.ErrEmptyKey
 	}
 
+	store.put(key, value)
+	return nil
+}
+
+func (store *Client) put(key storage.Key, value storage.Value) {
 	keyIndex, found := store.indexOf(key)
 	if found {
 		kv := &store.Items[keyIndex]
 		kv.Value = storage.CloneValue(value)
-		return nil
+		return
 	}
 
 	store.Items = append(store.Items, storage.ListItem{})
@@ -80,8 +87,6 @@ func (store *Client) Put(key storage.Key, value storage.Value) error {
 		Key:   storage.CloneKey(key),
 		Value: storage.CloneValue(value),
 	}
-
-	return nil
 }
 
 // Get gets a value to store
@@ -132,13 +137,52 @@ func (store *Client) Delete(key storage.Key) error {
 		return errInternal
 	}
 
+	if !store.delete(key) {
+		return storage.ErrKeyNotFound.New(key.String())
+	}
+	return nil
+}
+
+func (store *Client) delete(key storage.Key) bool {
 	keyIndex, found := store.indexOf(key)
 	if !found {
-		return storage.ErrKeyNotFound.New(key.String())
+		return false
 	}
 
 	copy(store.Items[keyIndex:], store.Items[keyIndex+1:])
 	store.Items = store.Items[:len(store.Items)-1]
+	return true
+}
+
+// CompareAndSwap applies all changes if the checked keys have their old values
+func (store *Client) CompareAndSwap(changes ...storage.Change) error {
+	store.CallCount.CompareAndSwap++
+	if store.forcedError() {
+		return errInternal
+	}
+
+	for i := range changes {
+		change := &changes[i]
+		if change.Key.IsZero() {
+			return storage.ErrEmptyKey
+		}
+		var current storage.Value
+		if keyIndex, found := store.indexOf(change.Key); found {
+			current = store.Items[keyIndex].Value
+		}
+		if !change.Matches(current) {
+			return storage.ErrValueChanged.New("%s", change.Key.String())
+		}
+	}
+
+	store.version++
+	for _, change := range changes {
+		if change.Delete {
+			store.delete(change.Key)
+		} else {
+			store.put(change.Key, change.Value)
+		}
+	}
 	return nil
 }
 
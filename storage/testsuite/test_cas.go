@@ -0,0 +1,86 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	a, b := storage.Key("cas/a"), storage.Key("cas/b")
+	defer cleanupItems(store, storage.Items{{Key: a}, {Key: b}})
+
+	expect := func(key storage.Key, want string) {
+		t.Helper()
+		value, err := store.Get(key)
+		if want == "" {
+			if !storage.ErrKeyNotFound.Has(err) {
+				t.Fatalf("%q should not exist: %q %v", key, value, err)
+			}
+			return
+		}
+		if err != nil || !bytes.Equal(value, storage.Value(want)) {
+			t.Fatalf("invalid value for %q: got %q %v, want %q", key, value, err, want)
+		}
+	}
+
+	t.Run("Insert", func(t *testing.T) {
+		err := store.CompareAndSwap(
+			storage.Change{Key: a, Value: storage.Value("1"), Check: true},
+			storage.Change{Key: b, Value: storage.Value("x")},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect(a, "1")
+		expect(b, "x")
+
+		err = store.CompareAndSwap(storage.Change{Key: a, Value: storage.Value("2"), Check: true})
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("inserting an existing key should fail: %v", err)
+		}
+		expect(a, "1")
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		err := store.CompareAndSwap(storage.Change{Key: a, Value: storage.Value("2"), Check: true, Old: storage.Value("1")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect(a, "2")
+	})
+
+	t.Run("Atomic", func(t *testing.T) {
+		err := store.CompareAndSwap(
+			storage.Change{Key: b, Delete: true},
+			storage.Change{Key: a, Value: storage.Value("3"), Check: true, Old: storage.Value("1")},
+		)
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("swapping a changed key should fail: %v", err)
+		}
+		expect(a, "2")
+		expect(b, "x")
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		err := store.CompareAndSwap(storage.Change{Key: a, Delete: true, Check: true, Old: storage.Value("1")})
+		if !storage.ErrValueChanged.Has(err) {
+			t.Fatalf("deleting a changed key should fail: %v", err)
+		}
+		expect(a, "2")
+
+		err = store.CompareAndSwap(
+			storage.Change{Key: a, Delete: true, Check: true, Old: storage.Value("2")},
+			storage.Change{Key: b, Delete: true},
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expect(a, "")
+		expect(b, "")
+	})
+}